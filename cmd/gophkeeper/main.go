@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
@@ -10,6 +11,8 @@ import (
 	"github.com/eugene982/yp-gophkeeper/internal/application"
 	"github.com/eugene982/yp-gophkeeper/internal/config"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+	_ "github.com/eugene982/yp-gophkeeper/internal/storage/postgres"
 )
 
 const (
@@ -22,12 +25,54 @@ var (
 )
 
 func main() {
-	err := run()
+	var err error
+	if len(os.Args) > 1 && os.Args[1] == "gc" {
+		err = runGC(os.Args[2:])
+	} else {
+		err = run()
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
 }
 
+// runGC разовая сборка мусора хранилища файлов:
+// gophkeeper gc [-dry-run] [-gcg grace] [-d dsn]
+func runGC(args []string) error {
+	config, err := config.ParseGC(args)
+	if err != nil {
+		return err
+	}
+	if err = logger.Initialize(config.LogLevel); err != nil {
+		return err
+	}
+
+	store, err := storage.Open(config.DSN, config.MigratePath)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	report, err := store.CollectGarbage(ctx, config.GCGrace, config.DryRun)
+	if err != nil {
+		return err
+	}
+
+	action := "removed"
+	if config.DryRun {
+		action = "reclaimable"
+	}
+	fmt.Printf("orphaned objects: %d\n", report.Objects)
+	fmt.Printf("unfinished uploads: %d\n", report.Binaries)
+	fmt.Printf("unassembled parts: %d\n", report.Parts)
+	fmt.Printf("expired items: %d\n", report.Expired)
+	fmt.Printf("%s bytes: %d\n", action, report.Bytes)
+	return nil
+}
+
 func run() (err error) {
 	// логируем любую возврвщаемую ошибку
 	defer func() {
@@ -0,0 +1,229 @@
+// Package archive упаковка каталога в tar для хранения одним файлом
+// и распаковка обратно с сохранением структуры и прав доступа
+package archive
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrUnsafePath путь элемента архива ведёт за пределы каталога распаковки
+var ErrUnsafePath = errors.New("unsafe path in archive")
+
+// Entry элемент архива
+type Entry struct {
+	Name string      // путь относительно каталога, разделитель "/"
+	Mode fs.FileMode // тип и права доступа
+	Size int64       // размер содержимого
+	End  int64       // смещение конца элемента в архиве
+}
+
+// Write упаковка содержимого каталога dir в tar: вложенные каталоги,
+// обычные файлы и символические ссылки вместе с правами и временем
+// изменения. Сокеты, каналы и устройства пропускаются, владелец не
+// сохраняется. Возвращает элементы в порядке записи.
+func Write(w io.Writer, dir string) ([]Entry, error) {
+	cw := &countWriter{w: w}
+	tw := tar.NewWriter(cw)
+
+	var entries []Entry
+	err := filepath.WalkDir(dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, name)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		var link string
+		switch mode := info.Mode(); {
+		case mode.IsRegular(), mode.IsDir():
+		case mode&fs.ModeSymlink != 0:
+			if link, err = os.Readlink(name); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if info.IsDir() {
+			hdr.Name += "/"
+		}
+		hdr.Uid, hdr.Gid, hdr.Uname, hdr.Gname = 0, 0, "", ""
+
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			if err = copyFile(tw, name, hdr.Size); err != nil {
+				return err
+			}
+		}
+		// выравнивание по блоку tar, чтобы знать конец элемента
+		if err = tw.Flush(); err != nil {
+			return err
+		}
+
+		entries = append(entries, Entry{Name: hdr.Name, Mode: info.Mode(), Size: hdr.Size, End: cw.n})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, tw.Close()
+}
+
+// copyFile запись в архив содержимого файла, размер которого
+// не должен измениться после чтения заголовка
+func copyFile(w io.Writer, name string, size int64) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.CopyN(w, f, size)
+	if err == io.EOF {
+		return fmt.Errorf("%s: file changed while archiving", name)
+	}
+	return err
+}
+
+// Extract распаковка tar из r в каталог dir с правами и временем
+// изменения, progress вызывается после каждого элемента. Элементы
+// с путями за пределами dir и ссылки, выходящие из своего каталога,
+// отклоняются с ErrUnsafePath. Права каталогов устанавливаются после
+// распаковки их содержимого.
+func Extract(r io.Reader, dir string, progress func(Entry)) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	var dirs []*tar.Header
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		name := filepath.FromSlash(hdr.Name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("%w: %s", ErrUnsafePath, hdr.Name)
+		}
+		target := filepath.Join(dir, name)
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, 0o700)
+			dirs = append(dirs, hdr)
+		case tar.TypeReg:
+			err = extractFile(tr, target, hdr)
+		case tar.TypeSymlink:
+			err = extractLink(target, hdr)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if progress != nil {
+			progress(Entry{Name: hdr.Name, Mode: hdr.FileInfo().Mode(), Size: hdr.Size})
+		}
+	}
+
+	// вложенные каталоги раньше содержащих их
+	for i := len(dirs) - 1; i >= 0; i-- {
+		target := filepath.Join(dir, filepath.FromSlash(dirs[i].Name))
+		if err := restore(target, dirs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractFile запись содержимого обычного файла
+func extractFile(r io.Reader, target string, hdr *tar.Header) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		return err
+	}
+	return restore(target, hdr)
+}
+
+// extractLink создание символической ссылки. Допускаются только
+// относительные ссылки без "..", они не выходят из своего каталога.
+func extractLink(target string, hdr *tar.Header) error {
+	link := filepath.FromSlash(hdr.Linkname)
+	if !filepath.IsLocal(link) || strings.Contains("/"+hdr.Linkname+"/", "/../") {
+		return fmt.Errorf("%w: %s -> %s", ErrUnsafePath, hdr.Name, hdr.Linkname)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o700); err != nil {
+		return err
+	}
+	if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return os.Symlink(link, target)
+}
+
+// restore восстановление прав доступа и времени изменения
+func restore(target string, hdr *tar.Header) error {
+	if err := os.Chmod(target, hdr.FileInfo().Mode().Perm()); err != nil {
+		return err
+	}
+	return os.Chtimes(target, hdr.ModTime, hdr.ModTime)
+}
+
+// Progress отслеживание загрузки архива: по отправленному объёму
+// fn вызывается для каждого переданного целиком элемента один раз,
+// в том числе если загрузка продолжилась с меньшего смещения
+func Progress(entries []Entry, fn func(Entry)) func(sent int64) {
+	next := 0
+	return func(sent int64) {
+		for ; next < len(entries) && entries[next].End <= sent; next++ {
+			fn(entries[next])
+		}
+	}
+}
+
+// countWriter подсчёт записанного объёма
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,126 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteExtract(t *testing.T) {
+	src := t.TempDir()
+	mtime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, os.WriteFile(filepath.Join(src, "id_rsa"), []byte("private"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "id_rsa.pub"), []byte("public"), 0o644))
+	require.NoError(t, os.Mkdir(filepath.Join(src, "keys"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "keys", "empty"), nil, 0o640))
+	require.NoError(t, os.Symlink("id_rsa.pub", filepath.Join(src, "current.pub")))
+	require.NoError(t, os.Chtimes(filepath.Join(src, "id_rsa"), mtime, mtime))
+	// каталог без права записи распаковывается вместе с содержимым
+	require.NoError(t, os.Chmod(filepath.Join(src, "keys"), 0o500))
+	t.Cleanup(func() { os.Chmod(filepath.Join(src, "keys"), 0o700) })
+
+	var buf bytes.Buffer
+	entries, err := Write(&buf, src)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(entries))
+	for i, e := range entries {
+		names = append(names, e.Name)
+		if i > 0 {
+			assert.Greater(t, e.End, entries[i-1].End)
+		}
+	}
+	assert.Equal(t, []string{"current.pub", "id_rsa", "id_rsa.pub", "keys/", "keys/empty"}, names)
+	assert.LessOrEqual(t, entries[len(entries)-1].End, int64(buf.Len()))
+
+	dst := filepath.Join(t.TempDir(), "ssh")
+	t.Cleanup(func() { os.Chmod(filepath.Join(dst, "keys"), 0o700) })
+	var extracted []string
+	require.NoError(t, Extract(&buf, dst, func(e Entry) { extracted = append(extracted, e.Name) }))
+	assert.Equal(t, names, extracted)
+
+	data, err := os.ReadFile(filepath.Join(dst, "id_rsa"))
+	require.NoError(t, err)
+	assert.Equal(t, "private", string(data))
+
+	info, err := os.Stat(filepath.Join(dst, "id_rsa"))
+	require.NoError(t, err)
+	assert.Equal(t, fs.FileMode(0o600), info.Mode().Perm())
+	assert.True(t, mtime.Equal(info.ModTime()))
+
+	info, err = os.Stat(filepath.Join(dst, "keys", "empty"))
+	require.NoError(t, err)
+	assert.Equal(t, fs.FileMode(0o640), info.Mode().Perm())
+
+	info, err = os.Stat(filepath.Join(dst, "keys"))
+	require.NoError(t, err)
+	assert.Equal(t, fs.FileMode(0o500), info.Mode().Perm())
+
+	link, err := os.Readlink(filepath.Join(dst, "current.pub"))
+	require.NoError(t, err)
+	assert.Equal(t, "id_rsa.pub", link)
+}
+
+func TestExtractUnsafe(t *testing.T) {
+	tests := []struct {
+		name string
+		hdr  tar.Header
+	}{
+		{
+			name: "parent path",
+			hdr:  tar.Header{Name: "../evil", Typeflag: tar.TypeReg, Mode: 0o600},
+		},
+		{
+			name: "absolute path",
+			hdr:  tar.Header{Name: "/tmp/evil", Typeflag: tar.TypeReg, Mode: 0o600},
+		},
+		{
+			name: "absolute link",
+			hdr:  tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc/passwd"},
+		},
+		{
+			name: "link to parent",
+			hdr:  tar.Header{Name: "dir/link", Typeflag: tar.TypeSymlink, Linkname: "../.."},
+		},
+		{
+			name: "link through parent",
+			hdr:  tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "a/../b"},
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tw := tar.NewWriter(&buf)
+			require.NoError(t, tw.WriteHeader(&tcase.hdr))
+			require.NoError(t, tw.Close())
+
+			err := Extract(&buf, t.TempDir(), nil)
+			assert.ErrorIs(t, err, ErrUnsafePath)
+		})
+	}
+}
+
+func TestProgress(t *testing.T) {
+	entries := []Entry{{Name: "a", End: 1024}, {Name: "b", End: 2048}, {Name: "c", End: 4096}}
+
+	var done []string
+	progress := Progress(entries, func(e Entry) { done = append(done, e.Name) })
+
+	progress(1000)
+	assert.Empty(t, done)
+	progress(2048)
+	assert.Equal(t, []string{"a", "b"}, done)
+	// повтор после обрыва с меньшего смещения
+	progress(1024)
+	progress(5000)
+	assert.Equal(t, []string{"a", "b", "c"}, done)
+}
@@ -0,0 +1,306 @@
+// Package cache зашифрованный локальный кэш хранилища пользователя.
+// Позволяет читать данные и вносить изменения без связи с сервером.
+package cache
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"golang.org/x/crypto/argon2"
+
+	aescrypt "github.com/eugene982/yp-gophkeeper/internal/crypto/aes"
+)
+
+var (
+	// ErrNotFound элемент отсутствует в кэше
+	ErrNotFound = errors.New("not found in cache")
+	// ErrWrongPassword пароль не подходит к кэшу
+	ErrWrongPassword = errors.New("wrong cache password")
+)
+
+var (
+	metaBucket  = []byte("meta")
+	itemsBucket = []byte("items")
+	queueBucket = []byte("queue")
+
+	saltKey  = []byte("salt")
+	checkKey = []byte("check")
+	checkVal = []byte("gophkeeper")
+)
+
+// Item закэшированный элемент хранилища
+type Item struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Data    []byte `json:"data"`    // ответ сервера на чтение в формате protobuf
+	Version string `json:"version"` // версия элемента на сервере
+	Local   bool   `json:"local"`   // изменён без связи с сервером
+}
+
+// Op отложенная операция изменения данных
+type Op struct {
+	Seq    uint64 `json:"-"`
+	Kind   string `json:"kind"`
+	Action string `json:"action"`
+	Name   string `json:"name"`
+	Base   string `json:"base"` // версия элемента, от которой сделано изменение
+	// изменение сделано поверх другого неотправленного изменения
+	Chained bool      `json:"chained"`
+	Data    []byte    `json:"data"` // запрос на запись в формате protobuf
+	Time    time.Time `json:"time"`
+}
+
+// Cache кэш одного пользователя
+type Cache struct {
+	db    *bolt.DB
+	crypt *aescrypt.AesCrypt
+	mac   []byte
+}
+
+// Open открытие или создание кэша, ключ шифрования выводится из пароля
+func Open(path, password string) (*Cache, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := open(db, password)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func open(db *bolt.DB, password string) (*Cache, error) {
+	var c Cache
+
+	err := db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{metaBucket, itemsBucket, queueBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		meta := tx.Bucket(metaBucket)
+
+		salt := meta.Get(saltKey)
+		if salt == nil {
+			salt = make([]byte, 16)
+			if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+				return err
+			}
+			if err := meta.Put(saltKey, salt); err != nil {
+				return err
+			}
+		}
+
+		key := argon2.IDKey([]byte(password), salt, 1, 64*1024, 4, 64)
+		crypt, err := aescrypt.New(key[:32])
+		if err != nil {
+			return err
+		}
+		c.crypt = crypt
+		c.mac = key[32:]
+
+		// проверочное значение позволяет убедиться в верности пароля
+		if check := meta.Get(checkKey); check != nil {
+			val, err := crypt.Decrypt(check)
+			if err != nil || !hmac.Equal(val, checkVal) {
+				return ErrWrongPassword
+			}
+			return nil
+		}
+		check, err := crypt.Encrypt(checkVal)
+		if err != nil {
+			return err
+		}
+		return meta.Put(checkKey, check)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.db = db
+	return &c, nil
+}
+
+// Close закрытие кэша
+func (c *Cache) Close() error {
+	return c.db.Close()
+}
+
+// Put сохранение элемента
+func (c *Cache) Put(item Item) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		val, err := c.seal(item)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(itemsBucket).Put(c.itemKey(item.Kind, item.Name), val)
+	})
+}
+
+// Get чтение элемента
+func (c *Cache) Get(kind, name string) (item Item, err error) {
+	err = c.db.View(func(tx *bolt.Tx) error {
+		val := tx.Bucket(itemsBucket).Get(c.itemKey(kind, name))
+		if val == nil {
+			return ErrNotFound
+		}
+		return c.open(val, &item)
+	})
+	return
+}
+
+// Delete удаление элемента
+func (c *Cache) Delete(kind, name string) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(itemsBucket).Delete(c.itemKey(kind, name))
+	})
+}
+
+// Items список элементов указанного вида
+func (c *Cache) Items(kind string) ([]Item, error) {
+	res := make([]Item, 0)
+	err := c.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(itemsBucket).ForEach(func(_, val []byte) error {
+			var item Item
+			if err := c.open(val, &item); err != nil {
+				return err
+			}
+			if item.Kind == kind {
+				res = append(res, item)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Prune удаление элементов, отсутствующих на сервере.
+// Элементы с неотправленными изменениями сохраняются.
+func (c *Cache) Prune(kind string, names []string) error {
+	keep := make(map[string]struct{}, len(names))
+	for _, n := range names {
+		keep[n] = struct{}{}
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(itemsBucket)
+		var stale [][]byte
+
+		err := b.ForEach(func(key, val []byte) error {
+			var item Item
+			if err := c.open(val, &item); err != nil {
+				return err
+			}
+			if _, ok := keep[item.Name]; item.Kind == kind && !ok && !item.Local {
+				stale = append(stale, key)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range stale {
+			if err = b.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Enqueue добавление операции в очередь отправки
+func (c *Cache) Enqueue(op Op) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(queueBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		val, err := c.seal(op)
+		if err != nil {
+			return err
+		}
+		return b.Put(seqKey(seq), val)
+	})
+}
+
+// Queue операции в порядке добавления
+func (c *Cache) Queue() ([]Op, error) {
+	res := make([]Op, 0)
+	err := c.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(queueBucket).ForEach(func(key, val []byte) error {
+			var op Op
+			if err := c.open(val, &op); err != nil {
+				return err
+			}
+			op.Seq = binary.BigEndian.Uint64(key)
+			res = append(res, op)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Pending количество неотправленных операций
+func (c *Cache) Pending() (n int) {
+	_ = c.db.View(func(tx *bolt.Tx) error {
+		n = tx.Bucket(queueBucket).Stats().KeyN
+		return nil
+	})
+	return
+}
+
+// Dequeue удаление отправленной операции
+func (c *Cache) Dequeue(seq uint64) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(queueBucket).Delete(seqKey(seq))
+	})
+}
+
+// itemKey ключ элемента не раскрывает его наименование
+func (c *Cache) itemKey(kind, name string) []byte {
+	h := hmac.New(sha256.New, c.mac)
+	h.Write([]byte(kind))
+	h.Write([]byte{0})
+	h.Write([]byte(name))
+	return h.Sum(nil)
+}
+
+func (c *Cache) seal(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return c.crypt.Encrypt(data)
+}
+
+func (c *Cache) open(val []byte, v any) error {
+	data, err := c.crypt.Decrypt(val)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func seqKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.db")
+
+	c, err := Open(path, "password")
+	require.NoError(t, err)
+
+	require.NoError(t, c.Put(Item{Kind: "note", Name: "n1", Data: []byte("one"), Version: "v1"}))
+	require.NoError(t, c.Put(Item{Kind: "note", Name: "n2", Data: []byte("two"), Local: true}))
+	require.NoError(t, c.Put(Item{Kind: "card", Name: "c1", Data: []byte("card")}))
+
+	item, err := c.Get("note", "n1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("one"), item.Data)
+	assert.Equal(t, "v1", item.Version)
+
+	_, err = c.Get("card", "n1")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	items, err := c.Items("note")
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+
+	// локально изменённые элементы не удаляются
+	require.NoError(t, c.Prune("note", nil))
+	items, err = c.Items("note")
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "n2", items[0].Name)
+
+	require.NoError(t, c.Delete("note", "n2"))
+	_, err = c.Get("note", "n2")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, c.Close())
+
+	// открытие с неверным паролем
+	_, err = Open(path, "wrong")
+	assert.ErrorIs(t, err, ErrWrongPassword)
+
+	c, err = Open(path, "password")
+	require.NoError(t, err)
+	defer c.Close()
+
+	item, err = c.Get("card", "c1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("card"), item.Data)
+}
+
+func TestQueue(t *testing.T) {
+	c, err := Open(filepath.Join(t.TempDir(), "cache.db"), "password")
+	require.NoError(t, err)
+	defer c.Close()
+
+	assert.Equal(t, 0, c.Pending())
+
+	require.NoError(t, c.Enqueue(Op{Kind: "note", Action: "create", Name: "n1"}))
+	require.NoError(t, c.Enqueue(Op{Kind: "note", Action: "delete", Name: "n2"}))
+	assert.Equal(t, 2, c.Pending())
+
+	ops, err := c.Queue()
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+	assert.Equal(t, "n1", ops[0].Name)
+	assert.Equal(t, "n2", ops[1].Name)
+
+	require.NoError(t, c.Dequeue(ops[0].Seq))
+	ops, err = c.Queue()
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, "delete", ops[0].Action)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
@@ -12,19 +13,34 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/cache"
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/otp"
 	"github.com/golang/protobuf/ptypes/empty"
 )
 
+// maxMsgSize наибольший размер сообщения: фрагменты ограничивает сервер
+// при согласовании параметров передачи
+const maxMsgSize = 64 << 20
+
 type Client struct {
 	conn       *grpc.ClientConn
 	client     pb.GophKeeperClient
+	addr       string
 	userTokens map[string]string
 	userName   string
+	cacheDir   string                     // каталог локального кэша, пусто - кэш отключен
+	caches     map[string]*cache.Cache    // кэши авторизованных пользователей
+	chunkSize  int                        // запрошенный размер фрагмента, 0 - по умолчанию сервера
+	parts      int                        // запрошенное число частей загрузки, 0 - по умолчанию сервера
+	transfer   *pb.BinaryTransferResponse // согласованные параметры передачи, nil - не согласованы
+	progress   func(sent int64)           // отслеживание загрузки, может быть nil
 }
 
-func NewClient(addr string) (*Client, error) {
+// NewClient конструктор клиента. Если указан каталог кэша,
+// данные пользователей доступны без связи с сервером.
+func NewClient(addr, cacheDir string) (*Client, error) {
 	var (
 		client Client
 		err    error
@@ -32,12 +48,16 @@ func NewClient(addr string) (*Client, error) {
 
 	// устанавливаем соединение с сервером
 	client.conn, err = grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(echoInterceptor))
+		grpc.WithUnaryInterceptor(echoInterceptor),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize), grpc.MaxCallSendMsgSize(maxMsgSize)))
 	if err != nil {
 		return nil, err
 	}
 
+	client.addr = addr
+	client.cacheDir = cacheDir
 	client.userTokens = make(map[string]string, 1)
+	client.caches = make(map[string]*cache.Cache, 1)
 
 	// Получаем переменную интерфейсного типа UserClient,
 	// через которую будем отправлять сообщения
@@ -81,6 +101,11 @@ func (c *Client) withToken(ctx context.Context) context.Context {
 }
 
 func (c *Client) Close() error {
+	for _, vault := range c.caches {
+		if err := vault.Close(); err != nil {
+			logger.Errorf("close cache error: %w", err)
+		}
+	}
 	return c.conn.Close()
 }
 
@@ -119,11 +144,17 @@ func (c *Client) Login(login, passwd string) error {
 		Password: passwd,
 	}
 	resp, err := c.client.Login(context.Background(), &req)
-	if err == nil {
-		c.userName = login
-		c.userTokens[login] = resp.Token
+	if isUnavailable(err) {
+		return c.loginOffline(login, passwd)
+	} else if err != nil {
+		return err
 	}
-	return err
+	if err = c.openCache(login, passwd); err != nil {
+		return err
+	}
+	c.userName = login
+	c.userTokens[login] = resp.Token
+	return nil
 }
 
 func (c *Client) Registration(login, passwd string) error {
@@ -132,11 +163,15 @@ func (c *Client) Registration(login, passwd string) error {
 		Password: passwd,
 	}
 	resp, err := c.client.Register(context.Background(), &req)
-	if err == nil {
-		c.userName = login
-		c.userTokens[login] = resp.Token
+	if err != nil {
+		return err
 	}
-	return err
+	if err = c.openCache(login, passwd); err != nil {
+		return err
+	}
+	c.userName = login
+	c.userTokens[login] = resp.Token
+	return nil
 }
 
 func (c *Client) List() (*pb.ListResponse, error) {
@@ -146,29 +181,43 @@ func (c *Client) List() (*pb.ListResponse, error) {
 
 // Cards //
 
-func (c *Client) CardList() ([]string, error) {
+func (c *Client) CardList(in *pb.ListRequest) ([]*pb.ListEntry, string, error) {
 	ctx := c.withToken(context.Background())
-	resp, err := c.client.CardList(ctx, &empty.Empty{})
+	resp, err := c.client.CardList(ctx, in)
 	if err != nil {
-		return nil, err
+		return c.entriesFromCache(err, kindCard, in)
 	}
-	return resp.Names, nil
+	c.pruneListed(kindCard, in, resp.Entries, resp.NextCursor)
+	return resp.Entries, resp.NextCursor, nil
 }
 
 func (c *Client) CardWrite(in *pb.CardWriteRequest) error {
 	ctx := c.withToken(context.Background())
 	_, err := c.client.CardWrite(ctx, in)
-	return err
+	if err != nil {
+		return c.offline(err, kindCard, actionCreate, in.Name, in)
+	}
+	c.refreshCache(ctx, kindCard, in.Name)
+	return nil
 }
 
 func (c *Client) CardRead(in *pb.CardReadRequest) (*pb.CardReadResponse, error) {
 	ctx := c.withToken(context.Background())
-	return c.client.CardRead(ctx, in)
+	resp, err := c.client.CardRead(ctx, in)
+	if err != nil {
+		var cached pb.CardReadResponse
+		if c.fromCache(err, kindCard, in.Name, &cached) {
+			return &cached, nil
+		}
+		return nil, err
+	}
+	c.toCache(kindCard, resp.Name, resp)
+	return resp, nil
 }
 
 func (c *Client) CardUpdate(name string, in *pb.CardWriteRequest) error {
 	ctx := c.withToken(context.Background())
-	pass, err := c.client.CardRead(ctx, &pb.CardReadRequest{
+	pass, err := c.CardRead(&pb.CardReadRequest{
 		Name: name,
 	})
 	if err != nil {
@@ -179,40 +228,69 @@ func (c *Client) CardUpdate(name string, in *pb.CardWriteRequest) error {
 		Write: in,
 	}
 	_, err = c.client.CardUpdate(ctx, &req)
-	return err
+	if err != nil {
+		return c.offline(err, kindCard, actionUpdate, name, in)
+	}
+	if name != in.Name {
+		c.refreshCache(ctx, kindCard, name)
+	}
+	c.refreshCache(ctx, kindCard, in.Name)
+	return nil
 }
 
 func (c *Client) CardDelete(in *pb.CardDelRequest) error {
 	ctx := c.withToken(context.Background())
 	_, err := c.client.CardDelete(ctx, in)
-	return err
+	if err != nil {
+		return c.offline(err, kindCard, actionDelete, in.Name, nil)
+	}
+	c.refreshCache(ctx, kindCard, in.Name)
+	return nil
+}
+
+func (c *Client) CardRename(name, newName string) error {
+	return c.rename(kindCard, name, newName)
 }
 
 // Notes //
 
-func (c *Client) NoteList() ([]string, error) {
+func (c *Client) NoteList(in *pb.ListRequest) ([]*pb.ListEntry, string, error) {
 	ctx := c.withToken(context.Background())
-	resp, err := c.client.NoteList(ctx, &empty.Empty{})
+	resp, err := c.client.NoteList(ctx, in)
 	if err != nil {
-		return nil, err
+		return c.entriesFromCache(err, kindNote, in)
 	}
-	return resp.Names, nil
+	c.pruneListed(kindNote, in, resp.Entries, resp.NextCursor)
+	return resp.Entries, resp.NextCursor, nil
 }
 
 func (c *Client) NoteWrite(in *pb.NoteWriteRequest) error {
 	ctx := c.withToken(context.Background())
 	_, err := c.client.NoteWrite(ctx, in)
-	return err
+	if err != nil {
+		return c.offline(err, kindNote, actionCreate, in.Name, in)
+	}
+	c.refreshCache(ctx, kindNote, in.Name)
+	return nil
 }
 
 func (c *Client) NoteRead(in *pb.NoteReadRequest) (*pb.NoteReadResponse, error) {
 	ctx := c.withToken(context.Background())
-	return c.client.NoteRead(ctx, in)
+	resp, err := c.client.NoteRead(ctx, in)
+	if err != nil {
+		var cached pb.NoteReadResponse
+		if c.fromCache(err, kindNote, in.Name, &cached) {
+			return &cached, nil
+		}
+		return nil, err
+	}
+	c.toCache(kindNote, resp.Name, resp)
+	return resp, nil
 }
 
 func (c *Client) NoteUpdate(name string, in *pb.NoteWriteRequest) error {
 	ctx := c.withToken(context.Background())
-	pass, err := c.client.NoteRead(ctx, &pb.NoteReadRequest{
+	pass, err := c.NoteRead(&pb.NoteReadRequest{
 		Name: name,
 	})
 	if err != nil {
@@ -223,24 +301,296 @@ func (c *Client) NoteUpdate(name string, in *pb.NoteWriteRequest) error {
 		Write: in,
 	}
 	_, err = c.client.NoteUpdate(ctx, &req)
-	return err
+	if err != nil {
+		return c.offline(err, kindNote, actionUpdate, name, in)
+	}
+	if name != in.Name {
+		c.refreshCache(ctx, kindNote, name)
+	}
+	c.refreshCache(ctx, kindNote, in.Name)
+	return nil
 }
 
 func (c *Client) NoteDelete(in *pb.NoteDelRequest) error {
 	ctx := c.withToken(context.Background())
 	_, err := c.client.NoteDelete(ctx, in)
-	return err
+	if err != nil {
+		return c.offline(err, kindNote, actionDelete, in.Name, nil)
+	}
+	c.refreshCache(ctx, kindNote, in.Name)
+	return nil
 }
 
-// Binaries //
+func (c *Client) NoteRename(name, newName string) error {
+	return c.rename(kindNote, name, newName)
+}
+
+// Items //
+
+func (c *Client) ItemList(in *pb.ListRequest) ([]*pb.ListEntry, string, error) {
+	ctx := c.withToken(context.Background())
+	resp, err := c.client.ItemList(ctx, in)
+	if err != nil {
+		return c.entriesFromCache(err, kindItem, in)
+	}
+	c.pruneListed(kindItem, in, resp.Entries, resp.NextCursor)
+	return resp.Entries, resp.NextCursor, nil
+}
+
+func (c *Client) ItemWrite(in *pb.ItemWriteRequest) error {
+	ctx := c.withToken(context.Background())
+	_, err := c.client.ItemWrite(ctx, in)
+	if err != nil {
+		return c.offline(err, kindItem, actionCreate, in.Name, in)
+	}
+	c.refreshCache(ctx, kindItem, in.Name)
+	return nil
+}
+
+func (c *Client) ItemRead(in *pb.ItemReadRequest) (*pb.ItemReadResponse, error) {
+	ctx := c.withToken(context.Background())
+	resp, err := c.client.ItemRead(ctx, in)
+	if err != nil {
+		var cached pb.ItemReadResponse
+		if c.fromCache(err, kindItem, in.Name, &cached) {
+			return &cached, nil
+		}
+		return nil, err
+	}
+	c.toCache(kindItem, resp.Name, resp)
+	return resp, nil
+}
+
+func (c *Client) ItemUpdate(name string, in *pb.ItemWriteRequest) error {
+	ctx := c.withToken(context.Background())
+	item, err := c.ItemRead(&pb.ItemReadRequest{
+		Name: name,
+	})
+	if err != nil {
+		return err
+	}
+	req := pb.ItemUpdateRequest{
+		Id:    item.Id,
+		Write: in,
+	}
+	_, err = c.client.ItemUpdate(ctx, &req)
+	if err != nil {
+		return c.offline(err, kindItem, actionUpdate, name, in)
+	}
+	if name != in.Name {
+		c.refreshCache(ctx, kindItem, name)
+	}
+	c.refreshCache(ctx, kindItem, in.Name)
+	return nil
+}
+
+func (c *Client) ItemDelete(in *pb.ItemDelRequest) error {
+	ctx := c.withToken(context.Background())
+	_, err := c.client.ItemDelete(ctx, in)
+	if err != nil {
+		return c.offline(err, kindItem, actionDelete, in.Name, nil)
+	}
+	c.refreshCache(ctx, kindItem, in.Name)
+	return nil
+}
+
+func (c *Client) ItemRename(name, newName string) error {
+	return c.rename(kindItem, name, newName)
+}
+
+// One-time passwords //
+
+func (c *Client) OtpList(in *pb.ListRequest) ([]*pb.ListEntry, string, error) {
+	ctx := c.withToken(context.Background())
+	resp, err := c.client.OtpList(ctx, in)
+	if err != nil {
+		return c.entriesFromCache(err, kindOtp, in)
+	}
+	c.pruneListed(kindOtp, in, resp.Entries, resp.NextCursor)
+	return resp.Entries, resp.NextCursor, nil
+}
+
+func (c *Client) OtpWrite(in *pb.OtpWriteRequest) error {
+	ctx := c.withToken(context.Background())
+	_, err := c.client.OtpWrite(ctx, in)
+	if err != nil {
+		return c.offline(err, kindOtp, actionCreate, in.Name, in)
+	}
+	c.refreshCache(ctx, kindOtp, in.Name)
+	return nil
+}
+
+func (c *Client) OtpRead(in *pb.OtpReadRequest) (*pb.OtpReadResponse, error) {
+	ctx := c.withToken(context.Background())
+	resp, err := c.client.OtpRead(ctx, in)
+	if err != nil {
+		var cached pb.OtpReadResponse
+		if c.fromCache(err, kindOtp, in.Name, &cached) {
+			return &cached, nil
+		}
+		return nil, err
+	}
+	c.toCache(kindOtp, resp.Name, resp)
+	return resp, nil
+}
+
+func (c *Client) OtpUpdate(name string, in *pb.OtpWriteRequest) error {
+	ctx := c.withToken(context.Background())
+	item, err := c.OtpRead(&pb.OtpReadRequest{
+		Name: name,
+	})
+	if err != nil {
+		return err
+	}
+	req := pb.OtpUpdateRequest{
+		Id:    item.Id,
+		Write: in,
+	}
+	_, err = c.client.OtpUpdate(ctx, &req)
+	if err != nil {
+		return c.offline(err, kindOtp, actionUpdate, name, in)
+	}
+	if name != in.Name {
+		c.refreshCache(ctx, kindOtp, name)
+	}
+	c.refreshCache(ctx, kindOtp, in.Name)
+	return nil
+}
+
+func (c *Client) OtpDelete(in *pb.OtpDelRequest) error {
+	ctx := c.withToken(context.Background())
+	_, err := c.client.OtpDelete(ctx, in)
+	if err != nil {
+		return c.offline(err, kindOtp, actionDelete, in.Name, nil)
+	}
+	c.refreshCache(ctx, kindOtp, in.Name)
+	return nil
+}
+
+func (c *Client) OtpRename(name, newName string) error {
+	return c.rename(kindOtp, name, newName)
+}
+
+// OtpCode текущий одноразовый пароль. Без связи с сервером пароль TOTP
+// генерируется по секрету из кэша, для HOTP нужен счётчик сервера.
+func (c *Client) OtpCode(in *pb.OtpCodeRequest) (*pb.OtpCodeResponse, error) {
+	ctx := c.withToken(context.Background())
+	resp, err := c.client.OtpCode(ctx, in)
+	if err == nil {
+		return resp, nil
+	}
+
+	var cached pb.OtpReadResponse
+	if !c.fromCache(err, kindOtp, in.Name, &cached) || cached.Type != pb.OtpType_OTP_TYPE_TOTP {
+		return nil, err
+	}
+	p, err := OtpParams(&cached)
+	if err != nil {
+		return nil, err
+	}
+	code, left := p.TOTP(time.Now())
+	return &pb.OtpCodeResponse{Code: code, Remaining: uint32(left / time.Second)}, nil
+}
+
+// OtpParams параметры генерации паролей прочитанного секрета
+func OtpParams(in *pb.OtpReadResponse) (p otp.Params, err error) {
+	p = otp.Params{
+		Type:      strings.ToLower(strings.TrimPrefix(in.Type.String(), "OTP_TYPE_")),
+		Issuer:    in.Issuer,
+		Account:   in.Account,
+		Algorithm: strings.TrimPrefix(in.Algorithm.String(), "OTP_ALGORITHM_"),
+		Digits:    int(in.Digits),
+		Period:    time.Duration(in.Period) * time.Second,
+		Counter:   in.Counter,
+	}
+	if p.Secret, err = otp.DecodeSecret(in.Secret); err != nil {
+		return
+	}
+	return p, p.Validate()
+}
+
+// SSH keys //
+
+func (c *Client) SshKeyList(in *pb.ListRequest) ([]*pb.ListEntry, string, error) {
+	ctx := c.withToken(context.Background())
+	resp, err := c.client.SshKeyList(ctx, in)
+	if err != nil {
+		return c.entriesFromCache(err, kindSshKey, in)
+	}
+	c.pruneListed(kindSshKey, in, resp.Entries, resp.NextCursor)
+	return resp.Entries, resp.NextCursor, nil
+}
 
-func (c *Client) BinaryList() ([]string, error) {
+func (c *Client) SshKeyWrite(in *pb.SshKeyWriteRequest) error {
 	ctx := c.withToken(context.Background())
-	resp, err := c.client.BinaryList(ctx, &empty.Empty{})
+	_, err := c.client.SshKeyWrite(ctx, in)
 	if err != nil {
+		return c.offline(err, kindSshKey, actionCreate, in.Name, in)
+	}
+	c.refreshCache(ctx, kindSshKey, in.Name)
+	return nil
+}
+
+func (c *Client) SshKeyRead(in *pb.SshKeyReadRequest) (*pb.SshKeyReadResponse, error) {
+	ctx := c.withToken(context.Background())
+	resp, err := c.client.SshKeyRead(ctx, in)
+	if err != nil {
+		var cached pb.SshKeyReadResponse
+		if c.fromCache(err, kindSshKey, in.Name, &cached) {
+			return &cached, nil
+		}
 		return nil, err
 	}
-	return resp.Names, nil
+	c.toCache(kindSshKey, resp.Name, resp)
+	return resp, nil
+}
+
+func (c *Client) SshKeyUpdate(name string, in *pb.SshKeyWriteRequest) error {
+	ctx := c.withToken(context.Background())
+	item, err := c.SshKeyRead(&pb.SshKeyReadRequest{
+		Name: name,
+	})
+	if err != nil {
+		return err
+	}
+	req := pb.SshKeyUpdateRequest{
+		Id:    item.Id,
+		Write: in,
+	}
+	_, err = c.client.SshKeyUpdate(ctx, &req)
+	if err != nil {
+		return c.offline(err, kindSshKey, actionUpdate, name, in)
+	}
+	if name != in.Name {
+		c.refreshCache(ctx, kindSshKey, name)
+	}
+	c.refreshCache(ctx, kindSshKey, in.Name)
+	return nil
+}
+
+func (c *Client) SshKeyDelete(in *pb.SshKeyDelRequest) error {
+	ctx := c.withToken(context.Background())
+	_, err := c.client.SshKeyDelete(ctx, in)
+	if err != nil {
+		return c.offline(err, kindSshKey, actionDelete, in.Name, nil)
+	}
+	c.refreshCache(ctx, kindSshKey, in.Name)
+	return nil
+}
+
+func (c *Client) SshKeyRename(name, newName string) error {
+	return c.rename(kindSshKey, name, newName)
+}
+
+// Binaries //
+
+func (c *Client) BinaryList(in *pb.ListRequest) ([]*pb.ListEntry, string, error) {
+	ctx := c.withToken(context.Background())
+	resp, err := c.client.BinaryList(ctx, in)
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Entries, resp.NextCursor, nil
 }
 
 func (c *Client) BinaryWrite(in *pb.BinaryWriteRequest) (int64, error) {
@@ -274,102 +624,180 @@ func (c *Client) BinaryDelete(in *pb.BinaryDelRequest) error {
 	return err
 }
 
-func (c *Client) BinaryUpload(id int64, r io.Reader) error {
+func (c *Client) BinaryRename(name, newName string) error {
+	return c.rename(kindBinary, name, newName)
+}
+
+// Password //
+
+func (c *Client) PasswordList(in *pb.ListRequest) ([]*pb.ListEntry, string, error) {
 	ctx := c.withToken(context.Background())
-	client, err := c.client.BinaryUpload(ctx)
+	resp, err := c.client.PasswordList(ctx, in)
 	if err != nil {
-		return err
+		return c.entriesFromCache(err, kindPassword, in)
 	}
+	c.pruneListed(kindPassword, in, resp.Entries, resp.NextCursor)
+	return resp.Entries, resp.NextCursor, nil
+}
 
-	chSize := 4096
-	upload := pb.BinaryUplodStream{
-		Chunk: make([]byte, chSize),
+func (c *Client) PasswordWrite(in *pb.PasswordWriteRequest) error {
+	ctx := c.withToken(context.Background())
+	_, err := c.client.PasswordWrite(ctx, in)
+	if err != nil {
+		return c.offline(err, kindPassword, actionCreate, in.Name, in)
 	}
+	c.refreshCache(ctx, kindPassword, in.Name)
+	return nil
+}
 
-	for err == nil {
-		var n int
-		n, err = r.Read(upload.Chunk)
-		if n > 0 && (err == nil || err == io.EOF) {
-			upload.Id = id
-			upload.Chunk = upload.Chunk[:n]
-			err = client.Send(&upload)
+func (c *Client) PasswordRead(in *pb.PasswordReadRequest) (*pb.PasswordReadResponse, error) {
+	ctx := c.withToken(context.Background())
+	resp, err := c.client.PasswordRead(ctx, in)
+	if err != nil {
+		var cached pb.PasswordReadResponse
+		if c.fromCache(err, kindPassword, in.Name, &cached) {
+			return &cached, nil
 		}
+		return nil, err
 	}
-	if err == io.EOF {
-		err = nil
+	c.toCache(kindPassword, resp.Name, resp)
+	return resp, nil
+}
+
+func (c *Client) PasswordUpdate(name string, in *pb.PasswordWriteRequest) error {
+	ctx := c.withToken(context.Background())
+	pass, err := c.PasswordRead(&pb.PasswordReadRequest{
+		Name: name,
+	})
+	if err != nil {
+		return err
 	}
-	if _, e := client.CloseAndRecv(); e != nil && err == nil {
-		err = e
+	req := pb.PasswordUpdateRequest{
+		Id:    pass.Id,
+		Write: in,
 	}
-	return err
+	_, err = c.client.PasswordUpdate(ctx, &req)
+	if err != nil {
+		return c.offline(err, kindPassword, actionUpdate, name, in)
+	}
+	if name != in.Name {
+		c.refreshCache(ctx, kindPassword, name)
+	}
+	c.refreshCache(ctx, kindPassword, in.Name)
+	return nil
 }
 
-func (c *Client) BinaryDownload(id int64, w io.Writer) error {
-
+func (c *Client) PasswordDelete(in *pb.PasswordDelRequest) error {
 	ctx := c.withToken(context.Background())
-	req := pb.BidaryDownloadRequest{
-		Id: id,
+	_, err := c.client.PasswordDelete(ctx, in)
+	if err != nil {
+		return c.offline(err, kindPassword, actionDelete, in.Name, nil)
 	}
+	c.refreshCache(ctx, kindPassword, in.Name)
+	return nil
+}
+
+func (c *Client) PasswordRename(name, newName string) error {
+	return c.rename(kindPassword, name, newName)
+}
+
+// Watch //
 
-	download, err := c.client.BinaryDownload(ctx, &req)
+// Watch подписка на изменения хранилища текущего пользователя.
+// Блокируется до завершения контекста или обрыва соединения.
+func (c *Client) Watch(ctx context.Context, fn func(*pb.WatchEvent)) error {
+	stream, err := c.client.Watch(c.withToken(ctx), &empty.Empty{})
 	if err != nil {
 		return err
 	}
 
-	for err == nil {
-		var stream *pb.BinaryDownloadStream
-		stream, err = download.Recv()
-		if err == nil {
-			logger.Debug("download", "err", err, "stream", stream)
-			_, err = w.Write(stream.Chunk)
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			return err
 		}
+		fn(event)
 	}
-	if err == io.EOF {
-		return nil
+}
+
+// Batch пакетное выполнение операций в одной транзакции
+func (c *Client) Batch(in *pb.BatchRequest) (*pb.BatchResponse, error) {
+	return c.client.Batch(c.withToken(context.Background()), in)
+}
+
+// rename переименование элемента на сервере с сохранением идентификатора
+func (c *Client) rename(kind, name, newName string) error {
+	ctx := c.withToken(context.Background())
+	_, err := c.client.Rename(ctx, &pb.RenameRequest{
+		Kind:    kind,
+		Name:    name,
+		NewName: newName,
+	})
+	if err != nil {
+		return c.renameOffline(err, kind, name, newName)
 	}
-	return err
+	if _, ok := c.vaultKinds()[kind]; ok {
+		c.refreshCache(ctx, kind, name)
+		c.refreshCache(ctx, kind, newName)
+	}
+	return nil
 }
 
-// Password //
+// Search //
 
-func (c *Client) PasswordList() ([]string, error) {
+func (c *Client) Search(in *pb.SearchRequest) ([]*pb.SearchResult, error) {
 	ctx := c.withToken(context.Background())
-	resp, err := c.client.PasswordList(ctx, &empty.Empty{})
+	resp, err := c.client.Search(ctx, in)
 	if err != nil {
-		return nil, err
+		return c.searchCache(err, in)
 	}
-	return resp.Names, nil
+	return resp.Results, nil
 }
 
-func (c *Client) PasswordWrite(in *pb.PasswordWriteRequest) error {
+// Tags //
+
+func (c *Client) TagAdd(kind, name string, tags ...string) error {
 	ctx := c.withToken(context.Background())
-	_, err := c.client.PasswordWrite(ctx, in)
+	_, err := c.client.TagAdd(ctx, &pb.TagRequest{Kind: kind, Name: name, Tags: tags})
 	return err
 }
 
-func (c *Client) PasswordRead(in *pb.PasswordReadRequest) (*pb.PasswordReadResponse, error) {
+func (c *Client) TagRemove(kind, name string, tags ...string) error {
 	ctx := c.withToken(context.Background())
-	return c.client.PasswordRead(ctx, in)
+	_, err := c.client.TagRemove(ctx, &pb.TagRequest{Kind: kind, Name: name, Tags: tags})
+	return err
 }
 
-func (c *Client) PasswordUpdate(name string, in *pb.PasswordWriteRequest) error {
+func (c *Client) TagList() ([]string, error) {
 	ctx := c.withToken(context.Background())
-	pass, err := c.client.PasswordRead(ctx, &pb.PasswordReadRequest{
-		Name: name,
-	})
+	resp, err := c.client.TagList(ctx, &empty.Empty{})
 	if err != nil {
-		return err
-	}
-	req := pb.PasswordUpdateRequest{
-		Id:    pass.Id,
-		Write: in,
+		return nil, err
 	}
-	_, err = c.client.PasswordUpdate(ctx, &req)
+	return resp.Tags, nil
+}
+
+// Folders //
+
+func (c *Client) FolderMove(kind, name, folder string) error {
+	ctx := c.withToken(context.Background())
+	_, err := c.client.FolderMove(ctx, &pb.FolderMoveRequest{Kind: kind, Name: name, Folder: folder})
 	return err
 }
 
-func (c *Client) PasswordDelete(in *pb.PasswordDelRequest) error {
+func (c *Client) FolderList() ([]string, error) {
 	ctx := c.withToken(context.Background())
-	_, err := c.client.PasswordDelete(ctx, in)
+	resp, err := c.client.FolderList(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Folders, nil
+}
+
+func (c *Client) FolderDelete(folder string) error {
+	ctx := c.withToken(context.Background())
+	_, err := c.client.FolderDelete(ctx, &pb.FolderDelRequest{Folder: folder})
 	return err
 }
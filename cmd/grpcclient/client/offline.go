@@ -0,0 +1,807 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/cache"
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+)
+
+// Виды данных, доступные без связи с сервером
+const (
+	kindPassword = "password"
+	kindCard     = "card"
+	kindNote     = "note"
+	kindItem     = "item"
+	kindOtp      = "otp"
+	kindSshKey   = "ssh"
+)
+
+// kindBinary файлы, доступны только при связи с сервером
+const kindBinary = "binary"
+
+// Отложенные действия
+const (
+	actionCreate = "create"
+	actionUpdate = "update"
+	actionDelete = "delete"
+)
+
+// ConflictStrategy способ разрешения конфликта при синхронизации
+type ConflictStrategy string
+
+const (
+	KeepLocal  ConflictStrategy = "local"  // локальные изменения перезаписывают серверные
+	KeepRemote ConflictStrategy = "remote" // локальные изменения отбрасываются
+	KeepBoth   ConflictStrategy = "both"   // локальная версия сохраняется под другим именем
+)
+
+// ParseConflictStrategy разбор способа разрешения конфликта
+func ParseConflictStrategy(s string) (ConflictStrategy, error) {
+	switch cs := ConflictStrategy(s); cs {
+	case KeepLocal, KeepRemote, KeepBoth:
+		return cs, nil
+	}
+	return "", fmt.Errorf("unknown conflict strategy: %s", s)
+}
+
+// SyncReport результат отправки отложенных изменений
+type SyncReport struct {
+	Applied   int // применено без конфликтов
+	Conflicts int // обнаружено конфликтов
+	Renamed   []string
+}
+
+// vaultKind операции с элементами одного вида на сервере
+type vaultKind struct {
+	read     func(ctx context.Context, name string) (proto.Message, error)
+	write    func(ctx context.Context, in proto.Message) error
+	update   func(ctx context.Context, id int64, in proto.Message) error
+	delete   func(ctx context.Context, name string) error
+	newRead  func() proto.Message
+	newWrite func() proto.Message
+}
+
+func (c *Client) vaultKinds() map[string]vaultKind {
+	return map[string]vaultKind{
+		kindPassword: {
+			read: func(ctx context.Context, name string) (proto.Message, error) {
+				return c.client.PasswordRead(ctx, &pb.PasswordReadRequest{Name: name})
+			},
+			write: func(ctx context.Context, in proto.Message) error {
+				_, err := c.client.PasswordWrite(ctx, in.(*pb.PasswordWriteRequest))
+				return err
+			},
+			update: func(ctx context.Context, id int64, in proto.Message) error {
+				_, err := c.client.PasswordUpdate(ctx, &pb.PasswordUpdateRequest{Id: id, Write: in.(*pb.PasswordWriteRequest)})
+				return err
+			},
+			delete: func(ctx context.Context, name string) error {
+				_, err := c.client.PasswordDelete(ctx, &pb.PasswordDelRequest{Name: name})
+				return err
+			},
+			newRead:  func() proto.Message { return new(pb.PasswordReadResponse) },
+			newWrite: func() proto.Message { return new(pb.PasswordWriteRequest) },
+		},
+		kindCard: {
+			read: func(ctx context.Context, name string) (proto.Message, error) {
+				return c.client.CardRead(ctx, &pb.CardReadRequest{Name: name})
+			},
+			write: func(ctx context.Context, in proto.Message) error {
+				_, err := c.client.CardWrite(ctx, in.(*pb.CardWriteRequest))
+				return err
+			},
+			update: func(ctx context.Context, id int64, in proto.Message) error {
+				_, err := c.client.CardUpdate(ctx, &pb.CardUpdateRequest{Id: id, Write: in.(*pb.CardWriteRequest)})
+				return err
+			},
+			delete: func(ctx context.Context, name string) error {
+				_, err := c.client.CardDelete(ctx, &pb.CardDelRequest{Name: name})
+				return err
+			},
+			newRead:  func() proto.Message { return new(pb.CardReadResponse) },
+			newWrite: func() proto.Message { return new(pb.CardWriteRequest) },
+		},
+		kindNote: {
+			read: func(ctx context.Context, name string) (proto.Message, error) {
+				return c.client.NoteRead(ctx, &pb.NoteReadRequest{Name: name})
+			},
+			write: func(ctx context.Context, in proto.Message) error {
+				_, err := c.client.NoteWrite(ctx, in.(*pb.NoteWriteRequest))
+				return err
+			},
+			update: func(ctx context.Context, id int64, in proto.Message) error {
+				_, err := c.client.NoteUpdate(ctx, &pb.NoteUpdateRequest{Id: id, Write: in.(*pb.NoteWriteRequest)})
+				return err
+			},
+			delete: func(ctx context.Context, name string) error {
+				_, err := c.client.NoteDelete(ctx, &pb.NoteDelRequest{Name: name})
+				return err
+			},
+			newRead:  func() proto.Message { return new(pb.NoteReadResponse) },
+			newWrite: func() proto.Message { return new(pb.NoteWriteRequest) },
+		},
+		kindItem: {
+			read: func(ctx context.Context, name string) (proto.Message, error) {
+				return c.client.ItemRead(ctx, &pb.ItemReadRequest{Name: name})
+			},
+			write: func(ctx context.Context, in proto.Message) error {
+				_, err := c.client.ItemWrite(ctx, in.(*pb.ItemWriteRequest))
+				return err
+			},
+			update: func(ctx context.Context, id int64, in proto.Message) error {
+				_, err := c.client.ItemUpdate(ctx, &pb.ItemUpdateRequest{Id: id, Write: in.(*pb.ItemWriteRequest)})
+				return err
+			},
+			delete: func(ctx context.Context, name string) error {
+				_, err := c.client.ItemDelete(ctx, &pb.ItemDelRequest{Name: name})
+				return err
+			},
+			newRead:  func() proto.Message { return new(pb.ItemReadResponse) },
+			newWrite: func() proto.Message { return new(pb.ItemWriteRequest) },
+		},
+		kindOtp: {
+			read: func(ctx context.Context, name string) (proto.Message, error) {
+				return c.client.OtpRead(ctx, &pb.OtpReadRequest{Name: name})
+			},
+			write: func(ctx context.Context, in proto.Message) error {
+				_, err := c.client.OtpWrite(ctx, in.(*pb.OtpWriteRequest))
+				return err
+			},
+			update: func(ctx context.Context, id int64, in proto.Message) error {
+				_, err := c.client.OtpUpdate(ctx, &pb.OtpUpdateRequest{Id: id, Write: in.(*pb.OtpWriteRequest)})
+				return err
+			},
+			delete: func(ctx context.Context, name string) error {
+				_, err := c.client.OtpDelete(ctx, &pb.OtpDelRequest{Name: name})
+				return err
+			},
+			newRead:  func() proto.Message { return new(pb.OtpReadResponse) },
+			newWrite: func() proto.Message { return new(pb.OtpWriteRequest) },
+		},
+		kindSshKey: {
+			read: func(ctx context.Context, name string) (proto.Message, error) {
+				return c.client.SshKeyRead(ctx, &pb.SshKeyReadRequest{Name: name})
+			},
+			write: func(ctx context.Context, in proto.Message) error {
+				_, err := c.client.SshKeyWrite(ctx, in.(*pb.SshKeyWriteRequest))
+				return err
+			},
+			update: func(ctx context.Context, id int64, in proto.Message) error {
+				_, err := c.client.SshKeyUpdate(ctx, &pb.SshKeyUpdateRequest{Id: id, Write: in.(*pb.SshKeyWriteRequest)})
+				return err
+			},
+			delete: func(ctx context.Context, name string) error {
+				_, err := c.client.SshKeyDelete(ctx, &pb.SshKeyDelRequest{Name: name})
+				return err
+			},
+			newRead:  func() proto.Message { return new(pb.SshKeyReadResponse) },
+			newWrite: func() proto.Message { return new(pb.SshKeyWriteRequest) },
+		},
+	}
+}
+
+// isUnavailable сервер недоступен, можно работать с кэшем
+func isUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// Offline текущий пользователь работает без связи с сервером
+func (c *Client) Offline() bool {
+	return c.userName != "" && c.userTokens[c.userName] == ""
+}
+
+// Pending количество неотправленных изменений текущего пользователя
+func (c *Client) Pending() int {
+	if vault := c.userCache(); vault != nil {
+		return vault.Pending()
+	}
+	return 0
+}
+
+func (c *Client) userCache() *cache.Cache {
+	return c.caches[c.userName]
+}
+
+// openCache открытие кэша пользователя, пароль служит ключом шифрования
+func (c *Client) openCache(login, passwd string) error {
+	if c.cacheDir == "" {
+		return nil
+	}
+	if _, ok := c.caches[login]; ok {
+		return nil
+	}
+	if err := os.MkdirAll(c.cacheDir, 0700); err != nil {
+		return err
+	}
+
+	path := filepath.Join(c.cacheDir, url.PathEscape(login+"@"+c.addr)+".db")
+	vault, err := cache.Open(path, passwd)
+	if err != nil {
+		return err
+	}
+	c.caches[login] = vault
+	return nil
+}
+
+// loginOffline вход по паролю от локального кэша
+func (c *Client) loginOffline(login, passwd string) error {
+	if c.cacheDir == "" {
+		return fmt.Errorf("server unavailable, offline cache disabled")
+	}
+	if err := c.openCache(login, passwd); err != nil {
+		return err
+	}
+	c.userName = login
+	c.userTokens[login] = ""
+	return nil
+}
+
+// version версия элемента - хеш ответа сервера
+func version(m proto.Message) string {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// toCache сохранение прочитанного с сервера элемента
+func (c *Client) toCache(kind, name string, resp proto.Message) {
+	vault := c.userCache()
+	if vault == nil {
+		return
+	}
+	data, err := proto.Marshal(resp)
+	if err == nil {
+		err = vault.Put(cache.Item{
+			Kind:    kind,
+			Name:    name,
+			Data:    data,
+			Version: version(resp),
+		})
+	}
+	if err != nil {
+		logger.Errorf("cache put error: %w", err)
+	}
+}
+
+// fromCache чтение элемента из кэша при недоступности сервера
+func (c *Client) fromCache(err error, kind, name string, resp proto.Message) bool {
+	vault := c.userCache()
+	if vault == nil || !isUnavailable(err) {
+		return false
+	}
+	item, err := vault.Get(kind, name)
+	if err != nil {
+		return false
+	}
+	return proto.Unmarshal(item.Data, resp) == nil && !expired(resp)
+}
+
+// entriesFromCache список элементов из кэша при недоступности сервера.
+// Фильтры запроса применяются локально, кэш отдаётся одной страницей.
+func (c *Client) entriesFromCache(err error, kind string, in *pb.ListRequest) ([]*pb.ListEntry, string, error) {
+	vault := c.userCache()
+	if vault == nil || !isUnavailable(err) {
+		return nil, "", err
+	}
+	if in.GetCursor() != "" {
+		return nil, "", nil
+	}
+	items, e := vault.Items(kind)
+	if e != nil {
+		return nil, "", err
+	}
+
+	entries := make([]*pb.ListEntry, 0, len(items))
+	for _, item := range items {
+		if !strings.HasPrefix(item.Name, in.GetPrefix()) {
+			continue
+		}
+		if ok, _ := path.Match(in.GetGlob(), item.Name); in.GetGlob() != "" && !ok {
+			continue
+		}
+		entry := pb.ListEntry{Name: item.Name}
+		resp := c.vaultKinds()[kind].newRead()
+		if proto.Unmarshal(item.Data, resp) == nil {
+			if expired(resp) {
+				continue
+			}
+			entry.Id = idOf(resp)
+			entry.ExpiresAt = expiresOf(resp)
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, "", nil
+}
+
+// searchCache нечёткий поиск по кэшу при недоступности сервера.
+// Совпадением считается вхождение символов запроса по порядку.
+func (c *Client) searchCache(err error, in *pb.SearchRequest) ([]*pb.SearchResult, error) {
+	vault := c.userCache()
+	if vault == nil || !isUnavailable(err) {
+		return nil, err
+	}
+
+	kinds := in.GetKinds()
+	if len(kinds) == 0 {
+		kinds = []string{kindPassword, kindCard, kindNote, kindItem, kindOtp, kindSshKey}
+	}
+
+	res := make([]*pb.SearchResult, 0)
+	for _, kind := range kinds {
+		vk, ok := c.vaultKinds()[kind]
+		if !ok {
+			continue
+		}
+		items, e := vault.Items(kind)
+		if e != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			best := &pb.SearchResult{Kind: kind, Name: item.Name, Matched: "name"}
+			best.Score = fuzzyScore(in.Query, item.Name)
+
+			resp := vk.newRead()
+			if p, ok := resp.(*pb.PasswordReadResponse); ok && proto.Unmarshal(item.Data, resp) == nil {
+				if s := fuzzyScore(in.Query, p.Url); s > best.Score {
+					best.Score, best.Matched = s, "url"
+				}
+				if s := fuzzyScore(in.Query, p.Username); p.Searchable && s > best.Score {
+					best.Score, best.Matched = s, "username"
+				}
+			}
+			if it, ok := resp.(*pb.ItemReadResponse); ok && proto.Unmarshal(item.Data, resp) == nil {
+				if s := fuzzyScore(in.Query, it.Type); s > best.Score {
+					best.Score, best.Matched = s, "type"
+				}
+			}
+			if o, ok := resp.(*pb.OtpReadResponse); ok && proto.Unmarshal(item.Data, resp) == nil {
+				if s := fuzzyScore(in.Query, o.Issuer); s > best.Score {
+					best.Score, best.Matched = s, "issuer"
+				}
+			}
+			if k, ok := resp.(*pb.SshKeyReadResponse); ok && proto.Unmarshal(item.Data, resp) == nil {
+				if s := fuzzyScore(in.Query, k.Comment); s > best.Score {
+					best.Score, best.Matched = s, "comment"
+				}
+			}
+			if best.Score > 0 {
+				res = append(res, best)
+			}
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		if res[i].Kind != res[j].Kind {
+			return res[i].Kind < res[j].Kind
+		}
+		return res[i].Name < res[j].Name
+	})
+	if limit := int(in.GetLimit()); limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+// fuzzyScore оценка совпадения без учёта регистра от 0 до 1:
+// подстрока оценивается выше, чем вхождение символов по порядку
+func fuzzyScore(query, s string) float32 {
+	q, t := []rune(strings.ToLower(query)), []rune(strings.ToLower(s))
+	if len(q) == 0 || len(t) == 0 {
+		return 0
+	}
+	ratio := float32(len(q)) / float32(len(t))
+	if strings.Contains(string(t), string(q)) {
+		return 0.5 + ratio/2
+	}
+
+	i := 0
+	for _, r := range t {
+		if i < len(q) && r == q[i] {
+			i++
+		}
+	}
+	if i < len(q) {
+		return 0
+	}
+	return ratio / 2
+}
+
+// pruneListed очистка кэша по полному списку с сервера.
+// Страница или отфильтрованный список не содержат всех элементов.
+func (c *Client) pruneListed(kind string, in *pb.ListRequest, entries []*pb.ListEntry, next string) {
+	if in.GetCursor() != "" || in.GetPrefix() != "" || in.GetGlob() != "" || next != "" {
+		return
+	}
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name
+	}
+	c.pruneCache(kind, names)
+}
+
+// pruneCache удаление из кэша элементов, удалённых на сервере
+func (c *Client) pruneCache(kind string, names []string) {
+	if vault := c.userCache(); vault != nil {
+		if err := vault.Prune(kind, names); err != nil {
+			logger.Errorf("cache prune error: %w", err)
+		}
+	}
+}
+
+// refreshCache перечитывание элемента с сервера после изменения
+func (c *Client) refreshCache(ctx context.Context, kind, name string) {
+	vault := c.userCache()
+	if vault == nil {
+		return
+	}
+	resp, err := c.vaultKinds()[kind].read(ctx, name)
+	switch {
+	case err == nil:
+		c.toCache(kind, name, resp)
+	case status.Code(err) == codes.NotFound:
+		err = vault.Delete(kind, name)
+	}
+	if err != nil {
+		logger.Errorf("cache refresh error: %w", err)
+	}
+}
+
+// offline постановка изменения в очередь при недоступности сервера.
+// Изменение сразу применяется к кэшу.
+func (c *Client) offline(err error, kind, action, name string, in proto.Message) error {
+	vault := c.userCache()
+	if vault == nil || !isUnavailable(err) {
+		return err
+	}
+
+	op := cache.Op{
+		Kind:   kind,
+		Action: action,
+		Name:   name,
+		Time:   time.Now(),
+	}
+
+	var prev []byte
+	if item, e := vault.Get(kind, name); e == nil {
+		op.Base = item.Version
+		op.Chained = item.Local
+		prev = item.Data
+	} else if action != actionCreate {
+		return fmt.Errorf("'%s' not in offline cache: %w", name, err)
+	}
+
+	if in != nil {
+		if op.Data, err = proto.Marshal(in); err != nil {
+			return err
+		}
+	}
+	if err = vault.Enqueue(op); err != nil {
+		return err
+	}
+
+	if action == actionDelete {
+		return vault.Delete(kind, name)
+	}
+
+	// ответ на чтение собирается из запроса на запись
+	resp := c.vaultKinds()[kind].newRead()
+	if prev != nil {
+		if err = proto.Unmarshal(prev, resp); err != nil {
+			return err
+		}
+	}
+	copyFields(in, resp)
+
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	newName := nameOf(in)
+	if newName != name {
+		if err = vault.Delete(kind, name); err != nil {
+			return err
+		}
+	}
+	return vault.Put(cache.Item{
+		Kind:    kind,
+		Name:    newName,
+		Data:    data,
+		Version: op.Base,
+		Local:   true,
+	})
+}
+
+// renameOffline переименование без связи с сервером, ставится в очередь
+// как обновление элемента с новым наименованием
+func (c *Client) renameOffline(err error, kind, name, newName string) error {
+	vault := c.userCache()
+	vk, ok := c.vaultKinds()[kind]
+	if vault == nil || !ok || !isUnavailable(err) {
+		return err
+	}
+
+	item, e := vault.Get(kind, name)
+	if e != nil {
+		return fmt.Errorf("'%s' not in offline cache: %w", name, err)
+	}
+	resp := vk.newRead()
+	if e = proto.Unmarshal(item.Data, resp); e != nil {
+		return e
+	}
+
+	in := vk.newWrite()
+	copyFields(resp, in)
+	setName(in, newName)
+	return c.offline(err, kind, actionUpdate, name, in)
+}
+
+// syncState состояние элемента на сервере в процессе синхронизации
+type syncState struct {
+	name    string // наименование на сервере
+	version string
+	dropped bool // локальные изменения отброшены
+}
+
+// Sync отправка отложенных изменений на сервер.
+// При обрыве связи неотправленные изменения остаются в очереди.
+func (c *Client) Sync(strategy ConflictStrategy) (report SyncReport, err error) {
+	vault := c.userCache()
+	if vault == nil {
+		return report, fmt.Errorf("offline cache disabled")
+	}
+	if c.Offline() {
+		return report, fmt.Errorf("offline mode, login to synchronize")
+	}
+
+	ops, err := vault.Queue()
+	if err != nil {
+		return report, err
+	}
+
+	ctx := c.withToken(context.Background())
+	kinds := c.vaultKinds()
+	states := make(map[string]*syncState)
+
+	for _, op := range ops {
+		kind, ok := kinds[op.Kind]
+		if !ok {
+			return report, fmt.Errorf("unknown kind: %s", op.Kind)
+		}
+
+		name, base := op.Name, op.Base
+		if st := states[op.Kind+"/"+op.Name]; op.Chained && st != nil {
+			if st.dropped {
+				if err = vault.Dequeue(op.Seq); err != nil {
+					return report, err
+				}
+				continue
+			}
+			name, base = st.name, st.version
+		}
+
+		var in proto.Message
+		if op.Data != nil {
+			in = kind.newWrite()
+			if err = proto.Unmarshal(op.Data, in); err != nil {
+				return report, err
+			}
+		}
+
+		st, err := c.replay(ctx, kind, op, name, base, in, strategy, &report)
+		if err != nil {
+			return report, err
+		}
+
+		key := op.Kind + "/" + op.Name
+		if in != nil {
+			key = op.Kind + "/" + nameOf(in)
+		}
+		states[key] = st
+
+		if err = vault.Dequeue(op.Seq); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// replay применение одной отложенной операции
+func (c *Client) replay(ctx context.Context, kind vaultKind, op cache.Op, name, base string,
+	in proto.Message, strategy ConflictStrategy, report *SyncReport) (*syncState, error) {
+
+	// текущее состояние на сервере
+	var (
+		remoteID      int64
+		remoteVersion string
+	)
+	remote, err := kind.read(ctx, name)
+	if err == nil {
+		remoteID = idOf(remote)
+		remoteVersion = version(remote)
+	} else if status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	var conflict bool
+	switch op.Action {
+	case actionCreate:
+		conflict = remoteVersion != ""
+	case actionUpdate:
+		conflict = remoteVersion != base
+	case actionDelete:
+		conflict = remoteVersion != "" && remoteVersion != base
+	}
+
+	target := name
+	if in != nil {
+		target = nameOf(in)
+	}
+
+	if !conflict {
+		report.Applied++
+		switch op.Action {
+		case actionCreate:
+			err = kind.write(ctx, in)
+		case actionUpdate:
+			err = kind.update(ctx, remoteID, in)
+		case actionDelete:
+			if remoteVersion != "" {
+				err = kind.delete(ctx, name)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return c.syncDone(ctx, op.Kind, name, target)
+	}
+
+	report.Conflicts++
+	switch {
+	case strategy == KeepLocal:
+		switch {
+		case op.Action == actionDelete:
+			err = kind.delete(ctx, name)
+		case remoteVersion != "":
+			err = kind.update(ctx, remoteID, in)
+		default:
+			err = kind.write(ctx, in)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return c.syncDone(ctx, op.Kind, name, target)
+
+	case strategy == KeepBoth && op.Action != actionDelete:
+		target, err = c.freeName(ctx, kind, target)
+		if err != nil {
+			return nil, err
+		}
+		setName(in, target)
+		if err = kind.write(ctx, in); err != nil {
+			return nil, err
+		}
+		report.Renamed = append(report.Renamed, target)
+		c.refreshCache(ctx, op.Kind, name)
+		return c.syncDone(ctx, op.Kind, nameOf(in), target)
+
+	default:
+		// остаётся версия сервера
+		c.refreshCache(ctx, op.Kind, name)
+		if target != name {
+			c.refreshCache(ctx, op.Kind, target)
+		}
+		return &syncState{name: name, version: remoteVersion, dropped: true}, nil
+	}
+}
+
+// syncDone обновление кэша после применения операции
+func (c *Client) syncDone(ctx context.Context, kind, name, target string) (*syncState, error) {
+	if target != name {
+		c.refreshCache(ctx, kind, name)
+	}
+
+	st := syncState{name: target}
+	resp, err := c.vaultKinds()[kind].read(ctx, target)
+	if err == nil {
+		st.version = version(resp)
+		c.toCache(kind, target, resp)
+	} else if status.Code(err) == codes.NotFound {
+		err = c.userCache().Delete(kind, target)
+	}
+	return &st, err
+}
+
+// freeName свободное наименование для локальной версии элемента
+func (c *Client) freeName(ctx context.Context, kind vaultKind, name string) (string, error) {
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s (local)", name)
+		if i > 1 {
+			candidate = fmt.Sprintf("%s (local %d)", name, i)
+		}
+		_, err := kind.read(ctx, candidate)
+		if status.Code(err) == codes.NotFound {
+			return candidate, nil
+		} else if err != nil {
+			return "", err
+		}
+	}
+}
+
+// copyFields копирование одноимённых полей сообщений
+func copyFields(from, to proto.Message) {
+	src, dst := from.ProtoReflect(), to.ProtoReflect()
+	fields := dst.Descriptor().Fields()
+
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if f := fields.ByName(fd.Name()); f != nil && f.Kind() == fd.Kind() {
+			dst.Set(f, v)
+		}
+		return true
+	})
+}
+
+func nameOf(m proto.Message) string {
+	r := m.ProtoReflect()
+	if f := r.Descriptor().Fields().ByName("name"); f != nil {
+		return r.Get(f).String()
+	}
+	return ""
+}
+
+func setName(m proto.Message, name string) {
+	r := m.ProtoReflect()
+	if f := r.Descriptor().Fields().ByName("name"); f != nil {
+		r.Set(f, protoreflect.ValueOfString(name))
+	}
+}
+
+func expiresOf(m proto.Message) *timestamppb.Timestamp {
+	r := m.ProtoReflect()
+	if f := r.Descriptor().Fields().ByName("expires_at"); f != nil && r.Has(f) {
+		ts, _ := r.Get(f).Message().Interface().(*timestamppb.Timestamp)
+		return ts
+	}
+	return nil
+}
+
+// expired срок хранения элемента истёк, сервер его уже не отдаёт
+func expired(m proto.Message) bool {
+	ts := expiresOf(m)
+	return ts != nil && !ts.AsTime().After(time.Now())
+}
+
+func idOf(m proto.Message) int64 {
+	r := m.ProtoReflect()
+	if f := r.Descriptor().Fields().ByName("id"); f != nil {
+		return r.Get(f).Int()
+	}
+	return 0
+}
@@ -0,0 +1,409 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/cache"
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/sshkey"
+)
+
+// fakeNotes сервер заметок в памяти
+type fakeNotes struct {
+	pb.GophKeeperClient
+	down   bool
+	nextID int64
+	notes  map[string]*pb.NoteReadResponse
+}
+
+var errDown = status.Error(codes.Unavailable, "server down")
+
+func (f *fakeNotes) NoteList(context.Context, *pb.ListRequest, ...grpc.CallOption) (*pb.NoteListResponse, error) {
+	if f.down {
+		return nil, errDown
+	}
+	var resp pb.NoteListResponse
+	for name, n := range f.notes {
+		resp.Entries = append(resp.Entries, &pb.ListEntry{Id: n.Id, Name: name})
+	}
+	return &resp, nil
+}
+
+func (f *fakeNotes) Search(context.Context, *pb.SearchRequest, ...grpc.CallOption) (*pb.SearchResponse, error) {
+	return nil, errDown
+}
+
+func (f *fakeNotes) NoteRead(_ context.Context, in *pb.NoteReadRequest, _ ...grpc.CallOption) (*pb.NoteReadResponse, error) {
+	if f.down {
+		return nil, errDown
+	}
+	n, ok := f.notes[in.Name]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return proto.Clone(n).(*pb.NoteReadResponse), nil
+}
+
+func (f *fakeNotes) NoteWrite(_ context.Context, in *pb.NoteWriteRequest, _ ...grpc.CallOption) (*empty.Empty, error) {
+	if f.down {
+		return nil, errDown
+	}
+	if _, ok := f.notes[in.Name]; ok {
+		return nil, status.Error(codes.AlreadyExists, "exists")
+	}
+	f.nextID++
+	f.notes[in.Name] = &pb.NoteReadResponse{Id: f.nextID, Name: in.Name, Notes: in.Notes}
+	return &empty.Empty{}, nil
+}
+
+func (f *fakeNotes) NoteUpdate(_ context.Context, in *pb.NoteUpdateRequest, _ ...grpc.CallOption) (*empty.Empty, error) {
+	if f.down {
+		return nil, errDown
+	}
+	for name, n := range f.notes {
+		if n.Id == in.Id {
+			delete(f.notes, name)
+			f.notes[in.Write.Name] = &pb.NoteReadResponse{Id: n.Id, Name: in.Write.Name, Notes: in.Write.Notes}
+			return &empty.Empty{}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func (f *fakeNotes) NoteDelete(_ context.Context, in *pb.NoteDelRequest, _ ...grpc.CallOption) (*empty.Empty, error) {
+	if f.down {
+		return nil, errDown
+	}
+	if _, ok := f.notes[in.Name]; !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	delete(f.notes, in.Name)
+	return &empty.Empty{}, nil
+}
+
+func (f *fakeNotes) Rename(_ context.Context, in *pb.RenameRequest, _ ...grpc.CallOption) (*empty.Empty, error) {
+	if f.down {
+		return nil, errDown
+	}
+	n, ok := f.notes[in.Name]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	delete(f.notes, in.Name)
+	n.Name = in.NewName
+	f.notes[in.NewName] = n
+	return &empty.Empty{}, nil
+}
+
+func (f *fakeNotes) ItemWrite(context.Context, *pb.ItemWriteRequest, ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, errDown
+}
+
+func (f *fakeNotes) ItemRead(context.Context, *pb.ItemReadRequest, ...grpc.CallOption) (*pb.ItemReadResponse, error) {
+	return nil, errDown
+}
+
+func (f *fakeNotes) ItemList(context.Context, *pb.ListRequest, ...grpc.CallOption) (*pb.ItemListResponse, error) {
+	return nil, errDown
+}
+
+func (f *fakeNotes) OtpWrite(context.Context, *pb.OtpWriteRequest, ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, errDown
+}
+
+func (f *fakeNotes) OtpRead(context.Context, *pb.OtpReadRequest, ...grpc.CallOption) (*pb.OtpReadResponse, error) {
+	return nil, errDown
+}
+
+func (f *fakeNotes) OtpCode(context.Context, *pb.OtpCodeRequest, ...grpc.CallOption) (*pb.OtpCodeResponse, error) {
+	return nil, errDown
+}
+
+func (f *fakeNotes) SshKeyWrite(context.Context, *pb.SshKeyWriteRequest, ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, errDown
+}
+
+func (f *fakeNotes) SshKeyRead(context.Context, *pb.SshKeyReadRequest, ...grpc.CallOption) (*pb.SshKeyReadResponse, error) {
+	return nil, errDown
+}
+
+func newOfflineClient(t *testing.T) (*Client, *fakeNotes) {
+	server := &fakeNotes{notes: make(map[string]*pb.NoteReadResponse)}
+	c := &Client{
+		client:     server,
+		userName:   "user",
+		userTokens: map[string]string{"user": "token"},
+		cacheDir:   t.TempDir(),
+		caches:     make(map[string]*cache.Cache),
+	}
+	require.NoError(t, c.openCache("user", "password"))
+	t.Cleanup(func() { c.caches["user"].Close() })
+	return c, server
+}
+
+func TestOfflineReadWrite(t *testing.T) {
+	c, server := newOfflineClient(t)
+
+	require.NoError(t, c.NoteWrite(&pb.NoteWriteRequest{Name: "n1", Notes: "one"}))
+
+	server.down = true
+
+	// чтение из кэша
+	resp, err := c.NoteRead(&pb.NoteReadRequest{Name: "n1"})
+	require.NoError(t, err)
+	assert.Equal(t, "one", resp.Notes)
+
+	// изменения без связи
+	require.NoError(t, c.NoteWrite(&pb.NoteWriteRequest{Name: "n2", Notes: "two"}))
+	require.NoError(t, c.NoteUpdate("n2", &pb.NoteWriteRequest{Name: "n2", Notes: "two+"}))
+	require.NoError(t, c.NoteDelete(&pb.NoteDelRequest{Name: "n1"}))
+	assert.Equal(t, 3, c.Pending())
+
+	entries, next, err := c.NoteList(&pb.ListRequest{Glob: "n*"})
+	require.NoError(t, err)
+	assert.Empty(t, next)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "n2", entries[0].Name)
+
+	entries, _, err = c.NoteList(&pb.ListRequest{Prefix: "x"})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = c.Sync(KeepBoth)
+	assert.Error(t, err)
+	assert.Equal(t, 3, c.Pending())
+
+	server.down = false
+
+	report, err := c.Sync(KeepBoth)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Applied)
+	assert.Equal(t, 0, report.Conflicts)
+	assert.Equal(t, 0, c.Pending())
+
+	require.Len(t, server.notes, 1)
+	assert.Equal(t, "two+", server.notes["n2"].Notes)
+}
+
+func TestSyncConflict(t *testing.T) {
+	tests := []struct {
+		name      string
+		strategy  ConflictStrategy
+		wantNotes map[string]string
+	}{
+		{
+			name:      "keep local",
+			strategy:  KeepLocal,
+			wantNotes: map[string]string{"n1": "local"},
+		},
+		{
+			name:      "keep remote",
+			strategy:  KeepRemote,
+			wantNotes: map[string]string{"n1": "remote"},
+		},
+		{
+			name:      "keep both",
+			strategy:  KeepBoth,
+			wantNotes: map[string]string{"n1": "remote", "n1 (local)": "local"},
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			c, server := newOfflineClient(t)
+
+			require.NoError(t, c.NoteWrite(&pb.NoteWriteRequest{Name: "n1", Notes: "base"}))
+
+			server.down = true
+			require.NoError(t, c.NoteUpdate("n1", &pb.NoteWriteRequest{Name: "n1", Notes: "local"}))
+			server.down = false
+
+			// изменение с другого устройства
+			server.notes["n1"].Notes = "remote"
+
+			report, err := c.Sync(tcase.strategy)
+			require.NoError(t, err)
+			assert.Equal(t, 1, report.Conflicts)
+			assert.Equal(t, 0, c.Pending())
+
+			got := make(map[string]string)
+			for name, n := range server.notes {
+				got[name] = n.Notes
+			}
+			assert.Equal(t, tcase.wantNotes, got)
+
+			// кэш соответствует серверу
+			server.down = true
+			resp, err := c.NoteRead(&pb.NoteReadRequest{Name: "n1"})
+			require.NoError(t, err)
+			assert.Equal(t, tcase.wantNotes["n1"], resp.Notes)
+		})
+	}
+}
+
+func TestOfflineRename(t *testing.T) {
+	c, server := newOfflineClient(t)
+
+	require.NoError(t, c.NoteWrite(&pb.NoteWriteRequest{Name: "a", Notes: "text"}))
+	require.NoError(t, c.NoteRename("a", "b"))
+	id := server.notes["b"].Id
+
+	server.down = true
+	require.NoError(t, c.NoteRename("b", "c"))
+	assert.Equal(t, 1, c.Pending())
+
+	resp, err := c.NoteRead(&pb.NoteReadRequest{Name: "c"})
+	require.NoError(t, err)
+	assert.Equal(t, "text", resp.Notes)
+
+	server.down = false
+	_, err = c.Sync(KeepBoth)
+	require.NoError(t, err)
+
+	require.Len(t, server.notes, 1)
+	assert.Equal(t, id, server.notes["c"].Id)
+	assert.Equal(t, "text", server.notes["c"].Notes)
+}
+
+func TestOfflineExpired(t *testing.T) {
+	c, server := newOfflineClient(t)
+	server.down = true
+
+	past := timestamppb.New(time.Now().Add(-time.Minute))
+	future := timestamppb.New(time.Now().Add(time.Hour))
+	require.NoError(t, c.NoteWrite(&pb.NoteWriteRequest{Name: "old", Notes: "one", ExpiresAt: past}))
+	require.NoError(t, c.NoteWrite(&pb.NoteWriteRequest{Name: "new", Notes: "two", ExpiresAt: future}))
+
+	_, err := c.NoteRead(&pb.NoteReadRequest{Name: "old"})
+	assert.Error(t, err)
+
+	resp, err := c.NoteRead(&pb.NoteReadRequest{Name: "new"})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(future, resp.ExpiresAt))
+
+	entries, _, err := c.NoteList(&pb.ListRequest{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "new", entries[0].Name)
+	assert.True(t, proto.Equal(future, entries[0].ExpiresAt))
+}
+
+func TestOfflineItem(t *testing.T) {
+	c, _ := newOfflineClient(t)
+
+	fields := []*pb.ItemField{
+		{Label: "host", Value: "db.local"},
+		{Label: "password", Type: pb.FieldType_FIELD_TYPE_SECRET, Value: "secret"},
+	}
+	require.NoError(t, c.ItemWrite(&pb.ItemWriteRequest{Name: "db", Type: "database", Fields: fields}))
+	assert.Equal(t, 1, c.Pending())
+
+	// поля переносятся в кэш вместе с порядком
+	resp, err := c.ItemRead(&pb.ItemReadRequest{Name: "db"})
+	require.NoError(t, err)
+	assert.Equal(t, "database", resp.Type)
+	require.Len(t, resp.Fields, 2)
+	for i := range fields {
+		assert.True(t, proto.Equal(fields[i], resp.Fields[i]))
+	}
+
+	entries, _, err := c.ItemList(&pb.ListRequest{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "db", entries[0].Name)
+}
+
+func TestOfflineOtpCode(t *testing.T) {
+	c, _ := newOfflineClient(t)
+
+	require.NoError(t, c.OtpWrite(&pb.OtpWriteRequest{Name: "github", Issuer: "GitHub",
+		Secret: "JBSWY3DPEHPK3PXP", Digits: 6, Period: 30}))
+	require.NoError(t, c.OtpWrite(&pb.OtpWriteRequest{Name: "bank",
+		Secret: "JBSWY3DPEHPK3PXP", Type: pb.OtpType_OTP_TYPE_HOTP, Digits: 6}))
+
+	// пароль TOTP генерируется по секрету из кэша
+	cached, err := c.OtpRead(&pb.OtpReadRequest{Name: "github"})
+	require.NoError(t, err)
+	p, err := OtpParams(cached)
+	require.NoError(t, err)
+	before, _ := p.TOTP(time.Now())
+	resp, err := c.OtpCode(&pb.OtpCodeRequest{Name: "github"})
+	require.NoError(t, err)
+	after, _ := p.TOTP(time.Now())
+	assert.Contains(t, []string{before, after}, resp.Code)
+	assert.NotZero(t, resp.Remaining)
+
+	// счётчик HOTP хранится на сервере
+	_, err = c.OtpCode(&pb.OtpCodeRequest{Name: "bank"})
+	assert.ErrorIs(t, err, errDown)
+
+	res, err := c.Search(&pb.SearchRequest{Query: "github", Kinds: []string{kindOtp}})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+}
+
+func TestOfflineSshKey(t *testing.T) {
+	c, _ := newOfflineClient(t)
+
+	key, err := sshkey.Generate(sshkey.TypeEd25519, 0, "")
+	require.NoError(t, err)
+	private, err := key.MarshalPrivate()
+	require.NoError(t, err)
+	require.NoError(t, c.SshKeyWrite(&pb.SshKeyWriteRequest{Name: "deploy",
+		PrivateKey: string(private), Comment: "deploy@ci"}))
+	assert.Equal(t, 1, c.Pending())
+
+	// закрытый ключ доступен агенту из зашифрованного кэша
+	cached, err := c.SshKeyRead(&pb.SshKeyReadRequest{Name: "deploy"})
+	require.NoError(t, err)
+	parsed, err := sshkey.Parse([]byte(cached.PrivateKey), nil)
+	require.NoError(t, err)
+	assert.Equal(t, key.Fingerprint(), parsed.Fingerprint())
+
+	res, err := c.Search(&pb.SearchRequest{Query: "deploy@ci", Kinds: []string{kindSshKey}})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "comment", res[0].Matched)
+}
+
+func TestOfflineSearch(t *testing.T) {
+	c, _ := newOfflineClient(t)
+
+	for _, name := range []string{"gmail", "mail", "bank"} {
+		require.NoError(t, c.NoteWrite(&pb.NoteWriteRequest{Name: name}))
+	}
+
+	res, err := c.Search(&pb.SearchRequest{Query: "mail"})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, "mail", res[0].Name)
+	assert.Equal(t, "gmail", res[1].Name)
+	assert.Equal(t, "name", res[0].Matched)
+
+	res, err = c.Search(&pb.SearchRequest{Query: "bnk"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "bank", res[0].Name)
+
+	res, err = c.Search(&pb.SearchRequest{Query: "mail", Kinds: []string{kindCard}})
+	require.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+func TestFuzzyScore(t *testing.T) {
+	assert.Equal(t, float32(1), fuzzyScore("Mail", "mail"))
+	assert.Greater(t, fuzzyScore("mail", "gmail"), fuzzyScore("gml", "gmail"))
+	assert.Zero(t, fuzzyScore("lg", "gmail"))
+	assert.Zero(t, fuzzyScore("", "gmail"))
+}
@@ -0,0 +1,455 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+)
+
+// ErrCorrupted содержимое файла не совпадает с дайджестом, сохранённым при загрузке
+var ErrCorrupted = errors.New("binary content corrupted")
+
+// uploadChunkSize размер фрагмента загрузки, если сервер не сообщает свой
+const uploadChunkSize = 64 << 10
+
+// minPartSize наименьший размер части параллельной загрузки: файлы
+// меньше двух частей загружаются одним потоком
+var minPartSize int64 = 4 << 20
+
+// uploadRetries число попыток продолжить прерванную загрузку
+const uploadRetries = 5
+
+// uploadBackoff начальная пауза перед повтором, удваивается с каждой попыткой
+var uploadBackoff = time.Second
+
+// SetChunkSize размер фрагмента передачи файлов, 0 - по умолчанию сервера.
+// Сервер ограничивает размер своими пределами.
+func (c *Client) SetChunkSize(n int) {
+	c.chunkSize = n
+	c.transfer = nil
+}
+
+// SetParts наибольшее число частей, параллельно загружаемых для больших
+// файлов, 0 - по умолчанию сервера, 1 - загрузка одним потоком
+func (c *Client) SetParts(n int) {
+	c.parts = n
+	c.transfer = nil
+}
+
+// SetUploadProgress функция отслеживания загрузки файлов: вызывается
+// с отправленным объёмом после каждого фрагмента, nil - не отслеживать
+func (c *Client) SetUploadProgress(fn func(sent int64)) {
+	c.progress = fn
+}
+
+// BinaryUploadStatus состояние загрузки файла на сервере
+func (c *Client) BinaryUploadStatus(id int64) (*pb.BinaryUploadStatusResponse, error) {
+	ctx := c.withToken(context.Background())
+	return c.client.BinaryUploadStatus(ctx, &pb.BinaryUploadStatusRequest{Id: id})
+}
+
+// BinaryUpload загрузка содержимого файла. Сначала считается SHA-256
+// содержимого, сервер сверяет его со своим при завершении загрузки.
+// После временной ошибки загрузка продолжается с объёма, уже записанного
+// на сервере. Алгоритм сжатия compression ("" или "zstd") сервер применяет
+// к новому содержимому, при продолжении остаётся выбранный ранее.
+func (c *Client) BinaryUpload(id int64, r io.ReadSeeker, compression string) error {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return err
+	}
+	digest := h.Sum(nil)
+
+	if ra, ok := r.(io.ReaderAt); ok {
+		size, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		t := c.transferParams()
+		if t.Parts > 1 && size >= 2*minPartSize {
+			err = c.uploadParts(id, ra, size, digest, compression, t)
+			// сервер без загрузки частями принимает файл одним потоком
+			if status.Code(err) != codes.Unimplemented {
+				return err
+			}
+		}
+	}
+
+	var offset int64
+	for attempt := 0; ; attempt++ {
+		if _, err := r.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+
+		err := c.uploadFrom(id, offset, digest, compression, r)
+		if err == nil || !isUnavailable(err) || attempt == uploadRetries {
+			return err
+		}
+		time.Sleep(uploadBackoff << attempt)
+
+		// при недоступности сервера повторяем с прежнего смещения,
+		// записанный объём от него только растёт
+		st, err := c.BinaryUploadStatus(id)
+		if err == nil {
+			offset = st.Committed
+		} else if !isUnavailable(err) {
+			return err
+		}
+	}
+}
+
+// BinaryPut создание файла вместе с содержимым одним потоком. Размер
+// и SHA-256 содержимого заголовка считаются по r, файл появляется на сервере
+// только после успешной передачи всего содержимого. Возвращает идентификатор
+// бинарника.
+func (c *Client) BinaryPut(header *pb.BinaryPutHeader, r io.ReadSeeker) (int64, error) {
+	h := sha256.New()
+	size, err := io.Copy(h, r)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	header.Size = size
+	header.Sha256 = h.Sum(nil)
+
+	ctx, cancel := context.WithCancel(c.withToken(context.Background()))
+	defer cancel()
+
+	client, err := c.client.BinaryPut(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = client.Send(&pb.BinaryPutStream{Data: &pb.BinaryPutStream_Header{Header: header}})
+	buf := make([]byte, c.uploadChunk())
+	var sent int64
+	for err == nil {
+		n, rerr := r.Read(buf)
+		if n > 0 {
+			err = client.Send(&pb.BinaryPutStream{Data: &pb.BinaryPutStream_Chunk{Chunk: buf[:n]}})
+			sent += int64(n)
+			if err == nil && c.progress != nil {
+				c.progress(sent)
+			}
+		}
+		if rerr == io.EOF {
+			break
+		} else if rerr != nil {
+			return 0, rerr
+		}
+	}
+
+	// при io.EOF от Send причина обрыва приходит из CloseAndRecv
+	resp, e := client.CloseAndRecv()
+	if err == nil || err == io.EOF {
+		err = e
+	}
+	if err != nil {
+		return 0, err
+	}
+	return resp.Id, nil
+}
+
+// uploadChunk размер фрагмента загрузки, согласованный с сервером
+func (c *Client) uploadChunk() int {
+	return int(c.transferParams().ChunkSize)
+}
+
+// transferParams параметры передачи, согласованные с сервером при первом
+// обращении. Сервер без согласования получает фрагменты запрошенного
+// размера или uploadChunkSize одним потоком.
+func (c *Client) transferParams() *pb.BinaryTransferResponse {
+	if c.transfer != nil {
+		return c.transfer
+	}
+
+	ctx := c.withToken(context.Background())
+	t, err := c.client.BinaryTransfer(ctx, &pb.BinaryTransferRequest{
+		ChunkSize: int32(c.chunkSize),
+		Parts:     int32(c.parts),
+	})
+	if err == nil {
+		c.transfer = t
+		return t
+	}
+
+	t = &pb.BinaryTransferResponse{ChunkSize: uploadChunkSize, Parts: 1}
+	if c.chunkSize > 0 {
+		t.ChunkSize = int32(c.chunkSize)
+	}
+	// временную недоступность сервера не запоминаем
+	if !isUnavailable(err) {
+		c.transfer = t
+	}
+	return t
+}
+
+// partSize размер части, кратный блоку шифрования: содержимое
+// размером size делится не более чем на parts частей
+func partSize(size int64, parts, block int32) int64 {
+	n := (size + int64(parts) - 1) / int64(parts)
+	if n < minPartSize {
+		n = minPartSize
+	}
+	if block <= 0 {
+		return n
+	}
+	b := int64(block)
+	return (n + b - 1) / b * b
+}
+
+// uploadParts загрузка содержимого частями в параллельных потоках.
+// Прерванная часть загружается заново целиком, после загрузки всех
+// частей сервер собирает их и сверяет дайджест.
+func (c *Client) uploadParts(id int64, r io.ReaderAt, size int64, digest []byte,
+	compression string, t *pb.BinaryTransferResponse) error {
+
+	ctx := c.withToken(context.Background())
+	_, err := c.client.BinaryPartsBegin(ctx, &pb.BinaryPartsBeginRequest{Id: id, Compression: compression})
+	if err != nil {
+		return err
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		sent int64
+	)
+	// add учёт отправленного объёма всех частей, при повторе части
+	// её прежний объём вычитается
+	add := func(n int64) {
+		mu.Lock()
+		defer mu.Unlock()
+		sent += n
+		if c.progress != nil && n > 0 {
+			c.progress(sent)
+		}
+	}
+
+	step := partSize(size, t.Parts, t.BlockSize)
+	errs := make([]error, 0, t.Parts)
+	for offset := int64(0); offset < size; offset += step {
+		length := size - offset
+		if length > step {
+			length = step
+		}
+		wg.Add(1)
+		go func(offset, length int64) {
+			defer wg.Done()
+			err := c.uploadPart(id, offset, io.NewSectionReader(r, offset, length), int(t.ChunkSize), add)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(offset, length)
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	_, err = c.client.BinaryPartsComplete(ctx, &pb.BinaryPartsCompleteRequest{Id: id, Sha256: digest})
+	return err
+}
+
+// uploadPart загрузка части со смещения offset с повтором целиком
+// после временной ошибки
+func (c *Client) uploadPart(id, offset int64, r *io.SectionReader, chunk int, add func(int64)) error {
+	for attempt := 0; ; attempt++ {
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		sent, err := c.sendPart(id, offset, r, chunk, add)
+		if err == nil || !isUnavailable(err) || attempt == uploadRetries {
+			return err
+		}
+		add(-sent)
+		time.Sleep(uploadBackoff << attempt)
+	}
+}
+
+// sendPart один поток загрузки части, возвращает отправленный объём
+func (c *Client) sendPart(id, offset int64, r io.Reader, chunk int, add func(int64)) (int64, error) {
+	ctx, cancel := context.WithCancel(c.withToken(context.Background()))
+	defer cancel()
+
+	client, err := c.client.BinaryUploadPart(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	buf := make([]byte, chunk)
+	part := pb.BinaryUploadPartStream{Id: id, Offset: offset}
+	var sent int64
+	for {
+		n, rerr := r.Read(buf)
+		if n > 0 {
+			part.Chunk = buf[:n]
+			if err = client.Send(&part); err != nil {
+				break
+			}
+			sent += int64(n)
+			add(int64(n))
+		}
+		if rerr == io.EOF {
+			break
+		} else if rerr != nil {
+			return sent, rerr
+		}
+	}
+
+	// при io.EOF от Send причина обрыва приходит из CloseAndRecv
+	if _, e := client.CloseAndRecv(); err == nil || err == io.EOF {
+		err = e
+	}
+	return sent, err
+}
+
+// uploadFrom один поток загрузки начиная со смещения offset.
+// Хотя бы один фрагмент отправляется всегда, чтобы сервер мог
+// отметить завершение уже полностью записанного файла.
+func (c *Client) uploadFrom(id, offset int64, digest []byte, compression string, r io.Reader) error {
+	ctx, cancel := context.WithCancel(c.withToken(context.Background()))
+	defer cancel()
+
+	client, err := c.client.BinaryUpload(ctx)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, c.uploadChunk())
+	upload := pb.BinaryUplodStream{
+		Id:          id,
+		Offset:      offset,
+		Sha256:      digest,
+		Compression: compression,
+	}
+
+	for sent := false; ; {
+		n, rerr := r.Read(buf)
+		if n > 0 || (!sent && rerr == io.EOF) {
+			upload.Chunk = buf[:n]
+			if err = client.Send(&upload); err != nil {
+				break
+			}
+			upload.Sha256 = nil
+			upload.Compression = ""
+			sent = true
+			offset += int64(n)
+			if c.progress != nil {
+				c.progress(offset)
+			}
+		}
+		if rerr == io.EOF {
+			break
+		} else if rerr != nil {
+			return rerr
+		}
+	}
+
+	// при io.EOF от Send причина обрыва приходит из CloseAndRecv
+	if _, e := client.CloseAndRecv(); err == nil || err == io.EOF {
+		err = e
+	}
+	return err
+}
+
+// BinaryDownload выгрузка содержимого файла в w. Если известен дайджест,
+// содержимое сверяется с ним по ходу выгрузки, при несовпадении
+// возвращается ErrCorrupted.
+func (c *Client) BinaryDownload(id int64, digest []byte, w io.Writer) error {
+	var h hash.Hash
+	if len(digest) > 0 {
+		h = sha256.New()
+		w = io.MultiWriter(w, h)
+	}
+	if err := c.BinaryDownloadRange(id, 0, 0, w); err != nil {
+		return err
+	}
+	return verify(h, digest)
+}
+
+// BinaryResume догрузка содержимого в частично выгруженный файл f:
+// выгрузка продолжается с его размера. Дайджест сверяется со всем
+// содержимым, включая выгруженное ранее.
+func (c *Client) BinaryResume(id int64, digest []byte, f io.ReadWriteSeeker) error {
+	var (
+		h hash.Hash
+		w io.Writer = f
+		r io.Reader = f
+	)
+	if len(digest) > 0 {
+		h = sha256.New()
+		w = io.MultiWriter(f, h)
+		r = io.TeeReader(f, h)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	offset, err := io.Copy(io.Discard, r)
+	if err != nil {
+		return err
+	}
+	if err = c.BinaryDownloadRange(id, offset, 0, w); err != nil {
+		return err
+	}
+	return verify(h, digest)
+}
+
+// BinaryDownloadRange выгрузка в w части содержимого файла со смещения
+// offset длиной length, нулевая длина - до конца файла
+func (c *Client) BinaryDownloadRange(id, offset, length int64, w io.Writer) error {
+
+	ctx := c.withToken(context.Background())
+	req := pb.BidaryDownloadRequest{
+		Id:        id,
+		Offset:    offset,
+		Length:    length,
+		ChunkSize: int32(c.chunkSize),
+	}
+
+	download, err := c.client.BinaryDownload(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	for err == nil {
+		var stream *pb.BinaryDownloadStream
+		stream, err = download.Recv()
+		if err == nil {
+			logger.Debug("download", "err", err, "stream", stream)
+			_, err = w.Write(stream.Chunk)
+		}
+	}
+	if err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// verify сверка посчитанного дайджеста с ожидаемым
+func verify(h hash.Hash, digest []byte) error {
+	if h == nil {
+		return nil
+	}
+	if sum := h.Sum(nil); !bytes.Equal(sum, digest) {
+		return fmt.Errorf("%w: sha256 %x, want %x", ErrCorrupted, sum, digest)
+	}
+	return nil
+}
@@ -0,0 +1,458 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+)
+
+// fakeUploads сервер загрузок, обрывающий поток после dropAfter байт
+type fakeUploads struct {
+	pb.GophKeeperClient
+	data        []byte
+	dropAfter   int
+	drops       int
+	digest      []byte
+	compression []string
+	maxChunk    int
+
+	transfer  *pb.BinaryTransferResponse // nil - сервер без согласования
+	noParts   bool                       // сервер без загрузки частями
+	mu        sync.Mutex
+	parts     map[int64][]byte
+	dropParts map[int64]int // число обрывов части по смещению
+}
+
+func (f *fakeUploads) BinaryTransfer(context.Context, *pb.BinaryTransferRequest, ...grpc.CallOption) (*pb.BinaryTransferResponse, error) {
+	if f.transfer == nil {
+		return nil, status.Error(codes.Unimplemented, "unknown method")
+	}
+	return f.transfer, nil
+}
+
+func (f *fakeUploads) BinaryPartsBegin(_ context.Context, in *pb.BinaryPartsBeginRequest, _ ...grpc.CallOption) (*empty.Empty, error) {
+	if f.noParts {
+		return nil, status.Error(codes.Unimplemented, "unknown method")
+	}
+	f.parts = make(map[int64][]byte)
+	f.compression = append(f.compression, in.Compression)
+	return &empty.Empty{}, nil
+}
+
+func (f *fakeUploads) BinaryUploadPart(context.Context, ...grpc.CallOption) (pb.GophKeeper_BinaryUploadPartClient, error) {
+	return &fakePartStream{f: f, offset: -1}, nil
+}
+
+func (f *fakeUploads) BinaryPartsComplete(_ context.Context, in *pb.BinaryPartsCompleteRequest, _ ...grpc.CallOption) (*empty.Empty, error) {
+	offsets := make([]int64, 0, len(f.parts))
+	for offset := range f.parts {
+		offsets = append(offsets, offset)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	f.data = f.data[:0]
+	for _, offset := range offsets {
+		if offset != int64(len(f.data)) {
+			return nil, status.Errorf(codes.FailedPrecondition, "missing part at %d", len(f.data))
+		}
+		f.data = append(f.data, f.parts[offset]...)
+	}
+	f.digest = in.Sha256
+	return &empty.Empty{}, nil
+}
+
+// fakePartStream поток части, обрываемый после первого фрагмента,
+// пока не исчерпано число обрывов части
+type fakePartStream struct {
+	grpc.ClientStream
+	f       *fakeUploads
+	offset  int64
+	data    []byte
+	dropped bool
+}
+
+func (s *fakePartStream) Send(in *pb.BinaryUploadPartStream) error {
+	if s.offset < 0 {
+		s.offset = in.Offset
+	}
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	if len(s.data) > 0 && s.f.dropParts[s.offset] > 0 {
+		s.f.dropParts[s.offset]--
+		s.dropped = true
+		return io.EOF
+	}
+	if len(in.Chunk) > s.f.maxChunk {
+		s.f.maxChunk = len(in.Chunk)
+	}
+	s.data = append(s.data, in.Chunk...)
+	return nil
+}
+
+func (s *fakePartStream) CloseAndRecv() (*empty.Empty, error) {
+	if s.dropped {
+		return nil, status.Error(codes.Unavailable, "connection lost")
+	}
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	s.f.parts[s.offset] = s.data
+	return &empty.Empty{}, nil
+}
+
+func (f *fakeUploads) BinaryUpload(context.Context, ...grpc.CallOption) (pb.GophKeeper_BinaryUploadClient, error) {
+	return &fakeUploadStream{f: f}, nil
+}
+
+func (f *fakeUploads) BinaryUploadStatus(context.Context, *pb.BinaryUploadStatusRequest, ...grpc.CallOption) (*pb.BinaryUploadStatusResponse, error) {
+	return &pb.BinaryUploadStatusResponse{Committed: int64(len(f.data))}, nil
+}
+
+type fakeUploadStream struct {
+	grpc.ClientStream
+	f       *fakeUploads
+	started bool
+	dropped bool
+	offset  int64
+}
+
+func (s *fakeUploadStream) Send(in *pb.BinaryUplodStream) error {
+	if !s.started {
+		if in.Offset > int64(len(s.f.data)) {
+			return status.Error(codes.OutOfRange, "offset beyond committed")
+		}
+		s.started = true
+		s.offset = in.Offset
+		s.f.digest = in.Sha256
+		s.f.compression = append(s.f.compression, in.Compression)
+	}
+	if s.f.drops > 0 && len(s.f.data) >= s.f.dropAfter {
+		s.f.drops--
+		s.dropped = true
+		return io.EOF
+	}
+	if len(in.Chunk) > s.f.maxChunk {
+		s.f.maxChunk = len(in.Chunk)
+	}
+	s.f.data = append(s.f.data[:s.offset], in.Chunk...)
+	s.offset += int64(len(in.Chunk))
+	return nil
+}
+
+func (s *fakeUploadStream) CloseAndRecv() (*empty.Empty, error) {
+	if s.dropped {
+		return nil, status.Error(codes.Unavailable, "connection lost")
+	}
+	return &empty.Empty{}, nil
+}
+
+func (f *fakeUploads) BinaryDownload(_ context.Context, req *pb.BidaryDownloadRequest, _ ...grpc.CallOption) (pb.GophKeeper_BinaryDownloadClient, error) {
+	if req.Offset > int64(len(f.data)) {
+		return nil, status.Error(codes.OutOfRange, "offset beyond size")
+	}
+	data := f.data[req.Offset:]
+	if req.Length > 0 && req.Length < int64(len(data)) {
+		data = data[:req.Length]
+	}
+	return &fakeDownloadStream{data: data}, nil
+}
+
+type fakeDownloadStream struct {
+	grpc.ClientStream
+	data []byte
+}
+
+func (s *fakeDownloadStream) Recv() (*pb.BinaryDownloadStream, error) {
+	if len(s.data) == 0 {
+		return nil, io.EOF
+	}
+	n := uploadChunkSize
+	if n > len(s.data) {
+		n = len(s.data)
+	}
+	chunk := s.data[:n]
+	s.data = s.data[n:]
+	return &pb.BinaryDownloadStream{Chunk: chunk}, nil
+}
+
+func TestBinaryUploadResume(t *testing.T) {
+	uploadBackoff = 0
+	content := strings.Repeat("0123456789", 2*uploadChunkSize)
+
+	t.Run("resume after drop", func(t *testing.T) {
+		srv := &fakeUploads{dropAfter: uploadChunkSize*3 + 1, drops: 2}
+		c := &Client{client: srv}
+
+		require.NoError(t, c.BinaryUpload(1, strings.NewReader(content), "zstd"))
+		assert.Equal(t, 0, srv.drops)
+		// алгоритм передаётся в первом фрагменте каждой попытки
+		assert.Equal(t, []string{"zstd", "zstd", "zstd"}, srv.compression)
+		assert.Equal(t, content, string(srv.data))
+		sum := sha256.Sum256([]byte(content))
+		assert.Equal(t, sum[:], srv.digest)
+	})
+
+	t.Run("chunk size", func(t *testing.T) {
+		srv := &fakeUploads{}
+		c := &Client{client: srv}
+		c.SetChunkSize(1000)
+
+		require.NoError(t, c.BinaryUpload(1, strings.NewReader(content), ""))
+		assert.Equal(t, content, string(srv.data))
+		assert.Equal(t, 1000, srv.maxChunk)
+	})
+
+	t.Run("progress", func(t *testing.T) {
+		srv := &fakeUploads{dropAfter: uploadChunkSize*3 + 1, drops: 1}
+		c := &Client{client: srv}
+		var sent []int64
+		c.SetUploadProgress(func(n int64) { sent = append(sent, n) })
+
+		require.NoError(t, c.BinaryUpload(1, strings.NewReader(content), ""))
+		require.NotEmpty(t, sent)
+		// после обрыва отсчёт продолжается с записанного на сервере
+		assert.Contains(t, sent, int64(uploadChunkSize*3))
+		assert.Equal(t, int64(len(content)), sent[len(sent)-1])
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		srv := &fakeUploads{dropAfter: uploadChunkSize, drops: uploadRetries + 1}
+		c := &Client{client: srv}
+
+		err := c.BinaryUpload(1, strings.NewReader(content), "")
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
+
+func TestBinaryUploadParts(t *testing.T) {
+	uploadBackoff = 0
+	defer func(n int64) { minPartSize = n }(minPartSize)
+	minPartSize = 4096
+
+	content := strings.Repeat("0123456789", 2058) // 5 блоков по 4096 и остаток
+	sum := sha256.Sum256([]byte(content))
+	transfer := &pb.BinaryTransferResponse{ChunkSize: 1000, Parts: 3, BlockSize: 4096}
+
+	t.Run("parallel", func(t *testing.T) {
+		srv := &fakeUploads{transfer: transfer, dropParts: map[int64]int{8192: 2}}
+		c := &Client{client: srv}
+		var last int64
+		var mu sync.Mutex
+		c.SetUploadProgress(func(n int64) { mu.Lock(); last = n; mu.Unlock() })
+
+		require.NoError(t, c.BinaryUpload(1, strings.NewReader(content), "zstd"))
+		assert.Equal(t, content, string(srv.data))
+		assert.Equal(t, sum[:], srv.digest)
+		assert.Equal(t, []string{"zstd"}, srv.compression)
+		assert.Equal(t, 1000, srv.maxChunk)
+		assert.Zero(t, srv.dropParts[8192])
+		// части начинаются с границ блоков
+		assert.Len(t, srv.parts, 3)
+		for offset := range srv.parts {
+			assert.Zero(t, offset%4096)
+		}
+		assert.Equal(t, int64(len(content)), last)
+	})
+
+	t.Run("part retries exhausted", func(t *testing.T) {
+		srv := &fakeUploads{transfer: transfer, dropParts: map[int64]int{0: uploadRetries + 1}}
+		c := &Client{client: srv}
+
+		err := c.BinaryUpload(1, strings.NewReader(content), "")
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Empty(t, srv.data)
+	})
+
+	t.Run("server without parts", func(t *testing.T) {
+		srv := &fakeUploads{transfer: transfer, noParts: true}
+		c := &Client{client: srv}
+
+		require.NoError(t, c.BinaryUpload(1, strings.NewReader(content), ""))
+		assert.Equal(t, content, string(srv.data))
+		assert.Nil(t, srv.parts)
+	})
+
+	t.Run("small file", func(t *testing.T) {
+		srv := &fakeUploads{transfer: transfer}
+		c := &Client{client: srv}
+
+		require.NoError(t, c.BinaryUpload(1, strings.NewReader(content[:8000]), ""))
+		assert.Equal(t, content[:8000], string(srv.data))
+		assert.Nil(t, srv.parts)
+	})
+}
+
+func TestPartSize(t *testing.T) {
+	defer func(n int64) { minPartSize = n }(minPartSize)
+	minPartSize = 100
+
+	assert.Equal(t, int64(4096), partSize(5000, 4, 4096))
+	assert.Equal(t, int64(8192), partSize(20000, 3, 4096))
+	assert.Equal(t, int64(100), partSize(150, 8, 0))
+}
+
+func TestBinaryDownloadVerify(t *testing.T) {
+	content := strings.Repeat("0123456789", uploadChunkSize)
+	sum := sha256.Sum256([]byte(content))
+
+	t.Run("ok", func(t *testing.T) {
+		c := &Client{client: &fakeUploads{data: []byte(content)}}
+
+		var buf bytes.Buffer
+		require.NoError(t, c.BinaryDownload(1, sum[:], &buf))
+		assert.Equal(t, content, buf.String())
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		data := []byte(content)
+		data[len(data)/2] ^= 0xff
+		c := &Client{client: &fakeUploads{data: data}}
+
+		err := c.BinaryDownload(1, sum[:], io.Discard)
+		assert.ErrorIs(t, err, ErrCorrupted)
+	})
+
+	t.Run("no digest", func(t *testing.T) {
+		c := &Client{client: &fakeUploads{data: []byte("abc")}}
+
+		var buf bytes.Buffer
+		require.NoError(t, c.BinaryDownload(1, nil, &buf))
+		assert.Equal(t, "abc", buf.String())
+	})
+}
+
+func TestBinaryDownloadRange(t *testing.T) {
+	content := strings.Repeat("0123456789", uploadChunkSize)
+	c := &Client{client: &fakeUploads{data: []byte(content)}}
+
+	var buf bytes.Buffer
+	require.NoError(t, c.BinaryDownloadRange(1, 5, 10, &buf))
+	assert.Equal(t, content[5:15], buf.String())
+}
+
+func TestBinaryResume(t *testing.T) {
+	content := strings.Repeat("0123456789", uploadChunkSize)
+	sum := sha256.Sum256([]byte(content))
+
+	tests := []struct {
+		name    string
+		local   string
+		wantErr error
+	}{
+		{
+			name:  "partial",
+			local: content[:uploadChunkSize+3],
+		},
+		{
+			name: "empty",
+		},
+		{
+			name:  "complete",
+			local: content,
+		},
+		{
+			name:    "corrupted local part",
+			local:   "X" + content[1:100],
+			wantErr: ErrCorrupted,
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			f, err := os.CreateTemp(t.TempDir(), "resume")
+			require.NoError(t, err)
+			defer f.Close()
+			_, err = f.WriteString(tcase.local)
+			require.NoError(t, err)
+
+			c := &Client{client: &fakeUploads{data: []byte(content)}}
+			err = c.BinaryResume(1, sum[:], f)
+			if tcase.wantErr != nil {
+				assert.ErrorIs(t, err, tcase.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := os.ReadFile(f.Name())
+			require.NoError(t, err)
+			assert.Equal(t, content, string(got))
+		})
+	}
+}
+
+// fakePutStream поток создания файла, собирающий полученное
+type fakePutStream struct {
+	grpc.ClientStream
+	header *pb.BinaryPutHeader
+	data   []byte
+	err    error
+}
+
+func (s *fakePutStream) Send(in *pb.BinaryPutStream) error {
+	if h := in.GetHeader(); h != nil {
+		s.header = h
+		return nil
+	}
+	s.data = append(s.data, in.GetChunk()...)
+	return nil
+}
+
+func (s *fakePutStream) CloseAndRecv() (*pb.BinaryWriteResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &pb.BinaryWriteResponse{Id: 9}, nil
+}
+
+type fakePuts struct {
+	pb.GophKeeperClient
+	stream *fakePutStream
+}
+
+func (f *fakePuts) BinaryTransfer(context.Context, *pb.BinaryTransferRequest, ...grpc.CallOption) (*pb.BinaryTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown method")
+}
+
+func (f *fakePuts) BinaryPut(context.Context, ...grpc.CallOption) (pb.GophKeeper_BinaryPutClient, error) {
+	return f.stream, nil
+}
+
+func TestBinaryPut(t *testing.T) {
+	content := strings.Repeat("0123456789", uploadChunkSize)
+	sum := sha256.Sum256([]byte(content))
+
+	t.Run("ok", func(t *testing.T) {
+		srv := &fakePuts{stream: &fakePutStream{}}
+		c := &Client{client: srv}
+
+		id, err := c.BinaryPut(&pb.BinaryPutHeader{Name: "name", ContentType: "text/plain"}, strings.NewReader(content))
+		require.NoError(t, err)
+		assert.Equal(t, int64(9), id)
+		assert.Equal(t, content, string(srv.stream.data))
+		assert.Equal(t, "name", srv.stream.header.Name)
+		assert.Equal(t, int64(len(content)), srv.stream.header.Size)
+		assert.Equal(t, sum[:], srv.stream.header.Sha256)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		srv := &fakePuts{stream: &fakePutStream{err: status.Error(codes.AlreadyExists, "exists")}}
+		c := &Client{client: srv}
+
+		_, err := c.BinaryPut(&pb.BinaryPutHeader{Name: "name"}, strings.NewReader("abc"))
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/archive"
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+)
+
+// putDir сохранение каталога одним файлом: каталог упаковывается в tar
+// во временный файл, который загружается с продолжением после обрыва.
+// По ходу загрузки выводятся переданные целиком файлы.
+func putDir(dir, name, notes, compression string, expiresAt *timestamppb.Timestamp) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	} else if !info.IsDir() {
+		return fmt.Errorf("%s: не каталог", dir)
+	}
+
+	tmp, err := os.CreateTemp("", "gophkeeper-*.tar")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	entries, err := archive.Write(tmp, dir)
+	if err != nil {
+		return err
+	}
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	if name == "" {
+		name = filepath.Base(filepath.Clean(dir)) + ".tar"
+	}
+	in := pb.BinaryWriteRequest{
+		Name:  name,
+		Notes: notes,
+		Size:  size,
+		Meta: &pb.FileMeta{
+			Filename: filepath.Base(filepath.Clean(dir)),
+			Mtime:    timestamppb.New(info.ModTime()),
+			Mode:     uint32(info.Mode().Perm()),
+		},
+		ExpiresAt: expiresAt,
+	}
+	id, err := gkeeperClient.BinaryWrite(&in)
+	if err != nil {
+		return err
+	}
+
+	gkeeperClient.SetUploadProgress(archive.Progress(entries, printEntry))
+	defer gkeeperClient.SetUploadProgress(nil)
+	return gkeeperClient.BinaryUpload(id, tmp, compression)
+}
+
+// getDir распаковка сохранённого каталога в dir по ходу выгрузки,
+// после распаковки каталогу возвращаются исходные права и время изменения
+func getDir(resp *pb.BinaryReadResponse, dir string) error {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := gkeeperClient.BinaryDownload(resp.BinId, resp.Sha256, pw)
+		pw.CloseWithError(err)
+		done <- err
+	}()
+
+	err := archive.Extract(pr, dir, printEntry)
+	if err == nil {
+		// остаток после конца архива нужен для сверки дайджеста
+		_, err = io.Copy(io.Discard, pr)
+	}
+	pr.CloseWithError(err)
+	if e := <-done; e != nil {
+		return e
+	}
+	if err != nil {
+		return err
+	}
+	return restoreMeta(dir, resp.Meta)
+}
+
+// printEntry вывод переданного элемента архива
+func printEntry(e archive.Entry) {
+	fmt.Printf("%s %8d %s\n", e.Mode, e.Size, e.Name)
+}
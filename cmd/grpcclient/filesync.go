@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"os/signal"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/command"
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/mirror"
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+)
+
+// syncDelay пауза после последнего изменения в каталоге перед синхронизацией
+const syncDelay = time.Second
+
+// newFileSyncCmd - обработчик команды синхронизации каталога с хранилищем файлов
+func newFileSyncCmd(args []string) *command.Command {
+	fs := flag.NewFlagSet("sync", flag.ContinueOnError)
+	del := fs.Bool("delete", false, "распространять удаления")
+	watch := fs.Bool("watch", false, "синхронизировать при изменениях до прерывания")
+	folder := fs.String("f", "", "папка хранилища файлов")
+	if err := fs.Parse(args); err != nil {
+		return command.New(func(map[string]string) error { return err }, nil)
+	}
+
+	return command.New(func(fields map[string]string) error {
+		s := mirror.New(fields["dir"], &vaultClient{folder: *folder}, mirror.Options{
+			Delete: *del,
+			Log: func(a mirror.Action) {
+				fmt.Printf("%-13s %s\n", a.Kind, a.Name)
+			},
+		})
+		if !*watch {
+			return printSyncReport(s.Sync())
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		fmt.Println("отслеживание изменений, Ctrl+C - остановить")
+		return s.Watch(ctx, syncDelay, func(report mirror.Report, err error) {
+			if err = printSyncReport(report, err); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		})
+	}, fs.Args(), "dir")
+}
+
+// printSyncReport вывод пропущенных и неудавшихся файлов синхронизации
+func printSyncReport(report mirror.Report, err error) error {
+	if err != nil {
+		return err
+	}
+	for _, name := range report.Skipped {
+		fmt.Println("пропущен:", name)
+	}
+	names := make([]string, 0, len(report.Failed))
+	for name := range report.Failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "ошибка %s: %v\n", name, report.Failed[name])
+	}
+	if len(report.Done) == 0 && len(names) == 0 {
+		fmt.Println("изменений нет")
+	}
+	return nil
+}
+
+// vaultClient хранилище файлов на сервере для синхронизации каталога,
+// при заданной папке синхронизируются только файлы в ней
+type vaultClient struct {
+	folder string
+	read   map[string]*pb.BinaryReadResponse // описания файлов последнего списка
+}
+
+var _ mirror.Vault = (*vaultClient)(nil)
+
+// List файлы хранилища с дайджестами
+func (v *vaultClient) List() ([]mirror.Remote, error) {
+	v.read = make(map[string]*pb.BinaryReadResponse)
+
+	var list []mirror.Remote
+	req := pb.ListRequest{Limit: 1000, Folder: v.folder}
+	for {
+		entries, next, err := gkeeperClient.BinaryList(&req)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			resp, err := gkeeperClient.BinaryRead(&pb.BinaryReadRequest{Name: e.Name})
+			if err != nil {
+				return nil, err
+			}
+			v.read[e.Name] = resp
+
+			r := mirror.Remote{
+				Name:   resp.Name,
+				Size:   resp.Size,
+				SHA256: resp.Sha256,
+				Mode:   os.FileMode(resp.Meta.GetMode()),
+			}
+			if mtime := resp.Meta.GetMtime(); mtime != nil {
+				r.ModTime = mtime.AsTime()
+			}
+			list = append(list, r)
+		}
+		if next == "" {
+			return list, nil
+		}
+		req.Cursor = next
+	}
+}
+
+// Create новый файл с содержимым, при заданной папке помещается в неё
+func (v *vaultClient) Create(name, filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	meta, err := fileMeta(file)
+	if err != nil {
+		return err
+	}
+	header := pb.BinaryPutHeader{
+		Name:        name,
+		ContentType: mime.TypeByExtension(path.Ext(filename)),
+		Meta:        meta,
+	}
+	if _, err = gkeeperClient.BinaryPut(&header, file); err != nil {
+		return err
+	}
+	if v.folder != "" {
+		return gkeeperClient.FolderMove(itemKind("file"), name, v.folder)
+	}
+	return nil
+}
+
+// Update замена содержимого файла с сохранением заметок и сжатия
+func (v *vaultClient) Update(r mirror.Remote, filename string) error {
+	resp, ok := v.read[r.Name]
+	if !ok {
+		return fmt.Errorf("%s: нет в списке хранилища", r.Name)
+	}
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	fstat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	upd := pb.BinaryWriteRequest{
+		Name:  resp.Name,
+		Notes: resp.Notes,
+		Size:  fstat.Size(),
+		Meta:  statMeta(file.Name(), fstat),
+	}
+	if err = gkeeperClient.BinaryUpdate(resp.Id, resp.BinId, &upd); err != nil {
+		return err
+	}
+	return gkeeperClient.BinaryUpload(resp.BinId, file, resp.Compression)
+}
+
+// Download выгрузка содержимого со сверкой дайджеста
+func (v *vaultClient) Download(r mirror.Remote, w io.Writer) error {
+	resp, ok := v.read[r.Name]
+	if !ok {
+		return fmt.Errorf("%s: нет в списке хранилища", r.Name)
+	}
+	return gkeeperClient.BinaryDownload(resp.BinId, resp.Sha256, w)
+}
+
+// Delete удаление файла
+func (v *vaultClient) Delete(name string) error {
+	return gkeeperClient.BinaryDelete(&pb.BinaryDelRequest{Name: name})
+}
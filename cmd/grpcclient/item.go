@@ -0,0 +1,202 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/c-bata/go-prompt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/command"
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/otp"
+)
+
+// secretMask замена значения скрытого поля при выводе
+const secretMask = "********"
+
+// newItemsCmd - обработчики команд работы с произвольными элементами
+func newItemsCmd(args []string) *command.Command {
+	var (
+		subcmd  string
+		subargs []string
+	)
+	if len(args) > 0 {
+		subcmd = args[0]
+		subargs = args[1:]
+	}
+
+	switch subcmd {
+	case "", "ls", "list":
+		return newLsCmd(subargs, gkeeperClient.ItemList, false, "нет сохраненных элементов")
+
+	case "new":
+		ttl, subargs, err := parseTTL("new", subargs)
+		if err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		return command.New(func(fields map[string]string) error {
+			in := pb.ItemWriteRequest{
+				Name:      fields["name"],
+				Type:      fields["type"],
+				ExpiresAt: ttl.expiresAt(),
+			}
+			var err error
+			if in.Fields, err = inputFields(in.Fields); err != nil {
+				return err
+			}
+			return gkeeperClient.ItemWrite(&in)
+		}, subargs, "name", "type")
+
+	case "get":
+		fs := flag.NewFlagSet("get", flag.ContinueOnError)
+		reveal := fs.Bool("reveal", false, "показать значения скрытых полей")
+		if err := fs.Parse(subargs); err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		return command.New(func(fields map[string]string) error {
+			resp, err := gkeeperClient.ItemRead(&pb.ItemReadRequest{Name: fields["name"]})
+			if err == nil {
+				fmt.Println("name:", resp.Name)
+				fmt.Println("type:", resp.Type)
+				for _, f := range resp.Fields {
+					fmt.Printf("%s (%s): %s\n", f.Label, fieldTypeName(f.Type), fieldValue(f, *reveal))
+				}
+				printExpiry(resp.ExpiresAt)
+			}
+			return err
+		}, fs.Args(), "name")
+
+	case "upd":
+		ttl, subargs, err := parseTTL("upd", subargs)
+		if err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		return command.New(func(fields map[string]string) error {
+			item, err := gkeeperClient.ItemRead(&pb.ItemReadRequest{Name: fields["name"]})
+			if err != nil {
+				return err
+			}
+
+			in := pb.ItemWriteRequest{
+				Name: valueOr(fields["new name"], item.Name),
+				Type: valueOr(fields["new type"], item.Type),
+			}
+			fmt.Println(`Enter - оставить значение поля, "-" - удалить поле`)
+			for _, f := range item.Fields {
+				value := prompt.Input(fmt.Sprintf("%s (%s): ", f.Label, fieldTypeName(f.Type)), noComplete)
+				switch value {
+				case "-":
+					continue
+				case "":
+				default:
+					f.Value = value
+				}
+				in.Fields = append(in.Fields, f)
+			}
+			if in.Fields, err = inputFields(in.Fields); err != nil {
+				return err
+			}
+
+			in.ExpiresAt, err = ttl.update(func() (*timestamppb.Timestamp, error) {
+				return item.ExpiresAt, nil
+			})
+			if err != nil {
+				return err
+			}
+			return gkeeperClient.ItemUpdate(fields["name"], &in)
+		}, subargs, "name", "new name", "new type")
+
+	case "mv":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.ItemRename(fields["name"], fields["new name"])
+		}, subargs, "name", "new name")
+
+	case "del":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.ItemDelete(&pb.ItemDelRequest{Name: fields["name"]})
+		}, subargs, "name")
+
+	default:
+		return command.New(func(map[string]string) error {
+			return fmt.Errorf("неизвестная команда: %s", strings.Join(args, " "))
+		}, nil)
+	}
+}
+
+// inputFields ввод новых полей элемента, пустое имя завершает ввод
+func inputFields(fields []*pb.ItemField) ([]*pb.ItemField, error) {
+	fmt.Println("новые поля, пустое имя - завершить ввод")
+	for {
+		label := prompt.Input("field: ", noComplete)
+		if label == "" {
+			return fields, nil
+		}
+
+		name := prompt.Input("type [text]: ", fieldTypeComplete)
+		typ, ok := fieldType(name)
+		if !ok {
+			return nil, fmt.Errorf("неизвестный тип поля: %s", name)
+		}
+		value := prompt.Input("value: ", noComplete)
+		fields = append(fields, &pb.ItemField{Label: label, Type: typ, Value: value})
+	}
+}
+
+// fieldTypeName имя типа поля без префикса перечисления
+func fieldTypeName(t pb.FieldType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "FIELD_TYPE_"))
+}
+
+// fieldType тип поля по имени, пустое имя - текст
+func fieldType(name string) (pb.FieldType, bool) {
+	if name == "" {
+		return pb.FieldType_FIELD_TYPE_TEXT, true
+	}
+	v, ok := pb.FieldType_value["FIELD_TYPE_"+strings.ToUpper(name)]
+	return pb.FieldType(v), ok
+}
+
+// fieldValue значение поля для вывода: скрытые поля маскируются,
+// для секрета одноразовых паролей выводится текущий код
+func fieldValue(f *pb.ItemField, reveal bool) string {
+	switch f.Type {
+	case pb.FieldType_FIELD_TYPE_SECRET:
+		if !reveal && f.Value != "" {
+			return secretMask
+		}
+	case pb.FieldType_FIELD_TYPE_OTP:
+		p, err := otp.Parse(f.Value)
+		if err != nil {
+			return fmt.Sprintf("<%s>", err)
+		}
+		code := otpCode(p, time.Now())
+		if reveal {
+			return fmt.Sprintf("%s (%s)", f.Value, code)
+		}
+		return code
+	}
+	return f.Value
+}
+
+// valueOr значение или его замена, если оно пустое
+func valueOr(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+func noComplete(prompt.Document) []prompt.Suggest {
+	return nil
+}
+
+func fieldTypeComplete(d prompt.Document) []prompt.Suggest {
+	s := make([]prompt.Suggest, 0, len(pb.FieldType_name))
+	for i := 0; i < len(pb.FieldType_name); i++ {
+		s = append(s, prompt.Suggest{Text: fieldTypeName(pb.FieldType(i))})
+	}
+	return prompt.FilterHasPrefix(s, d.GetWordBeforeCursor(), true)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/command"
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+)
+
+// listFunc получение страницы списка
+type listFunc func(*pb.ListRequest) ([]*pb.ListEntry, string, error)
+
+var listSorts = map[string]pb.ListSort{
+	"name":    pb.ListSort_LIST_SORT_NAME,
+	"created": pb.ListSort_LIST_SORT_CREATED,
+	"updated": pb.ListSort_LIST_SORT_UPDATED,
+}
+
+// newLsCmd вывод списка таблицей.
+// Аргументы: [-s name|created|updated] [-r] [-n count] [-t tag] [-f folder [-R]] [prefix|glob]
+func newLsCmd(args []string, list listFunc, withSize bool, emptyMsg string) *command.Command {
+	fs := flag.NewFlagSet("ls", flag.ContinueOnError)
+	sortBy := fs.String("s", "name", "сортировка: name, created, updated")
+	desc := fs.Bool("r", false, "обратный порядок")
+	count := fs.Int("n", 0, "количество строк, 0 - все")
+	tag := fs.String("t", "", "метка")
+	folder := fs.String("f", "", "папка, / - корень")
+	recursive := fs.Bool("R", false, "вместе с вложенными папками")
+
+	if err := fs.Parse(args); err != nil {
+		return command.New(func(map[string]string) error { return err }, nil)
+	}
+
+	return command.New(func(map[string]string) error {
+		sort, ok := listSorts[*sortBy]
+		if !ok {
+			return fmt.Errorf("неизвестная сортировка: %s", *sortBy)
+		}
+
+		req := pb.ListRequest{
+			Sort:      sort,
+			Desc:      *desc,
+			Tag:       *tag,
+			Folder:    *folder,
+			Recursive: *recursive,
+		}
+		if pattern := fs.Arg(0); strings.ContainsAny(pattern, "*?") {
+			req.Glob = pattern
+		} else {
+			req.Prefix = pattern
+		}
+		if *count > 0 && *count < 1000 {
+			req.Limit = int32(*count)
+		}
+
+		var entries []*pb.ListEntry
+		for {
+			page, next, err := list(&req)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, page...)
+			if next == "" || (*count > 0 && len(entries) >= *count) {
+				break
+			}
+			req.Cursor = next
+		}
+		if *count > 0 && len(entries) > *count {
+			entries = entries[:*count]
+		}
+
+		if len(entries) == 0 {
+			fmt.Println(emptyMsg)
+			return nil
+		}
+		printEntries(entries, withSize)
+		return nil
+	}, nil)
+}
+
+// printEntries вывод таблицы элементов
+func printEntries(entries []*pb.ListEntry, withSize bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	header := "ID\tNAME\tFOLDER\tTAGS\tCREATED\tUPDATED\tEXPIRES"
+	if withSize {
+		header += "\tSIZE"
+	}
+	fmt.Fprintln(w, header)
+
+	for _, e := range entries {
+		row := fmt.Sprintf("%d\t%s\t/%s\t%s\t%s\t%s\t%s", e.Id, e.Name, e.Folder,
+			strings.Join(e.Tags, ","), formatTime(e.CreatedAt), formatTime(e.UpdatedAt),
+			formatTime(e.ExpiresAt))
+		if withSize {
+			row += fmt.Sprintf("\t%d", e.Size)
+		}
+		fmt.Fprintln(w, row)
+	}
+	w.Flush()
+}
+
+func formatTime(t *timestamp.Timestamp) string {
+	if t == nil {
+		return "-"
+	}
+	return t.AsTime().Local().Format("2006-01-02 15:04:05")
+}
@@ -1,14 +1,22 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
+	"mime"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/c-bata/go-prompt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/client"
 	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/command"
@@ -18,11 +26,17 @@ import (
 
 var (
 	gkeeperClient *client.Client
+	// остановка подписки на изменения
+	stopWatch context.CancelFunc
 )
 
 var (
 	logLevel                             string
 	serverAddress                        string
+	cacheDir                             string
+	conflictStrategy                     string
+	chunkSize                            int
+	parts                                int
 	buildVersion, buildDate, buildCommit string
 )
 
@@ -36,6 +50,10 @@ func main() {
 
 	flag.StringVar(&logLevel, "l", "error", "log level")
 	flag.StringVar(&serverAddress, "a", ":28000", "gophkeeper server addres")
+	flag.StringVar(&cacheDir, "c", defaultCacheDir(), "offline cache directory, empty to disable")
+	flag.StringVar(&conflictStrategy, "conflict", string(client.KeepBoth), "sync conflict strategy: local, remote, both")
+	flag.IntVar(&chunkSize, "cs", 0, "file transfer chunk size in bytes, 0 - server default")
+	flag.IntVar(&parts, "p", 0, "max parallel parts of large file upload, 0 - server default, 1 - single stream")
 	flag.Parse()
 
 	if err := run(); err != nil {
@@ -50,10 +68,21 @@ func run() error {
 		return err
 	}
 
-	gkeeperClient, err = client.NewClient(serverAddress)
+	if _, err = client.ParseConflictStrategy(conflictStrategy); err != nil {
+		return err
+	}
+
+	gkeeperClient, err = client.NewClient(serverAddress, cacheDir)
 	if err != nil {
 		return err
 	}
+	gkeeperClient.SetChunkSize(chunkSize)
+	gkeeperClient.SetParts(parts)
+
+	if flag.Arg(0) == "ssh-agent" {
+		defer gkeeperClient.Close()
+		return runSSHAgent(flag.Args()[1:])
+	}
 
 	p := prompt.New(
 		executor,
@@ -93,10 +122,30 @@ func executor(line string) {
 		cmd = newCardsCmd(args)
 	case "note":
 		cmd = newNotesCmd(args)
+	case "item":
+		cmd = newItemsCmd(args)
+	case "otp":
+		cmd = newOtpsCmd(args)
+	case "ssh":
+		cmd = newSshKeysCmd(args)
+	case "ssh-agent":
+		cmd = newSSHAgentCmd(args)
 	case "file":
 		cmd = newFilesCmd(args)
 	case "password":
 		cmd = newPasswordsCmd(args)
+	case "watch":
+		cmd = newWatchCmd(args)
+	case "sync":
+		cmd = newSyncCmd(args)
+	case "import":
+		cmd = newImportCmd(args)
+	case "tag":
+		cmd = newTagCmd(args)
+	case "folder":
+		cmd = newFolderCmd(args)
+	case "find":
+		cmd = newFindCmd(args)
 	default:
 		fmt.Println("неизвестная команда:", line)
 		return
@@ -128,22 +177,49 @@ func completer(d prompt.Document) []prompt.Suggest {
 			{Text: "note", Description: "работа с хранилищем заметок"},
 			{Text: "card", Description: "работа с хранилищем карт"},
 			{Text: "file", Description: "работа с хранилищем файлов"},
+			{Text: "item", Description: "работа с произвольными элементами"},
+			{Text: "otp", Description: "работа с одноразовыми паролями TOTP/HOTP"},
+			{Text: "ssh", Description: "работа с ключами SSH"},
+
+			{Text: "tag", Description: "работа с метками"},
+			{Text: "folder", Description: "работа с папками"},
+			{Text: "find", Description: "[-k store[,store]] [-n count] query поиск по всем хранилищам"},
+
+			{Text: "watch", Description: "вкл/выкл уведомления об изменениях"},
+			{Text: "ssh-agent", Description: "[-s socket] вкл/выкл агент SSH с ключами хранилища"},
+			{Text: "sync", Description: "[local|remote|both] отправка изменений, сделанных без связи"},
+			{Text: "import", Description: "[file [best]] пакетная загрузка операций из json файла"},
 		}
 	case 2:
 		switch words[0] {
+		case "sync":
+			s = []prompt.Suggest{
+				{Text: string(client.KeepLocal), Description: "оставить локальную версию"},
+				{Text: string(client.KeepRemote), Description: "оставить версию сервера"},
+				{Text: string(client.KeepBoth), Description: "сохранить обе версии"},
+			}
 		case "user":
 			for _, u := range gkeeperClient.GetUsers() {
 				s = append(s, prompt.Suggest{Text: u})
 			}
-		case "password", "note", "card", "file":
+		case "password", "note", "card", "file", "item", "otp", "ssh":
 			s = []prompt.Suggest{
 				{Text: "ls", Description: "показать список"},
 				{Text: "get", Description: "прочитать данные из хранилища"},
 				{Text: "new", Description: "добавить данные в хранилище"},
 				{Text: "upd", Description: "обновить данные"},
+				{Text: "mv", Description: "[name new_name] переименовать"},
 				{Text: "del", Description: "удалить из хранилища"},
 			}
+			if words[0] == "file" {
+				s = append(s, prompt.Suggest{Text: "sync",
+					Description: "[--delete] [--watch] [-f folder] dir синхронизировать каталог"})
+			}
+		default:
+			s = organizeSuggest(words)
 		}
+	default:
+		s = organizeSuggest(words)
 	}
 
 	return prompt.FilterHasPrefix(s, d.GetWordBeforeCursor(), true)
@@ -152,13 +228,29 @@ func completer(d prompt.Document) []prompt.Suggest {
 func livePrefix() (prefix string, useLivePrefix bool) {
 	useLivePrefix = true
 	if gkeeperClient.GetUser() != "" {
-		prefix = gkeeperClient.GetUser() + "@" + serverAddress + "> "
+		prefix = gkeeperClient.GetUser() + "@" + serverAddress
 	} else {
-		prefix = serverAddress + "> "
+		prefix = serverAddress
+	}
+	if gkeeperClient.Offline() {
+		prefix += " [offline]"
 	}
+	if n := gkeeperClient.Pending(); n > 0 {
+		prefix += fmt.Sprintf(" [%d]", n)
+	}
+	prefix += "> "
 	return
 }
 
+// defaultCacheDir каталог кэша по умолчанию
+func defaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "gophkeeper")
+}
+
 func newPingCmd(args []string) *command.Command {
 	return command.New(func(m map[string]string) error {
 		logger.Debug("ping", "args", args)
@@ -207,12 +299,76 @@ func newLoginCmd(args []string) *command.Command {
 	return command.New(func(m map[string]string) error {
 		login := m["login"]
 		passwd := m["password"]
-		return gkeeperClient.Login(login, passwd)
+		if err := gkeeperClient.Login(login, passwd); err != nil {
+			return err
+		}
+		if gkeeperClient.Offline() {
+			fmt.Println("сервер недоступен, работа с локальным кэшем")
+		} else if gkeeperClient.Pending() > 0 {
+			return syncPending(client.ConflictStrategy(conflictStrategy))
+		}
+		return nil
 	},
 		args,
 		"login", "password")
 }
 
+func newSyncCmd(args []string) *command.Command {
+	return command.New(func(m map[string]string) error {
+		strategy := client.ConflictStrategy(conflictStrategy)
+		if s := m["strategy"]; s != "" {
+			var err error
+			if strategy, err = client.ParseConflictStrategy(s); err != nil {
+				return err
+			}
+		}
+		return syncPending(strategy)
+	}, args, "strategy")
+}
+
+// syncPending отправка изменений, сделанных без связи с сервером
+func syncPending(strategy client.ConflictStrategy) error {
+	report, err := gkeeperClient.Sync(strategy)
+	fmt.Println("применено:", report.Applied, "конфликтов:", report.Conflicts)
+	for _, name := range report.Renamed {
+		fmt.Println("локальная версия сохранена как:", name)
+	}
+	return err
+}
+
+func newImportCmd(args []string) *command.Command {
+	return command.New(func(m map[string]string) error {
+		data, err := os.ReadFile(m["file"])
+		if err != nil {
+			return err
+		}
+
+		var req pb.BatchRequest
+		if err = protojson.Unmarshal(data, &req); err != nil {
+			return err
+		}
+		req.BestEffort = req.BestEffort || m["mode"] == "best"
+
+		resp, err := gkeeperClient.Batch(&req)
+		if err != nil {
+			return err
+		}
+		applied := 0
+		for i, res := range resp.Results {
+			if codes.Code(res.Code) == codes.OK {
+				applied++
+				continue
+			}
+			fmt.Printf("%d: %s %s\n", i+1, codes.Code(res.Code), res.Message)
+		}
+		if !resp.Committed {
+			return fmt.Errorf("изменения отменены")
+		}
+		fmt.Println("выполнено операций:", applied)
+		return nil
+	}, args, "file", "mode")
+}
+
 func newUserCmd(args []string) *command.Command {
 	return command.New(func(m map[string]string) error {
 		return gkeeperClient.SetUser(m["name"])
@@ -221,19 +377,60 @@ func newUserCmd(args []string) *command.Command {
 		"name")
 }
 
+// usageOf использование относительно ограничения, 0 - без ограничения
+func usageOf(used, limit int64) string {
+	if limit == 0 {
+		return fmt.Sprint(used)
+	}
+	return fmt.Sprintf("%d of %d", used, limit)
+}
+
 func newListCmd(args []string) *command.Command {
 	return command.New(func(m map[string]string) error {
 		resp, err := gkeeperClient.List()
 		if err == nil {
-			fmt.Println("cards:", resp.CardsCount)
-			fmt.Println("files:", resp.BinariesCount)
-			fmt.Println("notes:", resp.NotesCount)
-			fmt.Println("passwords:", resp.PasswordsCount)
+			items := resp.GetLimits().GetItems()
+			fmt.Println("cards:", usageOf(int64(resp.CardsCount), int64(items)))
+			fmt.Println("files:", usageOf(int64(resp.BinariesCount), int64(items)))
+			fmt.Println("items:", usageOf(int64(resp.ItemsCount), int64(items)))
+			fmt.Println("otps:", usageOf(int64(resp.OtpsCount), int64(items)))
+			fmt.Println("ssh keys:", usageOf(int64(resp.SshKeysCount), int64(items)))
+			fmt.Println("notes:", usageOf(int64(resp.NotesCount), int64(items)))
+			fmt.Println("passwords:", usageOf(int64(resp.PasswordsCount), int64(items)))
+			fmt.Println("files size:", usageOf(resp.BinariesSize, resp.GetLimits().GetBytes()))
+			if size := resp.GetLimits().GetFileSize(); size > 0 {
+				fmt.Println("max file size:", size)
+			}
 		}
 		return err
 	}, args)
 }
 
+// newWatchCmd - включение и выключение уведомлений об изменениях хранилища
+func newWatchCmd(args []string) *command.Command {
+	return command.New(func(m map[string]string) error {
+		if stopWatch != nil {
+			stopWatch()
+			stopWatch = nil
+			fmt.Println("уведомления выключены")
+			return nil
+		}
+
+		var ctx context.Context
+		ctx, stopWatch = context.WithCancel(context.Background())
+		go func() {
+			err := gkeeperClient.Watch(ctx, func(e *pb.WatchEvent) {
+				fmt.Printf("\n[%d] %s %s: %s\n", e.Revision, e.Kind, e.Action, e.Name)
+			})
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "watch:", err)
+			}
+		}()
+		fmt.Println("уведомления включены")
+		return nil
+	}, args)
+}
+
 // newCardCmd - обработчики команда работы с картами
 func newCardsCmd(args []string) *command.Command {
 	var (
@@ -251,24 +448,19 @@ func newCardsCmd(args []string) *command.Command {
 
 	switch subcmd {
 	case "", "ls", "list":
-		return command.New(func(m map[string]string) error {
-			names, err := gkeeperClient.CardList()
-			if err != nil {
-				return err
-			} else if len(names) == 0 {
-				fmt.Println("нет сохраненных карт")
-			} else {
-				fmt.Println(strings.Join(names, "\n"))
-			}
-			return nil
-		}, subargs)
+		return newLsCmd(subargs, gkeeperClient.CardList, false, "нет сохраненных карт")
 	case "new":
+		ttl, subargs, err := parseTTL("new", subargs)
+		if err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
 		return command.New(func(fields map[string]string) error {
 			in := pb.CardWriteRequest{
-				Name:   fields["name"],
-				Number: fields["number"],
-				Pin:    fields["pin"],
-				Notes:  fields["notes"],
+				Name:      fields["name"],
+				Number:    fields["number"],
+				Pin:       fields["pin"],
+				Notes:     fields["notes"],
+				ExpiresAt: ttl.expiresAt(),
 			}
 			return gkeeperClient.CardWrite(&in)
 		}, subargs, "name", "number", "pin", "notes")
@@ -283,10 +475,15 @@ func newCardsCmd(args []string) *command.Command {
 				fmt.Println("number:", resp.Number)
 				fmt.Println("pin:", resp.Pin)
 				fmt.Println("notes:", resp.Notes)
+				printExpiry(resp.ExpiresAt)
 			}
 			return err
 		}, subargs, "name")
 	case "upd":
+		ttl, subargs, err := parseTTL("upd", subargs)
+		if err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
 		return command.New(func(fields map[string]string) error {
 			in := pb.CardWriteRequest{
 				Name:   fields["new name"],
@@ -294,8 +491,20 @@ func newCardsCmd(args []string) *command.Command {
 				Pin:    fields["new pin"],
 				Notes:  fields["new notes"],
 			}
+			var err error
+			in.ExpiresAt, err = ttl.update(func() (*timestamppb.Timestamp, error) {
+				resp, err := gkeeperClient.CardRead(&pb.CardReadRequest{Name: fields["name"]})
+				return resp.GetExpiresAt(), err
+			})
+			if err != nil {
+				return err
+			}
 			return gkeeperClient.CardUpdate(fields["name"], &in)
 		}, subargs, "name", "new name", "new number", "new pin", "new notes")
+	case "mv":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.CardRename(fields["name"], fields["new name"])
+		}, subargs, "name", "new name")
 	case "del":
 		return command.New(func(fields map[string]string) error {
 			in := pb.CardDelRequest{
@@ -320,23 +529,18 @@ func newNotesCmd(args []string) *command.Command {
 
 	switch subcmd {
 	case "", "ls", "list":
-		return command.New(func(m map[string]string) error {
-			names, err := gkeeperClient.NoteList()
-			if err != nil {
-				return err
-			} else if len(names) == 0 {
-				fmt.Println("нет сохраненных заметок")
-			} else {
-				fmt.Println(strings.Join(names, "\n"))
-			}
-			return nil
-		}, subargs)
+		return newLsCmd(subargs, gkeeperClient.NoteList, false, "нет сохраненных заметок")
 
 	case "new":
+		ttl, subargs, err := parseTTL("new", subargs)
+		if err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
 		return command.New(func(fields map[string]string) error {
 			in := pb.NoteWriteRequest{
-				Name:  fields["name"],
-				Notes: fields["notes"],
+				Name:      fields["name"],
+				Notes:     fields["notes"],
+				ExpiresAt: ttl.expiresAt(),
 			}
 			return gkeeperClient.NoteWrite(&in)
 		}, subargs, "name", "notes")
@@ -350,19 +554,36 @@ func newNotesCmd(args []string) *command.Command {
 			if err == nil {
 				fmt.Println("name:", resp.Name)
 				fmt.Println("notes:", resp.Notes)
+				printExpiry(resp.ExpiresAt)
 			}
 			return err
 		}, subargs, "name")
 
 	case "upd":
+		ttl, subargs, err := parseTTL("upd", subargs)
+		if err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
 		return command.New(func(fields map[string]string) error {
 			in := pb.NoteWriteRequest{
 				Name:  fields["new name"],
 				Notes: fields["new notes"],
 			}
+			var err error
+			in.ExpiresAt, err = ttl.update(func() (*timestamppb.Timestamp, error) {
+				resp, err := gkeeperClient.NoteRead(&pb.NoteReadRequest{Name: fields["name"]})
+				return resp.GetExpiresAt(), err
+			})
+			if err != nil {
+				return err
+			}
 			return gkeeperClient.NoteUpdate(fields["name"], &in)
 		}, subargs, "name", "new name", "new notes")
 
+	case "mv":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.NoteRename(fields["name"], fields["new name"])
+		}, subargs, "name", "new name")
 	case "del":
 		return command.New(func(fields map[string]string) error {
 			in := pb.NoteDelRequest{
@@ -391,29 +612,28 @@ func newFilesCmd(args []string) *command.Command {
 
 	switch subcmd {
 	case "", "ls", "list":
-		return command.New(func(m map[string]string) error {
-			names, err := gkeeperClient.BinaryList()
-			if err != nil {
-				return err
-			} else if len(names) == 0 {
-				fmt.Println("нет сохраненных файлов")
-			} else {
-				fmt.Println(strings.Join(names, "\n"))
-			}
-			return nil
-		}, subargs)
+		return newLsCmd(subargs, gkeeperClient.BinaryList, true, "нет сохраненных файлов")
 
 	case "new":
+		fs := flag.NewFlagSet("new", flag.ContinueOnError)
+		dir := fs.Bool("dir", false, "сохранить каталог tar архивом")
+		ttl := newTTLFlag(fs)
+		if err := fs.Parse(subargs); err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		if *dir {
+			return command.New(func(fields map[string]string) error {
+				return putDir(fields["dir"], fields["name"], fields["notes"],
+					compression(fields["compress (y/n)"]), ttl.expiresAt())
+			}, fs.Args(), "dir", "name", "notes", "compress (y/n)")
+		}
+
 		return command.New(func(fields map[string]string) error {
 			filename := fields["file"]
 			file, err := os.Open(filename)
 			if err != nil {
 				return err
 			}
-			fstat, err := file.Stat()
-			if err != nil {
-				return err
-			}
 			defer file.Close()
 
 			name := fields["name"]
@@ -421,22 +641,39 @@ func newFilesCmd(args []string) *command.Command {
 				name = path.Base(filename)
 			}
 
-			//резервируем идентификатор под файл
-			in := pb.BinaryWriteRequest{
-				Name:  name,
-				Notes: fields["notes"],
-				Size:  fstat.Size(),
-			}
-
-			id, err := gkeeperClient.BinaryWrite(&in)
+			meta, err := fileMeta(file)
 			if err != nil {
 				return err
 			}
-			return gkeeperClient.BinaryUpload(id, file)
 
-		}, subargs, "file", "name", "notes")
+			// запись появится на сервере вместе с содержимым, тип
+			// содержимого без известного расширения определит сервер
+			header := pb.BinaryPutHeader{
+				Name:        name,
+				Notes:       fields["notes"],
+				ContentType: mime.TypeByExtension(path.Ext(filename)),
+				Compression: compression(fields["compress (y/n)"]),
+				Meta:        meta,
+				ExpiresAt:   ttl.expiresAt(),
+			}
+			_, err = gkeeperClient.BinaryPut(&header, file)
+			return err
+
+		}, fs.Args(), "file", "name", "notes", "compress (y/n)")
 
 	case "get":
+		fs := flag.NewFlagSet("get", flag.ContinueOnError)
+		resume := fs.Bool("resume", false, "догрузить частично выгруженный файл")
+		dir := fs.Bool("dir", false, "распаковать сохранённый каталог")
+		if err := fs.Parse(subargs); err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		if *resume && *dir {
+			return command.New(func(map[string]string) error {
+				return errors.New("флаги --resume и --dir несовместимы")
+			}, nil)
+		}
+
 		return command.New(func(fields map[string]string) error {
 			req := pb.BinaryReadRequest{
 				Name: fields["name"],
@@ -448,23 +685,72 @@ func newFilesCmd(args []string) *command.Command {
 			}
 			fmt.Println("name:", resp.Name)
 			fmt.Println("size:", resp.Size)
+			if resp.ContentType != "" {
+				fmt.Println("content type:", resp.ContentType)
+			}
+			if meta := resp.Meta; meta.GetFilename() != "" {
+				fmt.Println("filename:", meta.Filename)
+			}
+			if mode := resp.Meta.GetMode(); mode != 0 {
+				fmt.Println("mode:", os.FileMode(mode))
+			}
+			if mtime := resp.Meta.GetMtime(); mtime != nil {
+				fmt.Println("modified:", mtime.AsTime().Local().Format(time.DateTime))
+			}
+			if resp.Compression != "" {
+				fmt.Printf("stored: %d (%s)\n", resp.StoredSize, resp.Compression)
+			}
 			fmt.Println("notes:", resp.Notes)
+			printExpiry(resp.ExpiresAt)
+			if len(resp.Sha256) > 0 {
+				fmt.Printf("sha256: %x\n", resp.Sha256)
+			}
 
 			filename := fields["save to file"]
+			if filename == "" {
+				filename = resp.Meta.GetFilename()
+			}
 			if filename == "" {
 				filename = resp.Name
 			}
+			if *dir {
+				return getDir(resp, filename)
+			}
+			if *resume {
+				file, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0o644)
+				if err != nil {
+					return err
+				}
+				err = gkeeperClient.BinaryResume(resp.BinId, resp.Sha256, file)
+				file.Close()
+				if err != nil {
+					return err
+				}
+				return restoreMeta(filename, resp.Meta)
+			}
+
 			file, err := os.Create(filename)
 			if err != nil {
 				return err
 			}
-			defer file.Close()
 
-			err = gkeeperClient.BinaryDownload(resp.BinId, file)
-			return err
-		}, subargs, "name", "save to file")
+			err = gkeeperClient.BinaryDownload(resp.BinId, resp.Sha256, file)
+			file.Close()
+			if errors.Is(err, client.ErrCorrupted) {
+				// повреждённое содержимое не оставляем на диске
+				os.Remove(filename)
+			}
+			if err != nil {
+				return err
+			}
+			return restoreMeta(filename, resp.Meta)
+		}, fs.Args(), "name", "save to file")
 
 	case "upd":
+		ttl, subargs, err := parseTTL("upd", subargs)
+		if err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
 		return command.New(func(fields map[string]string) error {
 			req := pb.BinaryReadRequest{
 				Name: fields["name"],
@@ -486,6 +772,9 @@ func newFilesCmd(args []string) *command.Command {
 			if upd.Notes == "" {
 				upd.Notes = resp.Notes
 			}
+			upd.ExpiresAt, _ = ttl.update(func() (*timestamppb.Timestamp, error) {
+				return resp.ExpiresAt, nil
+			})
 
 			var (
 				file  *os.File
@@ -505,6 +794,7 @@ func newFilesCmd(args []string) *command.Command {
 				}
 				defer file.Close()
 				upd.Size = fstat.Size()
+				upd.Meta = statMeta(file.Name(), fstat)
 			}
 
 			err = gkeeperClient.BinaryUpdate(resp.Id, binID, &upd)
@@ -513,12 +803,19 @@ func newFilesCmd(args []string) *command.Command {
 			}
 
 			if file != nil {
-				return gkeeperClient.BinaryUpload(resp.BinId, file)
+				return gkeeperClient.BinaryUpload(resp.BinId, file, compression(fields["compress (y/n)"]))
 			}
 			return nil
 
-		}, subargs, "name", "new file", "new name", "new notes")
+		}, subargs, "name", "new file", "new name", "new notes", "compress (y/n)")
+
+	case "sync":
+		return newFileSyncCmd(subargs)
 
+	case "mv":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.BinaryRename(fields["name"], fields["new name"])
+		}, subargs, "name", "new name")
 	case "del":
 		return command.New(func(fields map[string]string) error {
 			in := pb.BinaryDelRequest{
@@ -534,6 +831,56 @@ func newFilesCmd(args []string) *command.Command {
 	}
 }
 
+// fileMeta исходные атрибуты открытого файла
+func fileMeta(file *os.File) (*pb.FileMeta, error) {
+	fstat, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return statMeta(file.Name(), fstat), nil
+}
+
+// statMeta исходные атрибуты файла по его описанию
+func statMeta(filename string, fstat os.FileInfo) *pb.FileMeta {
+	return &pb.FileMeta{
+		Filename: filepath.Base(filename),
+		Mtime:    timestamppb.New(fstat.ModTime()),
+		Mode:     uint32(fstat.Mode().Perm()),
+	}
+}
+
+// restoreMeta восстановление прав доступа и времени изменения
+// выгруженного файла, не переданные атрибуты не меняются
+func restoreMeta(filename string, meta *pb.FileMeta) error {
+	if mode := meta.GetMode(); mode != 0 {
+		if err := os.Chmod(filename, os.FileMode(mode).Perm()); err != nil {
+			return err
+		}
+	}
+	if mtime := meta.GetMtime(); mtime != nil {
+		t := mtime.AsTime()
+		return os.Chtimes(filename, t, t)
+	}
+	return nil
+}
+
+// compression алгоритм сжатия файла по ответу пользователя
+func compression(s string) string {
+	if isYes(s) {
+		return "zstd"
+	}
+	return ""
+}
+
+// isYes утвердительный ответ пользователя
+func isYes(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "y", "yes", "д", "да":
+		return true
+	}
+	return false
+}
+
 // newCardCmd - обработчики команда работы с паролями
 func newPasswordsCmd(args []string) *command.Command {
 	var (
@@ -552,28 +899,25 @@ func newPasswordsCmd(args []string) *command.Command {
 	switch subcmd {
 	// Список паролей
 	case "", "ls", "list":
-		return command.New(func(m map[string]string) error {
-			names, err := gkeeperClient.PasswordList()
-			if err != nil {
-				return err
-			} else if len(names) == 0 {
-				fmt.Println("нет сохраненных паролей")
-			} else {
-				fmt.Println(strings.Join(names, "\n"))
-			}
-			return nil
-		}, subargs)
+		return newLsCmd(subargs, gkeeperClient.PasswordList, false, "нет сохраненных паролей")
 	// Создание нового
 	case "new":
+		ttl, subargs, err := parseTTL("new", subargs)
+		if err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
 		return command.New(func(fields map[string]string) error {
 			in := pb.PasswordWriteRequest{
-				Name:     fields["name"],
-				Username: fields["username"],
-				Password: fields["password"],
-				Notes:    fields["notes"],
+				Name:       fields["name"],
+				Username:   fields["username"],
+				Password:   fields["password"],
+				Notes:      fields["notes"],
+				Url:        fields["url"],
+				Searchable: isYes(fields["search by username (y/n)"]),
+				ExpiresAt:  ttl.expiresAt(),
 			}
 			return gkeeperClient.PasswordWrite(&in)
-		}, subargs, "name", "username", "password", "notes")
+		}, subargs, "name", "username", "password", "notes", "url", "search by username (y/n)")
 	// получение
 	case "get":
 		return command.New(func(fields map[string]string) error {
@@ -586,21 +930,41 @@ func newPasswordsCmd(args []string) *command.Command {
 				fmt.Println("username:", resp.Username)
 				fmt.Println("password:", resp.Password)
 				fmt.Println("notes:", resp.Notes)
+				fmt.Println("url:", resp.Url)
+				printExpiry(resp.ExpiresAt)
 			}
 			return err
 		}, subargs, "name")
 	// обновление
 	case "upd":
+		ttl, subargs, err := parseTTL("upd", subargs)
+		if err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
 		return command.New(func(fields map[string]string) error {
 			in := pb.PasswordWriteRequest{
-				Name:     fields["new name"],
-				Username: fields["new username"],
-				Password: fields["new password"],
-				Notes:    fields["new notes"],
+				Name:       fields["new name"],
+				Username:   fields["new username"],
+				Password:   fields["new password"],
+				Notes:      fields["new notes"],
+				Url:        fields["new url"],
+				Searchable: isYes(fields["search by username (y/n)"]),
+			}
+			var err error
+			in.ExpiresAt, err = ttl.update(func() (*timestamppb.Timestamp, error) {
+				resp, err := gkeeperClient.PasswordRead(&pb.PasswordReadRequest{Name: fields["name"]})
+				return resp.GetExpiresAt(), err
+			})
+			if err != nil {
+				return err
 			}
 			return gkeeperClient.PasswordUpdate(fields["name"], &in)
-		}, subargs, "name", "new name", "new username", "new password", "new notes")
+		}, subargs, "name", "new name", "new username", "new password", "new notes", "new url", "search by username (y/n)")
 	// Удаление
+	case "mv":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.PasswordRename(fields["name"], fields["new name"])
+		}, subargs, "name", "new name")
 	case "del":
 		return command.New(func(fields map[string]string) error {
 			in := pb.PasswordDelRequest{
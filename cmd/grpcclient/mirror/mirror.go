@@ -0,0 +1,346 @@
+// Package mirror синхронизация локального каталога с хранилищем файлов:
+// файлы сопоставляются по относительному пути, который служит наименованием
+// на сервере, и сравниваются по размеру и SHA-256
+package mirror
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// StateFile файл состояния последней синхронизации в каталоге
+	StateFile = ".gophkeeper-sync.json"
+	// tempPrefix префикс временных файлов выгрузки
+	tempPrefix = ".gophkeeper-sync-"
+	// maxName наибольшая длина наименования файла на сервере
+	maxName = 64
+)
+
+// Remote файл на сервере
+type Remote struct {
+	Name    string
+	Size    int64
+	SHA256  []byte      // пусто - загрузка не завершена
+	Mode    fs.FileMode // исходные права, 0 - неизвестны
+	ModTime time.Time   // исходное время изменения, нулевое - неизвестно
+}
+
+// Vault хранилище файлов на сервере
+type Vault interface {
+	List() ([]Remote, error)
+	Create(name, path string) error            // новый файл с содержимым
+	Update(remote Remote, path string) error   // замена содержимого
+	Download(remote Remote, w io.Writer) error // выгрузка со сверкой дайджеста
+	Delete(name string) error
+}
+
+// Local файл в каталоге
+type Local struct {
+	Name    string // путь относительно каталога, разделитель "/"
+	Path    string
+	Size    int64
+	ModTime time.Time
+	SHA256  []byte
+}
+
+// ActionKind вид действия синхронизации
+type ActionKind int
+
+const (
+	Upload ActionKind = iota
+	Download
+	DeleteLocal
+	DeleteRemote
+)
+
+func (k ActionKind) String() string {
+	switch k {
+	case Upload:
+		return "upload"
+	case Download:
+		return "download"
+	case DeleteLocal:
+		return "delete local"
+	case DeleteRemote:
+		return "delete remote"
+	}
+	return "unknown"
+}
+
+// Action действие синхронизации над файлом
+type Action struct {
+	Kind ActionKind
+	Name string
+}
+
+// Report результат синхронизации
+type Report struct {
+	Done    []Action
+	Failed  map[string]error // наименование - ошибка действия
+	Skipped []string         // файлы, которые нельзя сопоставить по пути
+}
+
+// Options параметры синхронизации
+type Options struct {
+	Delete bool         // распространять удаления
+	Log    func(Action) // вызывается после каждого выполненного действия
+}
+
+// entry запись состояния о файле, одинаковом на обеих сторонах
+type entry struct {
+	SHA256  []byte    `json:"sha256"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mtime"`
+}
+
+// Syncer синхронизация каталога с хранилищем
+type Syncer struct {
+	dir   string
+	vault Vault
+	opts  Options
+}
+
+// New - функция-конструктор синхронизации каталога dir
+func New(dir string, vault Vault, opts Options) *Syncer {
+	return &Syncer{dir: dir, vault: vault, opts: opts}
+}
+
+// Sync однократная синхронизация: загрузка новых и изменённых файлов,
+// выгрузка отсутствующих локально, с Options.Delete - удаление на той
+// стороне, где файл был удалён после прошлой синхронизации. Ошибки
+// отдельных файлов собираются в отчёт, остальные действия выполняются.
+func (s *Syncer) Sync() (Report, error) {
+	state, err := s.loadState()
+	if err != nil {
+		return Report{}, err
+	}
+	local, skipped, err := s.scan(state)
+	if err != nil {
+		return Report{}, err
+	}
+	list, err := s.vault.List()
+	if err != nil {
+		return Report{}, err
+	}
+	remote := make(map[string]Remote, len(list))
+	for _, r := range list {
+		// наименование, не являющееся путём внутри каталога, не выгружается
+		if !filepath.IsLocal(filepath.FromSlash(r.Name)) {
+			skipped = append(skipped, r.Name)
+			continue
+		}
+		remote[r.Name] = r
+	}
+
+	report := Report{Skipped: skipped, Failed: make(map[string]error)}
+	for _, a := range plan(local, remote, state, s.opts.Delete) {
+		if err = s.apply(a, local, remote); err != nil {
+			report.Failed[a.Name] = err
+			continue
+		}
+		report.Done = append(report.Done, a)
+		if s.opts.Log != nil {
+			s.opts.Log(a)
+		}
+	}
+
+	// состояние заново по результату, неудачные действия сохраняют прежнее
+	next := make(map[string]entry, len(local))
+	if local, _, err = s.scan(state); err != nil {
+		return report, err
+	}
+	for name, l := range local {
+		if _, failed := report.Failed[name]; !failed {
+			next[name] = entry{SHA256: l.SHA256, Size: l.Size, ModTime: l.ModTime}
+		}
+	}
+	for name, e := range state {
+		if _, failed := report.Failed[name]; failed {
+			next[name] = e
+		}
+	}
+	return report, s.saveState(next)
+}
+
+// plan действия для приведения сторон в соответствие. При изменении
+// на обеих сторонах сохраняется локальная версия. Без признака удаления
+// файл, отсутствующий на одной из сторон, копируется на неё.
+func plan(local map[string]Local, remote map[string]Remote, state map[string]entry, del bool) []Action {
+	var actions []Action
+	for name, l := range local {
+		r, ok := remote[name]
+		prev, synced := state[name]
+		switch {
+		case !ok && synced && del:
+			actions = append(actions, Action{Kind: DeleteLocal, Name: name})
+		case !ok:
+			actions = append(actions, Action{Kind: Upload, Name: name})
+		case l.Size == r.Size && bytes.Equal(l.SHA256, r.SHA256):
+		case synced && len(r.SHA256) > 0 && bytes.Equal(l.SHA256, prev.SHA256):
+			actions = append(actions, Action{Kind: Download, Name: name})
+		default:
+			actions = append(actions, Action{Kind: Upload, Name: name})
+		}
+	}
+	for name, r := range remote {
+		if _, ok := local[name]; ok {
+			continue
+		}
+		if _, synced := state[name]; synced && del {
+			actions = append(actions, Action{Kind: DeleteRemote, Name: name})
+		} else if len(r.SHA256) > 0 {
+			actions = append(actions, Action{Kind: Download, Name: name})
+		}
+	}
+	// порядок по наименованию для воспроизводимости
+	sort.Slice(actions, func(i, j int) bool { return actions[i].Name < actions[j].Name })
+	return actions
+}
+
+// apply выполнение действия
+func (s *Syncer) apply(a Action, local map[string]Local, remote map[string]Remote) error {
+	switch a.Kind {
+	case Upload:
+		if r, ok := remote[a.Name]; ok {
+			return s.vault.Update(r, local[a.Name].Path)
+		}
+		return s.vault.Create(a.Name, local[a.Name].Path)
+	case Download:
+		return s.download(remote[a.Name])
+	case DeleteLocal:
+		return os.Remove(local[a.Name].Path)
+	case DeleteRemote:
+		return s.vault.Delete(a.Name)
+	}
+	return fmt.Errorf("unknown action %d", a.Kind)
+}
+
+// download выгрузка во временный файл рядом с целевым, который заменяет
+// целевой только после успешной сверки дайджеста
+func (s *Syncer) download(r Remote) error {
+	target := filepath.Join(s.dir, filepath.FromSlash(r.Name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(target), tempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	err = s.vault.Download(r, tmp)
+	if e := tmp.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		return err
+	}
+
+	mode := r.Mode.Perm()
+	if mode == 0 {
+		mode = 0o600
+	}
+	if err = os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+	if !r.ModTime.IsZero() {
+		if err = os.Chtimes(tmp.Name(), r.ModTime, r.ModTime); err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmp.Name(), target)
+}
+
+// scan файлы каталога с дайджестами. Дайджест файла, размер и время
+// изменения которого совпадают с состоянием, не пересчитывается.
+func (s *Syncer) scan(state map[string]entry) (map[string]Local, []string, error) {
+	local := make(map[string]Local)
+	var skipped []string
+	err := filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() || Ignored(path) {
+			return nil
+		}
+		rel, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if len(name) > maxName {
+			skipped = append(skipped, name)
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		l := Local{Name: name, Path: path, Size: info.Size(), ModTime: info.ModTime()}
+		if e, ok := state[name]; ok && e.Size == l.Size && e.ModTime.Equal(l.ModTime) {
+			l.SHA256 = e.SHA256
+		} else if l.SHA256, err = digest(path); err != nil {
+			return err
+		}
+		local[name] = l
+		return nil
+	})
+	return local, skipped, err
+}
+
+// Ignored служебные файлы синхронизации, не подлежащие загрузке
+func Ignored(path string) bool {
+	base := filepath.Base(path)
+	return base == StateFile || strings.HasPrefix(base, tempPrefix)
+}
+
+// digest SHA-256 содержимого файла
+func digest(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// loadState состояние прошлой синхронизации, пустое при первой
+func (s *Syncer) loadState() (map[string]entry, error) {
+	state := make(map[string]entry)
+	data, err := os.ReadFile(filepath.Join(s.dir, StateFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return state, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("sync state: %w", err)
+	}
+	return state, nil
+}
+
+// saveState запись состояния синхронизации
+func (s *Syncer) saveState(state map[string]entry) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(s.dir, StateFile), data, 0o600)
+}
@@ -0,0 +1,315 @@
+package mirror
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVault хранилище файлов в памяти
+type fakeVault struct {
+	mu          sync.Mutex
+	files       map[string]string
+	downloadErr error
+}
+
+func newFakeVault(files map[string]string) *fakeVault {
+	if files == nil {
+		files = make(map[string]string)
+	}
+	return &fakeVault{files: files}
+}
+
+func (v *fakeVault) List() ([]Remote, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	var list []Remote
+	for name, content := range v.files {
+		sum := sha256.Sum256([]byte(content))
+		list = append(list, Remote{Name: name, Size: int64(len(content)), SHA256: sum[:], Mode: 0o640})
+	}
+	return list, nil
+}
+
+func (v *fakeVault) Create(name, path string) error {
+	return v.store(name, path)
+}
+
+func (v *fakeVault) Update(r Remote, path string) error {
+	return v.store(r.Name, path)
+}
+
+func (v *fakeVault) store(name, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.files[name] = string(data)
+	return nil
+}
+
+func (v *fakeVault) Download(r Remote, w io.Writer) error {
+	if v.downloadErr != nil {
+		return v.downloadErr
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	_, err := io.WriteString(w, v.files[r.Name])
+	return err
+}
+
+func (v *fakeVault) Delete(name string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.files, name)
+	return nil
+}
+
+func (v *fakeVault) get(name string) (string, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	content, ok := v.files[name]
+	return content, ok
+}
+
+func sum(s string) []byte {
+	h := sha256.Sum256([]byte(s))
+	return h[:]
+}
+
+func TestPlan(t *testing.T) {
+	local := func(content string) Local {
+		return Local{Size: int64(len(content)), SHA256: sum(content)}
+	}
+	remote := func(content string) Remote {
+		return Remote{Size: int64(len(content)), SHA256: sum(content)}
+	}
+	synced := func(content string) entry {
+		return entry{Size: int64(len(content)), SHA256: sum(content)}
+	}
+
+	tests := []struct {
+		name   string
+		local  map[string]Local
+		remote map[string]Remote
+		state  map[string]entry
+		del    bool
+		want   []Action
+	}{
+		{
+			name:   "equal",
+			local:  map[string]Local{"a": local("1")},
+			remote: map[string]Remote{"a": remote("1")},
+		},
+		{
+			name:  "new local",
+			local: map[string]Local{"a": local("1")},
+			want:  []Action{{Kind: Upload, Name: "a"}},
+		},
+		{
+			name:   "new remote",
+			remote: map[string]Remote{"a": remote("1")},
+			want:   []Action{{Kind: Download, Name: "a"}},
+		},
+		{
+			name:   "remote incomplete",
+			remote: map[string]Remote{"a": {Size: 5}},
+		},
+		{
+			name:   "changed local",
+			local:  map[string]Local{"a": local("2")},
+			remote: map[string]Remote{"a": remote("1")},
+			state:  map[string]entry{"a": synced("1")},
+			want:   []Action{{Kind: Upload, Name: "a"}},
+		},
+		{
+			name:   "changed remote",
+			local:  map[string]Local{"a": local("1")},
+			remote: map[string]Remote{"a": remote("2")},
+			state:  map[string]entry{"a": synced("1")},
+			want:   []Action{{Kind: Download, Name: "a"}},
+		},
+		{
+			name:   "changed both keeps local",
+			local:  map[string]Local{"a": local("2")},
+			remote: map[string]Remote{"a": remote("3")},
+			state:  map[string]entry{"a": synced("1")},
+			want:   []Action{{Kind: Upload, Name: "a"}},
+		},
+		{
+			name:   "differ without state keeps local",
+			local:  map[string]Local{"a": local("2")},
+			remote: map[string]Remote{"a": remote("3")},
+			want:   []Action{{Kind: Upload, Name: "a"}},
+		},
+		{
+			name:  "deleted remote",
+			local: map[string]Local{"a": local("1")},
+			state: map[string]entry{"a": synced("1")},
+			del:   true,
+			want:  []Action{{Kind: DeleteLocal, Name: "a"}},
+		},
+		{
+			name:   "deleted local",
+			remote: map[string]Remote{"a": remote("1")},
+			state:  map[string]entry{"a": synced("1")},
+			del:    true,
+			want:   []Action{{Kind: DeleteRemote, Name: "a"}},
+		},
+		{
+			name:   "deleted without propagation",
+			local:  map[string]Local{"b": local("1")},
+			remote: map[string]Remote{"a": remote("1")},
+			state:  map[string]entry{"a": synced("1"), "b": synced("1")},
+			want:   []Action{{Kind: Download, Name: "a"}, {Kind: Upload, Name: "b"}},
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			assert.Equal(t, tcase.want, plan(tcase.local, tcase.remote, tcase.state, tcase.del))
+		})
+	}
+}
+
+func TestSync(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "local"), []byte("local"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "nested"), []byte("nested"), 0o600))
+	vault := newFakeVault(map[string]string{"remote": "remote", "dir/file": "file"})
+
+	var logged []Action
+	s := New(dir, vault, Options{Log: func(a Action) { logged = append(logged, a) }})
+
+	report, err := s.Sync()
+	require.NoError(t, err)
+	assert.Empty(t, report.Failed)
+	assert.Equal(t, []Action{
+		{Kind: Download, Name: "dir/file"},
+		{Kind: Upload, Name: "local"},
+		{Kind: Download, Name: "remote"},
+		{Kind: Upload, Name: "sub/nested"},
+	}, report.Done)
+	assert.Equal(t, report.Done, logged)
+
+	content, _ := vault.get("sub/nested")
+	assert.Equal(t, "nested", content)
+	data, err := os.ReadFile(filepath.Join(dir, "dir", "file"))
+	require.NoError(t, err)
+	assert.Equal(t, "file", string(data))
+	info, err := os.Stat(filepath.Join(dir, "remote"))
+	require.NoError(t, err)
+	assert.Equal(t, fs.FileMode(0o640), info.Mode().Perm())
+
+	t.Run("nothing changed", func(t *testing.T) {
+		report, err := s.Sync()
+		require.NoError(t, err)
+		assert.Empty(t, report.Done)
+	})
+
+	t.Run("deletions restored without propagation", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dir, "local")))
+		report, err := New(dir, vault, Options{}).Sync()
+		require.NoError(t, err)
+		assert.Equal(t, []Action{{Kind: Download, Name: "local"}}, report.Done)
+	})
+
+	t.Run("deletions propagated", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dir, "local")))
+		require.NoError(t, vault.Delete("remote"))
+
+		report, err := New(dir, vault, Options{Delete: true}).Sync()
+		require.NoError(t, err)
+		assert.Equal(t, []Action{
+			{Kind: DeleteRemote, Name: "local"},
+			{Kind: DeleteLocal, Name: "remote"},
+		}, report.Done)
+
+		_, ok := vault.get("local")
+		assert.False(t, ok)
+		_, err = os.Stat(filepath.Join(dir, "remote"))
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+
+	t.Run("failed download retried", func(t *testing.T) {
+		vault.files["late"] = "late"
+		vault.downloadErr = errors.New("connection lost")
+		report, err := New(dir, vault, Options{Delete: true}).Sync()
+		require.NoError(t, err)
+		assert.Contains(t, report.Failed, "late")
+		// временный файл не остаётся
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		for _, e := range entries {
+			assert.False(t, Ignored(e.Name()) && e.Name() != StateFile, e.Name())
+		}
+
+		// неудавшаяся выгрузка не считается удалением на стороне клиента
+		vault.downloadErr = nil
+		report, err = New(dir, vault, Options{Delete: true}).Sync()
+		require.NoError(t, err)
+		assert.Equal(t, []Action{{Kind: Download, Name: "late"}}, report.Done)
+	})
+}
+
+func TestSyncSkipped(t *testing.T) {
+	dir := t.TempDir()
+	long := filepath.Join(dir, "very-long-directory-name-for-sync-test", "and-a-long-file-name-too.txt")
+	require.NoError(t, os.MkdirAll(filepath.Dir(long), 0o700))
+	require.NoError(t, os.WriteFile(long, []byte("x"), 0o600))
+	vault := newFakeVault(map[string]string{"../escape": "x"})
+
+	report, err := New(dir, vault, Options{}).Sync()
+	require.NoError(t, err)
+	assert.Empty(t, report.Done)
+	assert.ElementsMatch(t, []string{
+		"very-long-directory-name-for-sync-test/and-a-long-file-name-too.txt",
+		"../escape",
+	}, report.Skipped)
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	vault := newFakeVault(nil)
+	s := New(dir, vault, Options{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	synced := make(chan Report, 10)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.Watch(ctx, 10*time.Millisecond, func(r Report, err error) {
+			assert.NoError(t, err)
+			select {
+			case synced <- r:
+			default:
+			}
+		})
+	}()
+	<-synced
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "new"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "new", "file"), []byte("data"), 0o600))
+
+	assert.Eventually(t, func() bool {
+		content, ok := vault.get("new/file")
+		return ok && content == "data"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-errc)
+}
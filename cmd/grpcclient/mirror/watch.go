@@ -0,0 +1,73 @@
+package mirror
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Watch синхронизация при изменениях в каталоге до отмены ctx. Сначала
+// выполняется Sync, затем события файловой системы собираются в течение
+// delay после последнего, и выполняется следующий Sync. Результат каждой
+// синхронизации передаётся в done.
+func (s *Syncer) Watch(ctx context.Context, delay time.Duration, done func(Report, error)) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	if err = watchTree(w, s.dir); err != nil {
+		return err
+	}
+	done(s.Sync())
+
+	var timer <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case ev, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			if Ignored(ev.Name) {
+				continue
+			}
+			// вложенные каталоги отслеживаются отдельно
+			if ev.Has(fsnotify.Create) {
+				if info, err := os.Stat(ev.Name); err == nil && info.IsDir() {
+					if err = watchTree(w, ev.Name); err != nil {
+						return err
+					}
+				}
+			}
+			timer = time.After(delay)
+
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+			return err
+
+		case <-timer:
+			timer = nil
+			done(s.Sync())
+		}
+	}
+}
+
+// watchTree отслеживание каталога вместе с вложенными
+func watchTree(w *fsnotify.Watcher, dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return err
+		}
+		return w.Add(path)
+	})
+}
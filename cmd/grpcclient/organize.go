@@ -0,0 +1,131 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/c-bata/go-prompt"
+
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/command"
+)
+
+// itemKind вид данных на сервере по имени хранилища в клиенте
+func itemKind(store string) string {
+	if store == "file" {
+		return "binary"
+	}
+	return store
+}
+
+// newTagCmd - обработчики команд работы с метками
+func newTagCmd(args []string) *command.Command {
+	var (
+		subcmd  string
+		subargs []string
+	)
+	if len(args) > 0 {
+		subcmd = args[0]
+		subargs = args[1:]
+	}
+
+	switch subcmd {
+	case "", "ls", "list":
+		return command.New(func(map[string]string) error {
+			tags, err := gkeeperClient.TagList()
+			if err != nil {
+				return err
+			} else if len(tags) == 0 {
+				fmt.Println("нет меток")
+			} else {
+				fmt.Println(strings.Join(tags, "\n"))
+			}
+			return nil
+		}, subargs)
+	case "add":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.TagAdd(itemKind(fields["store"]), fields["name"],
+				strings.Split(fields["tags"], ",")...)
+		}, subargs, "store", "name", "tags")
+	case "rm", "del":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.TagRemove(itemKind(fields["store"]), fields["name"],
+				strings.Split(fields["tags"], ",")...)
+		}, subargs, "store", "name", "tags")
+	}
+	return command.New(func(map[string]string) error {
+		return fmt.Errorf("неизвестная команда: tag %s", strings.Join(args, " "))
+	}, nil)
+}
+
+// newFolderCmd - обработчики команд работы с папками
+func newFolderCmd(args []string) *command.Command {
+	var (
+		subcmd  string
+		subargs []string
+	)
+	if len(args) > 0 {
+		subcmd = args[0]
+		subargs = args[1:]
+	}
+
+	switch subcmd {
+	case "", "ls", "list":
+		return command.New(func(map[string]string) error {
+			folders, err := gkeeperClient.FolderList()
+			if err != nil {
+				return err
+			} else if len(folders) == 0 {
+				fmt.Println("нет папок")
+			} else {
+				fmt.Println(strings.Join(folders, "\n"))
+			}
+			return nil
+		}, subargs)
+	case "mv":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.FolderMove(itemKind(fields["store"]), fields["name"], fields["folder"])
+		}, subargs, "store", "name", "folder")
+	case "rm", "del":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.FolderDelete(fields["folder"])
+		}, subargs, "folder")
+	}
+	return command.New(func(map[string]string) error {
+		return fmt.Errorf("неизвестная команда: folder %s", strings.Join(args, " "))
+	}, nil)
+}
+
+// organizeSuggest подсказки для команд tag и folder
+func organizeSuggest(words []string) (s []prompt.Suggest) {
+	switch {
+	case len(words) == 2 && words[0] == "tag":
+		s = []prompt.Suggest{
+			{Text: "ls", Description: "список меток"},
+			{Text: "add", Description: "[store name tag,...] добавить метки"},
+			{Text: "rm", Description: "[store name tag,...] снять метки"},
+		}
+	case len(words) == 2 && words[0] == "folder":
+		s = []prompt.Suggest{
+			{Text: "ls", Description: "список папок"},
+			{Text: "mv", Description: "[store name folder] переместить в папку"},
+			{Text: "rm", Description: "[folder] удалить папку"},
+		}
+	case len(words) == 3 && (words[1] == "add" || words[1] == "rm" || words[1] == "mv"):
+		s = []prompt.Suggest{
+			{Text: "password"}, {Text: "note"}, {Text: "card"}, {Text: "file"}, {Text: "item"}, {Text: "otp"},
+			{Text: "ssh"},
+		}
+	case len(words) == 3 && words[0] == "folder" && words[1] == "rm",
+		len(words) == 5 && words[0] == "folder" && words[1] == "mv":
+		folders, _ := gkeeperClient.FolderList()
+		for _, f := range folders {
+			s = append(s, prompt.Suggest{Text: f})
+		}
+	case len(words) == 5 && words[0] == "tag":
+		tags, _ := gkeeperClient.TagList()
+		for _, t := range tags {
+			s = append(s, prompt.Suggest{Text: t})
+		}
+	}
+	return
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/command"
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/otp"
+)
+
+// newOtpsCmd - обработчики команд работы с одноразовыми паролями
+func newOtpsCmd(args []string) *command.Command {
+	var (
+		subcmd  string
+		subargs []string
+	)
+	if len(args) > 0 {
+		subcmd = args[0]
+		subargs = args[1:]
+	}
+
+	switch subcmd {
+	case "", "ls", "list":
+		return newLsCmd(subargs, gkeeperClient.OtpList, false, "нет сохраненных одноразовых паролей")
+
+	case "new":
+		f := newOtpFlags("new")
+		if err := f.fs.Parse(subargs); err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		return command.New(func(fields map[string]string) error {
+			in := pb.OtpWriteRequest{
+				Name:      fields["name"],
+				ExpiresAt: f.ttl.expiresAt(),
+			}
+			setOtpSecret(&in, fields["secret or otpauth uri"])
+			if err := f.apply(&in); err != nil {
+				return err
+			}
+			return gkeeperClient.OtpWrite(&in)
+		}, f.fs.Args(), "name", "secret or otpauth uri")
+
+	case "get":
+		fs := flag.NewFlagSet("get", flag.ContinueOnError)
+		reveal := fs.Bool("reveal", false, "показать секрет и параметры генерации")
+		if err := fs.Parse(subargs); err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		return command.New(func(fields map[string]string) error {
+			resp, err := gkeeperClient.OtpRead(&pb.OtpReadRequest{Name: fields["name"]})
+			if err != nil {
+				return err
+			}
+			fmt.Println("name:", resp.Name)
+			if resp.Issuer != "" {
+				fmt.Println("issuer:", resp.Issuer)
+			}
+			if resp.Account != "" {
+				fmt.Println("account:", resp.Account)
+			}
+			if *reveal {
+				fmt.Println("secret:", resp.Secret)
+				fmt.Println("type:", otpTypeName(resp.Type))
+				fmt.Println("algorithm:", otpAlgorithmName(resp.Algorithm))
+				fmt.Println("digits:", resp.Digits)
+				if resp.Type == pb.OtpType_OTP_TYPE_HOTP {
+					fmt.Println("counter:", resp.Counter)
+				} else {
+					fmt.Println("period:", time.Duration(resp.Period)*time.Second)
+				}
+			}
+			printExpiry(resp.ExpiresAt)
+
+			code, err := gkeeperClient.OtpCode(&pb.OtpCodeRequest{Name: resp.Name})
+			if err != nil {
+				return err
+			}
+			if resp.Type == pb.OtpType_OTP_TYPE_HOTP {
+				fmt.Printf("code: %s (counter %d)\n", code.Code, code.Counter)
+			} else {
+				fmt.Printf("code: %s (%s)\n", code.Code, time.Duration(code.Remaining)*time.Second)
+			}
+			return nil
+		}, fs.Args(), "name")
+
+	case "upd":
+		f := newOtpFlags("upd")
+		if err := f.fs.Parse(subargs); err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		return command.New(func(fields map[string]string) error {
+			cur, err := gkeeperClient.OtpRead(&pb.OtpReadRequest{Name: fields["name"]})
+			if err != nil {
+				return err
+			}
+
+			in := pb.OtpWriteRequest{
+				Name:      valueOr(fields["new name"], cur.Name),
+				Issuer:    cur.Issuer,
+				Account:   cur.Account,
+				Secret:    cur.Secret,
+				Type:      cur.Type,
+				Algorithm: cur.Algorithm,
+				Digits:    cur.Digits,
+				Period:    cur.Period,
+				Counter:   cur.Counter,
+			}
+			if s := fields["new secret or otpauth uri"]; s != "" {
+				setOtpSecret(&in, s)
+			}
+			if err = f.apply(&in); err != nil {
+				return err
+			}
+
+			in.ExpiresAt, err = f.ttl.update(func() (*timestamppb.Timestamp, error) {
+				return cur.ExpiresAt, nil
+			})
+			if err != nil {
+				return err
+			}
+			return gkeeperClient.OtpUpdate(fields["name"], &in)
+		}, f.fs.Args(), "name", "new name", "new secret or otpauth uri")
+
+	case "mv":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.OtpRename(fields["name"], fields["new name"])
+		}, subargs, "name", "new name")
+
+	case "del":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.OtpDelete(&pb.OtpDelRequest{Name: fields["name"]})
+		}, subargs, "name")
+
+	default:
+		return command.New(func(map[string]string) error {
+			return fmt.Errorf("неизвестная команда: %s", strings.Join(args, " "))
+		}, nil)
+	}
+}
+
+// otpFlags параметры генерации паролей в флагах команд new и upd,
+// в запрос переносятся только указанные флаги
+type otpFlags struct {
+	fs        *flag.FlagSet
+	ttl       *ttlFlag
+	issuer    *string
+	account   *string
+	hotp      *bool
+	algorithm *string
+	digits    *uint
+	period    *uint
+	counter   *uint64
+}
+
+func newOtpFlags(name string) *otpFlags {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	return &otpFlags{
+		fs:        fs,
+		ttl:       newTTLFlag(fs),
+		issuer:    fs.String("issuer", "", "издатель, например GitHub"),
+		account:   fs.String("account", "", "учётная запись"),
+		hotp:      fs.Bool("hotp", false, "пароли по счётчику (HOTP) вместо времени"),
+		algorithm: fs.String("alg", "SHA1", "алгоритм HMAC: SHA1, SHA256, SHA512"),
+		digits:    fs.Uint("digits", 6, "количество цифр пароля"),
+		period:    fs.Uint("period", 30, "шаг TOTP в секундах"),
+		counter:   fs.Uint64("counter", 0, "начальное значение счётчика HOTP"),
+	}
+}
+
+// apply перенос указанных флагов в запрос
+func (f *otpFlags) apply(in *pb.OtpWriteRequest) (err error) {
+	f.fs.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "issuer":
+			in.Issuer = *f.issuer
+		case "account":
+			in.Account = *f.account
+		case "hotp":
+			in.Type = pb.OtpType_OTP_TYPE_TOTP
+			if *f.hotp {
+				in.Type = pb.OtpType_OTP_TYPE_HOTP
+			}
+		case "alg":
+			v, ok := pb.OtpAlgorithm_value["OTP_ALGORITHM_"+strings.ToUpper(*f.algorithm)]
+			if !ok {
+				err = fmt.Errorf("неизвестный алгоритм: %s", *f.algorithm)
+			}
+			in.Algorithm = pb.OtpAlgorithm(v)
+		case "digits":
+			in.Digits = uint32(*f.digits)
+		case "period":
+			in.Period = uint32(*f.period)
+		case "counter":
+			in.Counter = *f.counter
+		}
+	})
+	return
+}
+
+// setOtpSecret секрет в base32 или ссылка otpauth://, ссылка заменяет
+// параметры генерации вместе с издателем и учётной записью
+func setOtpSecret(in *pb.OtpWriteRequest, s string) {
+	if strings.HasPrefix(s, "otpauth://") {
+		in.Uri, in.Secret = s, ""
+		in.Issuer, in.Account = "", ""
+		return
+	}
+	in.Secret, in.Uri = s, ""
+}
+
+// otpCode пароль и время до его смены для TOTP, для HOTP - пароль
+// значения счётчика
+func otpCode(p otp.Params, t time.Time) string {
+	if p.Type == otp.TypeHOTP {
+		return fmt.Sprintf("%s (counter %d)", p.HOTP(p.Counter), p.Counter)
+	}
+	code, left := p.TOTP(t)
+	return fmt.Sprintf("%s (%s)", code, left)
+}
+
+func otpTypeName(t pb.OtpType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "OTP_TYPE_"))
+}
+
+func otpAlgorithmName(a pb.OtpAlgorithm) string {
+	return strings.TrimPrefix(a.String(), "OTP_ALGORITHM_")
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/command"
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+)
+
+// storeName имя хранилища в клиенте по виду данных на сервере
+func storeName(kind string) string {
+	if kind == "binary" {
+		return "file"
+	}
+	return kind
+}
+
+// newFindCmd поиск по всем хранилищам.
+// Аргументы: [-k store[,store]] [-n count] query
+func newFindCmd(args []string) *command.Command {
+	fs := flag.NewFlagSet("find", flag.ContinueOnError)
+	stores := fs.String("k", "", "хранилища через запятую, пусто - все")
+	count := fs.Int("n", 0, "количество строк, 0 - по умолчанию")
+
+	if err := fs.Parse(args); err != nil {
+		return command.New(func(map[string]string) error { return err }, nil)
+	}
+
+	var query []string
+	if q := strings.Join(fs.Args(), " "); q != "" {
+		query = []string{q}
+	}
+
+	return command.New(func(fields map[string]string) error {
+		req := pb.SearchRequest{
+			Query: fields["query"],
+		}
+		if *stores != "" {
+			for _, s := range strings.Split(*stores, ",") {
+				req.Kinds = append(req.Kinds, itemKind(s))
+			}
+		}
+		if *count > 0 && *count <= 100 {
+			req.Limit = int32(*count)
+		}
+
+		results, err := gkeeperClient.Search(&req)
+		if err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			fmt.Println("ничего не найдено")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "KIND\tNAME\tSCORE\tMATCHED")
+		for _, r := range results {
+			fmt.Fprintf(w, "%s\t%s\t%.2f\t%s\n", storeName(r.Kind), r.Name, r.Score, r.Matched)
+		}
+		return w.Flush()
+	}, query, "query")
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/c-bata/go-prompt"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/command"
+	"github.com/eugene982/yp-gophkeeper/cmd/grpcclient/sshagent"
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/sshkey"
+)
+
+// остановка агента SSH, запущенного командой ssh-agent
+var stopSSHAgent context.CancelFunc
+
+// newSshKeysCmd - обработчики команд работы с ключами SSH
+func newSshKeysCmd(args []string) *command.Command {
+	var (
+		subcmd  string
+		subargs []string
+	)
+	if len(args) > 0 {
+		subcmd = args[0]
+		subargs = args[1:]
+	}
+
+	switch subcmd {
+	case "", "ls", "list":
+		return newLsCmd(subargs, gkeeperClient.SshKeyList, false, "нет сохраненных ключей SSH")
+
+	case "new":
+		fs := flag.NewFlagSet("new", flag.ContinueOnError)
+		ttl := newTTLFlag(fs)
+		file := fs.String("file", "", "импорт закрытого ключа из файла")
+		typ := fs.String("type", sshkey.TypeEd25519, "вид создаваемого ключа: ed25519, rsa, ecdsa")
+		bits := fs.Uint("bits", 0, "длина создаваемого ключа, 0 - по умолчанию")
+		comment := fs.String("comment", "", "комментарий, например user@host")
+		if err := fs.Parse(subargs); err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		return command.New(func(fields map[string]string) error {
+			in := pb.SshKeyWriteRequest{
+				Name:      fields["name"],
+				Comment:   *comment,
+				ExpiresAt: ttl.expiresAt(),
+			}
+			if *file != "" {
+				if err := importSshKey(&in, *file); err != nil {
+					return err
+				}
+			} else {
+				v, ok := pb.SshKeyType_value["SSH_KEY_TYPE_"+strings.ToUpper(*typ)]
+				if !ok {
+					return fmt.Errorf("неизвестный вид ключа: %s", *typ)
+				}
+				in.Generate, in.Type, in.Bits = true, pb.SshKeyType(v), uint32(*bits)
+			}
+
+			if err := gkeeperClient.SshKeyWrite(&in); err != nil {
+				return err
+			}
+			resp, err := gkeeperClient.SshKeyRead(&pb.SshKeyReadRequest{Name: in.Name})
+			if err == nil {
+				fmt.Println(resp.PublicKey)
+			}
+			return err
+		}, fs.Args(), "name")
+
+	case "get":
+		fs := flag.NewFlagSet("get", flag.ContinueOnError)
+		reveal := fs.Bool("reveal", false, "показать закрытый ключ")
+		if err := fs.Parse(subargs); err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		return command.New(func(fields map[string]string) error {
+			resp, err := gkeeperClient.SshKeyRead(&pb.SshKeyReadRequest{Name: fields["name"]})
+			if err == nil {
+				fmt.Println("name:", resp.Name)
+				fmt.Println("type:", sshKeyTypeName(resp.Type), resp.Bits)
+				fmt.Println("fingerprint:", resp.Fingerprint)
+				if resp.Comment != "" {
+					fmt.Println("comment:", resp.Comment)
+				}
+				fmt.Println("public key:", resp.PublicKey)
+				if *reveal {
+					fmt.Print(resp.PrivateKey)
+				}
+				printExpiry(resp.ExpiresAt)
+			}
+			return err
+		}, fs.Args(), "name")
+
+	case "upd":
+		ttl, subargs, err := parseTTL("upd", subargs)
+		if err != nil {
+			return command.New(func(map[string]string) error { return err }, nil)
+		}
+		return command.New(func(fields map[string]string) error {
+			cur, err := gkeeperClient.SshKeyRead(&pb.SshKeyReadRequest{Name: fields["name"]})
+			if err != nil {
+				return err
+			}
+
+			in := pb.SshKeyWriteRequest{
+				Name:       valueOr(fields["new name"], cur.Name),
+				PrivateKey: cur.PrivateKey,
+				Comment:    valueOr(fields["new comment"], cur.Comment),
+			}
+			in.ExpiresAt, err = ttl.update(func() (*timestamppb.Timestamp, error) {
+				return cur.ExpiresAt, nil
+			})
+			if err != nil {
+				return err
+			}
+			return gkeeperClient.SshKeyUpdate(fields["name"], &in)
+		}, subargs, "name", "new name", "new comment")
+
+	case "mv":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.SshKeyRename(fields["name"], fields["new name"])
+		}, subargs, "name", "new name")
+
+	case "del":
+		return command.New(func(fields map[string]string) error {
+			return gkeeperClient.SshKeyDelete(&pb.SshKeyDelRequest{Name: fields["name"]})
+		}, subargs, "name")
+
+	default:
+		return command.New(func(map[string]string) error {
+			return fmt.Errorf("неизвестная команда: %s", strings.Join(args, " "))
+		}, nil)
+	}
+}
+
+// importSshKey закрытый ключ из файла. Для зашифрованного ключа
+// запрашивается фраза-пароль, без комментария берётся комментарий
+// открытого ключа из файла .pub рядом.
+func importSshKey(in *pb.SshKeyWriteRequest, filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	in.PrivateKey = string(data)
+
+	if _, err = sshkey.Parse(data, nil); errors.Is(err, sshkey.ErrPassphrase) {
+		in.Passphrase = prompt.Input("passphrase: ", noComplete)
+	}
+	if in.Comment == "" {
+		if pub, err := os.ReadFile(filename + ".pub"); err == nil {
+			if _, comment, _, _, err := ssh.ParseAuthorizedKey(pub); err == nil {
+				in.Comment = comment
+			}
+		}
+	}
+	return nil
+}
+
+func sshKeyTypeName(t pb.SshKeyType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "SSH_KEY_TYPE_"))
+}
+
+// newSSHAgentCmd - включение и выключение агента SSH с ключами хранилища
+func newSSHAgentCmd(args []string) *command.Command {
+	fs := flag.NewFlagSet("ssh-agent", flag.ContinueOnError)
+	socket := fs.String("s", "", "путь сокета агента, по умолчанию во временном каталоге")
+	if err := fs.Parse(args); err != nil {
+		return command.New(func(map[string]string) error { return err }, nil)
+	}
+	return command.New(func(map[string]string) error {
+		if stopSSHAgent != nil {
+			stopSSHAgent()
+			stopSSHAgent = nil
+			fmt.Println("агент SSH остановлен")
+			return nil
+		}
+
+		l, cleanup, err := listenSSHAgent(*socket)
+		if err != nil {
+			return err
+		}
+		var ctx context.Context
+		ctx, stopSSHAgent = context.WithCancel(context.Background())
+		go func() {
+			defer cleanup()
+			if err := sshagent.New(vaultKeys{}).Serve(ctx, l); err != nil {
+				fmt.Fprintln(os.Stderr, "ssh-agent:", err)
+			}
+		}()
+		printAuthSock(l)
+		return nil
+	}, fs.Args())
+}
+
+// runSSHAgent режим агента SSH: вход пользователя и обслуживание
+// сокета агента до прерывания
+func runSSHAgent(args []string) error {
+	fs := flag.NewFlagSet("ssh-agent", flag.ContinueOnError)
+	socket := fs.String("s", "", "путь сокета агента, по умолчанию во временном каталоге")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	login := prompt.Input("login: ", noComplete)
+	passwd := prompt.Input("password: ", noComplete)
+	if err := gkeeperClient.Login(login, passwd); err != nil {
+		return err
+	}
+	if gkeeperClient.Offline() {
+		fmt.Println("сервер недоступен, ключи из локального кэша")
+	}
+
+	l, cleanup, err := listenSSHAgent(*socket)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	printAuthSock(l)
+	fmt.Println("агент SSH запущен, Ctrl+C - остановить")
+	return sshagent.New(vaultKeys{}).Serve(ctx, l)
+}
+
+// listenSSHAgent сокет агента. Без пути сокет создаётся во временном
+// каталоге, доступном только владельцу, cleanup удаляет каталог.
+func listenSSHAgent(path string) (l net.Listener, cleanup func(), err error) {
+	cleanup = func() {}
+	if path == "" {
+		dir, err := os.MkdirTemp("", "gophkeeper-agent-")
+		if err != nil {
+			return nil, nil, err
+		}
+		path = filepath.Join(dir, "agent.sock")
+		cleanup = func() { os.RemoveAll(dir) }
+	}
+
+	if l, err = sshagent.Listen(path); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return l, cleanup, nil
+}
+
+// printAuthSock вывод переменной окружения для клиентов ssh
+func printAuthSock(l net.Listener) {
+	fmt.Printf("SSH_AUTH_SOCK=%s; export SSH_AUTH_SOCK;\n", l.Addr())
+}
+
+// vaultKeys ключи SSH текущего пользователя для агента
+type vaultKeys struct{}
+
+var _ sshagent.Vault = vaultKeys{}
+
+// Keys все ключи хранилища, ключи, которые не удалось прочитать,
+// пропускаются
+func (vaultKeys) Keys() ([]sshkey.Key, error) {
+	var keys []sshkey.Key
+	req := pb.ListRequest{Limit: 1000}
+	for {
+		entries, next, err := gkeeperClient.SshKeyList(&req)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			resp, err := gkeeperClient.SshKeyRead(&pb.SshKeyReadRequest{Name: e.Name})
+			if err != nil {
+				logger.Errorf("read ssh key error: %w", err, "name", e.Name)
+				continue
+			}
+			k, err := sshkey.Parse([]byte(resp.PrivateKey), nil)
+			if err != nil {
+				logger.Errorf("parse ssh key error: %w", err, "name", e.Name)
+				continue
+			}
+			k.Comment = valueOr(resp.Comment, resp.Name)
+			keys = append(keys, k)
+		}
+		if next == "" {
+			return keys, nil
+		}
+		req.Cursor = next
+	}
+}
@@ -0,0 +1,256 @@
+// Package sshagent агент SSH, выдающий ключи хранилища по протоколу
+// ssh-agent: закрытые ключи находятся только в памяти процесса и не
+// записываются на диск. Ключи управляются хранилищем, поэтому добавление
+// и удаление ключей через агент не поддерживается.
+package sshagent
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/sshkey"
+)
+
+var (
+	// ErrReadOnly ключи агента управляются хранилищем
+	ErrReadOnly = errors.New("agent keys are managed by the vault")
+	// ErrLocked агент заблокирован
+	ErrLocked = errors.New("agent is locked")
+	// ErrKeyNotFound ключа нет в хранилище
+	ErrKeyNotFound = errors.New("key not found")
+)
+
+// Vault ключи SSH хранилища
+type Vault interface {
+	Keys() ([]sshkey.Key, error)
+}
+
+// Agent агент SSH с ключами хранилища
+type Agent struct {
+	vault Vault
+
+	mu         sync.Mutex
+	keys       []sshkey.Key // ключи последнего списка
+	locked     bool
+	passphrase []byte
+}
+
+var _ agent.ExtendedAgent = (*Agent)(nil)
+
+// New агент с ключами хранилища v
+func New(v Vault) *Agent {
+	return &Agent{vault: v}
+}
+
+// List ключи хранилища, список читается при каждом запросе,
+// поэтому новые ключи доступны без перезапуска агента
+func (a *Agent) List() ([]*agent.Key, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.locked {
+		return nil, nil
+	}
+
+	keys, err := a.reload()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*agent.Key, 0, len(keys))
+	for _, k := range keys {
+		res = append(res, &agent.Key{
+			Format:  k.Public.Type(),
+			Blob:    k.Public.Marshal(),
+			Comment: k.Comment,
+		})
+	}
+	return res, nil
+}
+
+// Sign подпись данных ключом хранилища
+func (a *Agent) Sign(key ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	return a.SignWithFlags(key, data, 0)
+}
+
+// SignWithFlags подпись данных, для RSA флаги выбирают алгоритм
+// rsa-sha2-256 или rsa-sha2-512
+func (a *Agent) SignWithFlags(key ssh.PublicKey, data []byte, flags agent.SignatureFlags) (*ssh.Signature, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.locked {
+		return nil, ErrLocked
+	}
+
+	k, err := a.find(key)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := ssh.NewSignerFromSigner(k.Private)
+	if err != nil {
+		return nil, err
+	}
+
+	var algorithm string
+	switch {
+	case flags&agent.SignatureFlagRsaSha256 != 0:
+		algorithm = ssh.KeyAlgoRSASHA256
+	case flags&agent.SignatureFlagRsaSha512 != 0:
+		algorithm = ssh.KeyAlgoRSASHA512
+	default:
+		return signer.Sign(rand.Reader, data)
+	}
+	as, ok := signer.(ssh.AlgorithmSigner)
+	if !ok {
+		return nil, errors.New("signature algorithm not supported")
+	}
+	return as.SignWithAlgorithm(rand.Reader, data, algorithm)
+}
+
+// Signers подписанты всех ключей хранилища
+func (a *Agent) Signers() ([]ssh.Signer, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.locked {
+		return nil, ErrLocked
+	}
+
+	keys, err := a.reload()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]ssh.Signer, 0, len(keys))
+	for _, k := range keys {
+		signer, err := ssh.NewSignerFromSigner(k.Private)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, signer)
+	}
+	return res, nil
+}
+
+// Lock блокировка агента фразой-паролем
+func (a *Agent) Lock(passphrase []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.locked {
+		return ErrLocked
+	}
+	a.locked, a.passphrase = true, passphrase
+	a.keys = nil
+	return nil
+}
+
+// Unlock разблокировка агента
+func (a *Agent) Unlock(passphrase []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.locked {
+		return errors.New("agent is not locked")
+	}
+	if subtle.ConstantTimeCompare(passphrase, a.passphrase) != 1 {
+		return errors.New("incorrect passphrase")
+	}
+	a.locked, a.passphrase = false, nil
+	return nil
+}
+
+func (a *Agent) Add(agent.AddedKey) error {
+	return ErrReadOnly
+}
+
+func (a *Agent) Remove(ssh.PublicKey) error {
+	return ErrReadOnly
+}
+
+func (a *Agent) RemoveAll() error {
+	return ErrReadOnly
+}
+
+func (a *Agent) Extension(string, []byte) ([]byte, error) {
+	return nil, agent.ErrExtensionUnsupported
+}
+
+// reload чтение ключей хранилища
+func (a *Agent) reload() ([]sshkey.Key, error) {
+	keys, err := a.vault.Keys()
+	if err != nil {
+		return nil, err
+	}
+	a.keys = keys
+	return keys, nil
+}
+
+// find ключ по открытому ключу: сначала среди ключей последнего
+// списка, затем в хранилище
+func (a *Agent) find(key ssh.PublicKey) (sshkey.Key, error) {
+	if k, ok := lookup(a.keys, key); ok {
+		return k, nil
+	}
+	keys, err := a.reload()
+	if err != nil {
+		return sshkey.Key{}, err
+	}
+	if k, ok := lookup(keys, key); ok {
+		return k, nil
+	}
+	return sshkey.Key{}, ErrKeyNotFound
+}
+
+func lookup(keys []sshkey.Key, key ssh.PublicKey) (sshkey.Key, bool) {
+	blob := key.Marshal()
+	for _, k := range keys {
+		if bytes.Equal(k.Public.Marshal(), blob) {
+			return k, true
+		}
+	}
+	return sshkey.Key{}, false
+}
+
+// Listen сокет агента, доступный только владельцу
+func Listen(path string) (net.Listener, error) {
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.Chmod(path, 0o600); err != nil {
+		l.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// Serve обслуживание подключений к сокету до отмены контекста,
+// затем сокет закрывается
+func (a *Agent) Serve(ctx context.Context, l net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			if err := agent.ServeAgent(a, conn); err != nil && !errors.Is(err, io.EOF) {
+				logger.Errorf("ssh agent error: %w", err)
+			}
+		}()
+	}
+}
@@ -0,0 +1,135 @@
+package sshagent
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+
+	"github.com/eugene982/yp-gophkeeper/internal/sshkey"
+)
+
+// fakeVault ключи хранилища в памяти
+type fakeVault struct {
+	mu    sync.Mutex
+	keys  []sshkey.Key
+	calls int
+}
+
+func (v *fakeVault) Keys() ([]sshkey.Key, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.calls++
+	return append([]sshkey.Key(nil), v.keys...), nil
+}
+
+func (v *fakeVault) add(k sshkey.Key) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keys = append(v.keys, k)
+}
+
+func generate(t *testing.T, typ string, bits int, comment string) sshkey.Key {
+	t.Helper()
+	k, err := sshkey.Generate(typ, bits, comment)
+	require.NoError(t, err)
+	return k
+}
+
+// serve агент на сокете во временном каталоге и клиент к нему
+func serve(t *testing.T, v Vault) agent.ExtendedAgent {
+	t.Helper()
+	// путь сокета ограничен по длине, t.TempDir может быть слишком длинным
+	dir, err := os.MkdirTemp("", "gk-agent-")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "agent.sock")
+	l, err := Listen(path)
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- New(v).Serve(ctx, l) }()
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return agent.NewClient(conn)
+}
+
+func TestAgentSign(t *testing.T) {
+	vault := &fakeVault{keys: []sshkey.Key{
+		generate(t, sshkey.TypeEd25519, 0, "ed@host"),
+		generate(t, sshkey.TypeECDSA, 384, "ecdsa@host"),
+	}}
+	client := serve(t, vault)
+
+	keys, err := client.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, ssh.KeyAlgoED25519, keys[0].Format)
+	assert.Equal(t, "ed@host", keys[0].Comment)
+	assert.Equal(t, ssh.KeyAlgoECDSA384, keys[1].Format)
+
+	data := []byte("session data")
+	for _, key := range keys {
+		sig, err := client.Sign(key, data)
+		require.NoError(t, err)
+		assert.NoError(t, key.Verify(data, sig))
+	}
+
+	// ключ, добавленный в хранилище после списка, находится при подписи
+	rsaKey := generate(t, sshkey.TypeRSA, sshkey.MinRSABits, "rsa@host")
+	vault.add(rsaKey)
+	sig, err := client.SignWithFlags(rsaKey.Public, data, agent.SignatureFlagRsaSha256)
+	require.NoError(t, err)
+	assert.Equal(t, ssh.KeyAlgoRSASHA256, sig.Format)
+	assert.NoError(t, rsaKey.Public.Verify(data, sig))
+
+	other := generate(t, sshkey.TypeEd25519, 0, "")
+	_, err = client.Sign(other.Public, data)
+	assert.Error(t, err)
+}
+
+func TestAgentReadOnly(t *testing.T) {
+	vault := &fakeVault{}
+	client := serve(t, vault)
+
+	k := generate(t, sshkey.TypeEd25519, 0, "")
+	assert.Error(t, client.Add(agent.AddedKey{PrivateKey: k.Private}))
+	assert.Error(t, client.RemoveAll())
+	assert.Empty(t, vault.keys)
+}
+
+func TestAgentLock(t *testing.T) {
+	k := generate(t, sshkey.TypeEd25519, 0, "")
+	client := serve(t, &fakeVault{keys: []sshkey.Key{k}})
+
+	require.NoError(t, client.Lock([]byte("secret")))
+	keys, err := client.List()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+	_, err = client.Sign(k.Public, []byte("data"))
+	assert.Error(t, err)
+
+	assert.Error(t, client.Unlock([]byte("wrong")))
+	require.NoError(t, client.Unlock([]byte("secret")))
+	keys, err = client.List()
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+}
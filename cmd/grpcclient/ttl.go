@@ -0,0 +1,75 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ttlFlag флаг --ttl срока хранения элемента
+type ttlFlag struct {
+	ttl time.Duration
+	set bool // флаг указан в команде
+}
+
+// newTTLFlag регистрация флага --ttl в наборе fs
+func newTTLFlag(fs *flag.FlagSet) *ttlFlag {
+	f := new(ttlFlag)
+	fs.Var(f, "ttl", "срок хранения, например 24h; 0 - бессрочно")
+	return f
+}
+
+// String реализация flag.Value
+func (f *ttlFlag) String() string {
+	return f.ttl.String()
+}
+
+// Set реализация flag.Value
+func (f *ttlFlag) Set(s string) error {
+	ttl, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	if ttl < 0 {
+		return errors.New("срок хранения не может быть отрицательным")
+	}
+	f.ttl, f.set = ttl, true
+	return nil
+}
+
+// expiresAt время удаления, отсчитанное от текущего, nil - бессрочно
+func (f *ttlFlag) expiresAt() *timestamppb.Timestamp {
+	if f.ttl == 0 {
+		return nil
+	}
+	return timestamppb.New(time.Now().Add(f.ttl))
+}
+
+// update время удаления обновляемого элемента: без флага сохраняется
+// прежнее, которое возвращает current
+func (f *ttlFlag) update(current func() (*timestamppb.Timestamp, error)) (*timestamppb.Timestamp, error) {
+	if f.set {
+		return f.expiresAt(), nil
+	}
+	return current()
+}
+
+// parseTTL разбор флага --ttl перед аргументами команды
+func parseTTL(name string, args []string) (*ttlFlag, []string, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	ttl := newTTLFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+	return ttl, fs.Args(), nil
+}
+
+// printExpiry вывод времени удаления элемента, если оно задано
+func printExpiry(expiresAt *timestamppb.Timestamp) {
+	if expiresAt != nil {
+		fmt.Println("expires:", formatTime(expiresAt))
+	}
+}
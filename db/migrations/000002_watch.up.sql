@@ -0,0 +1,2 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS revision BIGINT NOT NULL DEFAULT(0);
@@ -0,0 +1,19 @@
+ALTER TABLE users
+    ALTER COLUMN create_at TYPE TIMESTAMP,
+    ALTER COLUMN update_at TYPE TIMESTAMP;
+
+ALTER TABLE passwords
+    ALTER COLUMN create_at TYPE TIMESTAMP,
+    ALTER COLUMN update_at TYPE TIMESTAMP;
+
+ALTER TABLE notes
+    ALTER COLUMN create_at TYPE TIMESTAMP,
+    ALTER COLUMN update_at TYPE TIMESTAMP;
+
+ALTER TABLE cards
+    ALTER COLUMN create_at TYPE TIMESTAMP,
+    ALTER COLUMN update_at TYPE TIMESTAMP;
+
+ALTER TABLE binaries
+    ALTER COLUMN create_at TYPE TIMESTAMP,
+    ALTER COLUMN update_at TYPE TIMESTAMP;
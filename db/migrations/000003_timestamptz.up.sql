@@ -0,0 +1,19 @@
+ALTER TABLE users
+    ALTER COLUMN create_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN update_at TYPE TIMESTAMPTZ;
+
+ALTER TABLE passwords
+    ALTER COLUMN create_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN update_at TYPE TIMESTAMPTZ;
+
+ALTER TABLE notes
+    ALTER COLUMN create_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN update_at TYPE TIMESTAMPTZ;
+
+ALTER TABLE cards
+    ALTER COLUMN create_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN update_at TYPE TIMESTAMPTZ;
+
+ALTER TABLE binaries
+    ALTER COLUMN create_at TYPE TIMESTAMPTZ,
+    ALTER COLUMN update_at TYPE TIMESTAMPTZ;
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS item_tags;
+DROP TABLE IF EXISTS tags;
+
+ALTER TABLE passwords DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE cards DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE notes DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE binaries DROP COLUMN IF EXISTS folder_id;
+
+DROP TABLE IF EXISTS folders;
@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS folders (
+    id        SERIAL       PRIMARY KEY,
+    user_id   VARCHAR(64)  NOT NULL,
+    path      VARCHAR(256) NOT NULL,
+    create_at TIMESTAMPTZ  NOT NULL DEFAULT(now())
+);
+CREATE UNIQUE INDEX IF NOT EXISTS folders_user_id_path_idx
+ON folders (user_id, path);
+
+ALTER TABLE passwords
+    ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders (id) ON DELETE SET NULL;
+ALTER TABLE cards
+    ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders (id) ON DELETE SET NULL;
+ALTER TABLE notes
+    ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders (id) ON DELETE SET NULL;
+ALTER TABLE binaries
+    ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders (id) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS tags (
+    id      SERIAL      PRIMARY KEY,
+    user_id VARCHAR(64) NOT NULL,
+    name    VARCHAR(64) NOT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS tags_user_id_name_idx
+ON tags (user_id, name);
+
+CREATE TABLE IF NOT EXISTS item_tags (
+    tag_id      INTEGER NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    password_id INTEGER REFERENCES passwords (id) ON DELETE CASCADE,
+    card_id     INTEGER REFERENCES cards (id) ON DELETE CASCADE,
+    note_id     INTEGER REFERENCES notes (id) ON DELETE CASCADE,
+    binary_id   INTEGER REFERENCES binaries (id) ON DELETE CASCADE,
+    CHECK (num_nonnulls(password_id, card_id, note_id, binary_id) = 1)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS item_tags_password_idx
+ON item_tags (password_id, tag_id) WHERE password_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS item_tags_card_idx
+ON item_tags (card_id, tag_id) WHERE card_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS item_tags_note_idx
+ON item_tags (note_id, tag_id) WHERE note_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS item_tags_binary_idx
+ON item_tags (binary_id, tag_id) WHERE binary_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS item_tags_tag_id_idx
+ON item_tags (tag_id);
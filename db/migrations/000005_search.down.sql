@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS tags_name_trgm_idx;
+DROP INDEX IF EXISTS binaries_name_trgm_idx;
+DROP INDEX IF EXISTS notes_name_trgm_idx;
+DROP INDEX IF EXISTS cards_name_trgm_idx;
+DROP INDEX IF EXISTS passwords_search_username_trgm_idx;
+DROP INDEX IF EXISTS passwords_url_trgm_idx;
+DROP INDEX IF EXISTS passwords_name_trgm_idx;
+
+ALTER TABLE passwords
+    DROP COLUMN IF EXISTS search_username,
+    DROP COLUMN IF EXISTS url;
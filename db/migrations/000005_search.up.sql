@@ -0,0 +1,20 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE passwords
+    ADD COLUMN IF NOT EXISTS url             VARCHAR(512) NOT NULL DEFAULT(''),
+    ADD COLUMN IF NOT EXISTS search_username VARCHAR(128) NOT NULL DEFAULT('');
+
+CREATE INDEX IF NOT EXISTS passwords_name_trgm_idx
+ON passwords USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS passwords_url_trgm_idx
+ON passwords USING gin (url gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS passwords_search_username_trgm_idx
+ON passwords USING gin (search_username gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS cards_name_trgm_idx
+ON cards USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS notes_name_trgm_idx
+ON notes USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS binaries_name_trgm_idx
+ON binaries USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS tags_name_trgm_idx
+ON tags USING gin (name gin_trgm_ops);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS binaries_not_uploaded_idx;
+DROP INDEX IF EXISTS binaries_bin_id_idx;
+
+ALTER TABLE binaries
+    DROP COLUMN IF EXISTS uploaded;
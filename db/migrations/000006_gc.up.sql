@@ -0,0 +1,10 @@
+-- загруженные ранее файлы считаются завершёнными, новые - до окончания загрузки нет
+ALTER TABLE binaries
+    ADD COLUMN IF NOT EXISTS uploaded BOOLEAN NOT NULL DEFAULT(TRUE);
+ALTER TABLE binaries
+    ALTER COLUMN uploaded SET DEFAULT(FALSE);
+
+CREATE INDEX IF NOT EXISTS binaries_bin_id_idx
+ON binaries (bin_id);
+CREATE INDEX IF NOT EXISTS binaries_not_uploaded_idx
+ON binaries (update_at) WHERE NOT uploaded;
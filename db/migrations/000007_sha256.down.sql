@@ -0,0 +1,3 @@
+ALTER TABLE binaries
+    DROP COLUMN IF EXISTS hash_state,
+    DROP COLUMN IF EXISTS sha256;
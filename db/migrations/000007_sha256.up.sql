@@ -0,0 +1,4 @@
+-- дайджест содержимого и состояние хеша незавершённой загрузки
+ALTER TABLE binaries
+    ADD COLUMN IF NOT EXISTS sha256 BYTEA,
+    ADD COLUMN IF NOT EXISTS hash_state BYTEA;
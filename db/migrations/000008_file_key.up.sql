@@ -0,0 +1,4 @@
+-- ключ шифрования содержимого, зашифрованный ключом сервера;
+-- NULL - содержимое загружено до появления шифрования
+ALTER TABLE binaries
+    ADD COLUMN IF NOT EXISTS file_key BYTEA;
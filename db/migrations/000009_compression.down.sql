@@ -0,0 +1,4 @@
+ALTER TABLE binaries
+    DROP COLUMN IF EXISTS stored_size,
+    DROP COLUMN IF EXISTS committed,
+    DROP COLUMN IF EXISTS compression;
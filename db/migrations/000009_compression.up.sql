@@ -0,0 +1,16 @@
+-- сжатие содержимого, записанный объём открытых данных и хранимый объём
+ALTER TABLE binaries
+    ADD COLUMN IF NOT EXISTS compression TEXT NOT NULL DEFAULT(''),
+    ADD COLUMN IF NOT EXISTS committed BIGINT NOT NULL DEFAULT(0),
+    ADD COLUMN IF NOT EXISTS stored_size BIGINT NOT NULL DEFAULT(0);
+
+-- объёмы уже записанного содержимого по размеру большого объекта;
+-- зашифрованные блоки по 65536 байт хранятся с приростом 28 байт
+UPDATE binaries b
+SET stored_size = s.size,
+    committed = CASE WHEN b.file_key IS NULL THEN s.size
+        ELSE s.size - (s.size + 65563) / 65564 * 28 END
+FROM (SELECT id, lo_lseek64(lo_open(bin_id, 262144), 0, 2) AS size
+    FROM binaries
+    WHERE EXISTS (SELECT 1 FROM pg_largeobject_metadata m WHERE m.oid = bin_id)) s
+WHERE b.id = s.id;
@@ -0,0 +1,2 @@
+ALTER TABLE binaries
+    DROP COLUMN IF EXISTS content_type;
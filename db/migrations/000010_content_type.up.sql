@@ -0,0 +1,3 @@
+-- тип содержимого файла
+ALTER TABLE binaries
+    ADD COLUMN IF NOT EXISTS content_type TEXT NOT NULL DEFAULT('');
@@ -0,0 +1,4 @@
+ALTER TABLE binaries
+    DROP COLUMN IF EXISTS filename,
+    DROP COLUMN IF EXISTS mtime,
+    DROP COLUMN IF EXISTS mode;
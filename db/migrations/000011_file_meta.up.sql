@@ -0,0 +1,5 @@
+-- исходные атрибуты файла на стороне клиента
+ALTER TABLE binaries
+    ADD COLUMN IF NOT EXISTS filename TEXT NOT NULL DEFAULT(''),
+    ADD COLUMN IF NOT EXISTS mtime TIMESTAMP NULL,
+    ADD COLUMN IF NOT EXISTS mode INTEGER NOT NULL DEFAULT(0);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS binary_parts_oid_idx;
+DROP TABLE IF EXISTS binary_parts;
@@ -0,0 +1,13 @@
+-- части содержимого, загружаемого параллельными потоками,
+-- каждая в своём большом объекте до сборки
+CREATE TABLE IF NOT EXISTS binary_parts (
+    bin_id      OID         NOT NULL,
+    part_offset BIGINT      NOT NULL,
+    size        BIGINT      NOT NULL,
+    stored_size BIGINT      NOT NULL,
+    oid         OID         NOT NULL,
+    create_at   TIMESTAMPTZ NOT NULL DEFAULT(now()),
+    PRIMARY KEY (bin_id, part_offset)
+);
+CREATE INDEX IF NOT EXISTS binary_parts_oid_idx
+ON binary_parts (oid);
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS binaries_expires_at_idx;
+DROP INDEX IF EXISTS notes_expires_at_idx;
+DROP INDEX IF EXISTS cards_expires_at_idx;
+DROP INDEX IF EXISTS passwords_expires_at_idx;
+
+ALTER TABLE binaries
+    DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE notes
+    DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE cards
+    DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE passwords
+    DROP COLUMN IF EXISTS expires_at;
@@ -0,0 +1,18 @@
+-- время удаления элемента, NULL - бессрочно
+ALTER TABLE passwords
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+ALTER TABLE cards
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+ALTER TABLE notes
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+ALTER TABLE binaries
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS passwords_expires_at_idx
+ON passwords (expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS cards_expires_at_idx
+ON cards (expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS notes_expires_at_idx
+ON notes (expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS binaries_expires_at_idx
+ON binaries (expires_at) WHERE expires_at IS NOT NULL;
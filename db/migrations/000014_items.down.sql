@@ -0,0 +1,9 @@
+DELETE FROM item_tags WHERE item_id IS NOT NULL;
+ALTER TABLE item_tags
+    DROP CONSTRAINT IF EXISTS item_tags_check,
+    DROP COLUMN IF EXISTS item_id,
+    ADD CONSTRAINT item_tags_check
+        CHECK (num_nonnulls(password_id, card_id, note_id, binary_id) = 1);
+
+DROP TABLE IF EXISTS item_fields;
+DROP TABLE IF EXISTS items;
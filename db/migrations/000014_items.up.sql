@@ -0,0 +1,39 @@
+-- произвольные элементы: тип задаёт пользователь, поля хранятся по порядку
+CREATE TABLE IF NOT EXISTS items (
+    id         SERIAL       PRIMARY KEY,
+    user_id    VARCHAR(64)  NOT NULL,
+    name       VARCHAR(128) NOT NULL,
+    item_type  VARCHAR(64)  NOT NULL,
+    folder_id  INTEGER      REFERENCES folders (id) ON DELETE SET NULL,
+    expires_at TIMESTAMPTZ  NULL,
+    create_at  TIMESTAMPTZ  NOT NULL DEFAULT(now()),
+    update_at  TIMESTAMPTZ  NOT NULL DEFAULT(now())
+);
+CREATE INDEX IF NOT EXISTS items_user_id_idx
+ON items (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS items_user_id_name_idx
+ON items (user_id, name);
+CREATE INDEX IF NOT EXISTS items_expires_at_idx
+ON items (expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS items_name_trgm_idx
+ON items USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS items_item_type_trgm_idx
+ON items USING gin (item_type gin_trgm_ops);
+
+-- значение каждого поля шифруется отдельно
+CREATE TABLE IF NOT EXISTS item_fields (
+    item_id    INTEGER     NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    position   INTEGER     NOT NULL,
+    label      VARCHAR(64) NOT NULL,
+    field_type VARCHAR(16) NOT NULL,
+    value      BYTEA       NOT NULL,
+    PRIMARY KEY (item_id, position)
+);
+
+ALTER TABLE item_tags
+    ADD COLUMN IF NOT EXISTS item_id INTEGER REFERENCES items (id) ON DELETE CASCADE,
+    DROP CONSTRAINT IF EXISTS item_tags_check,
+    ADD CONSTRAINT item_tags_check
+        CHECK (num_nonnulls(password_id, card_id, note_id, binary_id, item_id) = 1);
+CREATE UNIQUE INDEX IF NOT EXISTS item_tags_item_idx
+ON item_tags (item_id, tag_id) WHERE item_id IS NOT NULL;
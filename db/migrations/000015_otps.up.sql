@@ -0,0 +1,36 @@
+-- секреты одноразовых паролей, счётчик HOTP увеличивается сервером
+CREATE TABLE IF NOT EXISTS otps (
+    id         SERIAL       PRIMARY KEY,
+    user_id    VARCHAR(64)  NOT NULL,
+    name       VARCHAR(128) NOT NULL,
+    issuer     VARCHAR(128) NOT NULL DEFAULT(''),
+    account    BYTEA        NOT NULL,
+    secret     BYTEA        NOT NULL,
+    otp_type   VARCHAR(4)   NOT NULL,
+    algorithm  VARCHAR(8)   NOT NULL,
+    digits     SMALLINT     NOT NULL,
+    period     INTEGER      NOT NULL,
+    counter    BIGINT       NOT NULL DEFAULT(0),
+    folder_id  INTEGER      REFERENCES folders (id) ON DELETE SET NULL,
+    expires_at TIMESTAMPTZ  NULL,
+    create_at  TIMESTAMPTZ  NOT NULL DEFAULT(now()),
+    update_at  TIMESTAMPTZ  NOT NULL DEFAULT(now())
+);
+CREATE INDEX IF NOT EXISTS otps_user_id_idx
+ON otps (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS otps_user_id_name_idx
+ON otps (user_id, name);
+CREATE INDEX IF NOT EXISTS otps_expires_at_idx
+ON otps (expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS otps_name_trgm_idx
+ON otps USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS otps_issuer_trgm_idx
+ON otps USING gin (issuer gin_trgm_ops);
+
+ALTER TABLE item_tags
+    ADD COLUMN IF NOT EXISTS otp_id INTEGER REFERENCES otps (id) ON DELETE CASCADE,
+    DROP CONSTRAINT IF EXISTS item_tags_check,
+    ADD CONSTRAINT item_tags_check
+        CHECK (num_nonnulls(password_id, card_id, note_id, binary_id, item_id, otp_id) = 1);
+CREATE UNIQUE INDEX IF NOT EXISTS item_tags_otp_idx
+ON item_tags (otp_id, tag_id) WHERE otp_id IS NOT NULL;
@@ -0,0 +1,8 @@
+DELETE FROM item_tags WHERE ssh_key_id IS NOT NULL;
+ALTER TABLE item_tags
+    DROP CONSTRAINT IF EXISTS item_tags_check,
+    DROP COLUMN IF EXISTS ssh_key_id,
+    ADD CONSTRAINT item_tags_check
+        CHECK (num_nonnulls(password_id, card_id, note_id, binary_id, item_id, otp_id) = 1);
+
+DROP TABLE IF EXISTS ssh_keys;
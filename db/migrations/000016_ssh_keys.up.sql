@@ -0,0 +1,34 @@
+-- ключи SSH, закрытый ключ зашифрован, открытый ключ и отпечаток хранятся открыто
+CREATE TABLE IF NOT EXISTS ssh_keys (
+    id          SERIAL       PRIMARY KEY,
+    user_id     VARCHAR(64)  NOT NULL,
+    name        VARCHAR(128) NOT NULL,
+    key_type    VARCHAR(8)   NOT NULL,
+    bits        INTEGER      NOT NULL,
+    public_key  TEXT         NOT NULL,
+    private_key BYTEA        NOT NULL,
+    comment     VARCHAR(256) NOT NULL DEFAULT(''),
+    fingerprint VARCHAR(64)  NOT NULL,
+    folder_id   INTEGER      REFERENCES folders (id) ON DELETE SET NULL,
+    expires_at  TIMESTAMPTZ  NULL,
+    create_at   TIMESTAMPTZ  NOT NULL DEFAULT(now()),
+    update_at   TIMESTAMPTZ  NOT NULL DEFAULT(now())
+);
+CREATE INDEX IF NOT EXISTS ssh_keys_user_id_idx
+ON ssh_keys (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS ssh_keys_user_id_name_idx
+ON ssh_keys (user_id, name);
+CREATE INDEX IF NOT EXISTS ssh_keys_expires_at_idx
+ON ssh_keys (expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS ssh_keys_name_trgm_idx
+ON ssh_keys USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS ssh_keys_comment_trgm_idx
+ON ssh_keys USING gin (comment gin_trgm_ops);
+
+ALTER TABLE item_tags
+    ADD COLUMN IF NOT EXISTS ssh_key_id INTEGER REFERENCES ssh_keys (id) ON DELETE CASCADE,
+    DROP CONSTRAINT IF EXISTS item_tags_check,
+    ADD CONSTRAINT item_tags_check
+        CHECK (num_nonnulls(password_id, card_id, note_id, binary_id, item_id, otp_id, ssh_key_id) = 1);
+CREATE UNIQUE INDEX IF NOT EXISTS item_tags_ssh_key_idx
+ON item_tags (ssh_key_id, tag_id) WHERE ssh_key_id IS NOT NULL;
@@ -9,6 +9,7 @@ package pb_v1
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,6 +23,262 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ListSort поле сортировки списка
+type ListSort int32
+
+const (
+	ListSort_LIST_SORT_NAME    ListSort = 0
+	ListSort_LIST_SORT_CREATED ListSort = 1
+	ListSort_LIST_SORT_UPDATED ListSort = 2
+)
+
+// Enum value maps for ListSort.
+var (
+	ListSort_name = map[int32]string{
+		0: "LIST_SORT_NAME",
+		1: "LIST_SORT_CREATED",
+		2: "LIST_SORT_UPDATED",
+	}
+	ListSort_value = map[string]int32{
+		"LIST_SORT_NAME":    0,
+		"LIST_SORT_CREATED": 1,
+		"LIST_SORT_UPDATED": 2,
+	}
+)
+
+func (x ListSort) Enum() *ListSort {
+	p := new(ListSort)
+	*p = x
+	return p
+}
+
+func (x ListSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_gophkeeper_proto_enumTypes[0].Descriptor()
+}
+
+func (ListSort) Type() protoreflect.EnumType {
+	return &file_proto_v1_gophkeeper_proto_enumTypes[0]
+}
+
+func (x ListSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListSort.Descriptor instead.
+func (ListSort) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{0}
+}
+
+// FieldType тип поля произвольного элемента
+type FieldType int32
+
+const (
+	FieldType_FIELD_TYPE_TEXT   FieldType = 0
+	FieldType_FIELD_TYPE_SECRET FieldType = 1 // скрываемое значение: пароль, ключ
+	FieldType_FIELD_TYPE_URL    FieldType = 2
+	FieldType_FIELD_TYPE_EMAIL  FieldType = 3
+	FieldType_FIELD_TYPE_DATE   FieldType = 4 // дата в формате ГГГГ-ММ-ДД
+	FieldType_FIELD_TYPE_OTP    FieldType = 5 // секрет одноразовых паролей: base32 или otpauth://
+)
+
+// Enum value maps for FieldType.
+var (
+	FieldType_name = map[int32]string{
+		0: "FIELD_TYPE_TEXT",
+		1: "FIELD_TYPE_SECRET",
+		2: "FIELD_TYPE_URL",
+		3: "FIELD_TYPE_EMAIL",
+		4: "FIELD_TYPE_DATE",
+		5: "FIELD_TYPE_OTP",
+	}
+	FieldType_value = map[string]int32{
+		"FIELD_TYPE_TEXT":   0,
+		"FIELD_TYPE_SECRET": 1,
+		"FIELD_TYPE_URL":    2,
+		"FIELD_TYPE_EMAIL":  3,
+		"FIELD_TYPE_DATE":   4,
+		"FIELD_TYPE_OTP":    5,
+	}
+)
+
+func (x FieldType) Enum() *FieldType {
+	p := new(FieldType)
+	*p = x
+	return p
+}
+
+func (x FieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_gophkeeper_proto_enumTypes[1].Descriptor()
+}
+
+func (FieldType) Type() protoreflect.EnumType {
+	return &file_proto_v1_gophkeeper_proto_enumTypes[1]
+}
+
+func (x FieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FieldType.Descriptor instead.
+func (FieldType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{1}
+}
+
+// OtpType вид одноразовых паролей
+type OtpType int32
+
+const (
+	OtpType_OTP_TYPE_TOTP OtpType = 0 // по времени, RFC 6238
+	OtpType_OTP_TYPE_HOTP OtpType = 1 // по счётчику, RFC 4226
+)
+
+// Enum value maps for OtpType.
+var (
+	OtpType_name = map[int32]string{
+		0: "OTP_TYPE_TOTP",
+		1: "OTP_TYPE_HOTP",
+	}
+	OtpType_value = map[string]int32{
+		"OTP_TYPE_TOTP": 0,
+		"OTP_TYPE_HOTP": 1,
+	}
+)
+
+func (x OtpType) Enum() *OtpType {
+	p := new(OtpType)
+	*p = x
+	return p
+}
+
+func (x OtpType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OtpType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_gophkeeper_proto_enumTypes[2].Descriptor()
+}
+
+func (OtpType) Type() protoreflect.EnumType {
+	return &file_proto_v1_gophkeeper_proto_enumTypes[2]
+}
+
+func (x OtpType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OtpType.Descriptor instead.
+func (OtpType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{2}
+}
+
+// OtpAlgorithm алгоритм HMAC
+type OtpAlgorithm int32
+
+const (
+	OtpAlgorithm_OTP_ALGORITHM_SHA1   OtpAlgorithm = 0
+	OtpAlgorithm_OTP_ALGORITHM_SHA256 OtpAlgorithm = 1
+	OtpAlgorithm_OTP_ALGORITHM_SHA512 OtpAlgorithm = 2
+)
+
+// Enum value maps for OtpAlgorithm.
+var (
+	OtpAlgorithm_name = map[int32]string{
+		0: "OTP_ALGORITHM_SHA1",
+		1: "OTP_ALGORITHM_SHA256",
+		2: "OTP_ALGORITHM_SHA512",
+	}
+	OtpAlgorithm_value = map[string]int32{
+		"OTP_ALGORITHM_SHA1":   0,
+		"OTP_ALGORITHM_SHA256": 1,
+		"OTP_ALGORITHM_SHA512": 2,
+	}
+)
+
+func (x OtpAlgorithm) Enum() *OtpAlgorithm {
+	p := new(OtpAlgorithm)
+	*p = x
+	return p
+}
+
+func (x OtpAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OtpAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_gophkeeper_proto_enumTypes[3].Descriptor()
+}
+
+func (OtpAlgorithm) Type() protoreflect.EnumType {
+	return &file_proto_v1_gophkeeper_proto_enumTypes[3]
+}
+
+func (x OtpAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OtpAlgorithm.Descriptor instead.
+func (OtpAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{3}
+}
+
+// SshKeyType вид ключа SSH
+type SshKeyType int32
+
+const (
+	SshKeyType_SSH_KEY_TYPE_ED25519 SshKeyType = 0
+	SshKeyType_SSH_KEY_TYPE_RSA     SshKeyType = 1
+	SshKeyType_SSH_KEY_TYPE_ECDSA   SshKeyType = 2
+)
+
+// Enum value maps for SshKeyType.
+var (
+	SshKeyType_name = map[int32]string{
+		0: "SSH_KEY_TYPE_ED25519",
+		1: "SSH_KEY_TYPE_RSA",
+		2: "SSH_KEY_TYPE_ECDSA",
+	}
+	SshKeyType_value = map[string]int32{
+		"SSH_KEY_TYPE_ED25519": 0,
+		"SSH_KEY_TYPE_RSA":     1,
+		"SSH_KEY_TYPE_ECDSA":   2,
+	}
+)
+
+func (x SshKeyType) Enum() *SshKeyType {
+	p := new(SshKeyType)
+	*p = x
+	return p
+}
+
+func (x SshKeyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SshKeyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_gophkeeper_proto_enumTypes[4].Descriptor()
+}
+
+func (SshKeyType) Type() protoreflect.EnumType {
+	return &file_proto_v1_gophkeeper_proto_enumTypes[4]
+}
+
+func (x SshKeyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SshKeyType.Descriptor instead.
+func (SshKeyType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{4}
+}
+
 // Ping
 type PingResponse struct {
 	state         protoimpl.MessageState
@@ -279,10 +536,15 @@ type ListResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardsCount     int32 `protobuf:"varint,1,opt,name=cards_count,json=cardsCount,proto3" json:"cards_count,omitempty"`             // количество кредитных картNoteReadResponse
-	NotesCount     int32 `protobuf:"varint,2,opt,name=notes_count,json=notesCount,proto3" json:"notes_count,omitempty"`             // количество заметок
-	BinariesCount  int32 `protobuf:"varint,3,opt,name=binaries_count,json=binariesCount,proto3" json:"binaries_count,omitempty"`    // количество хранимых двоичных данных
-	PasswordsCount int32 `protobuf:"varint,4,opt,name=passwords_count,json=passwordsCount,proto3" json:"passwords_count,omitempty"` // количество хранимых пользователем паролей
+	CardsCount     int32   `protobuf:"varint,1,opt,name=cards_count,json=cardsCount,proto3" json:"cards_count,omitempty"`             // количество кредитных картNoteReadResponse
+	NotesCount     int32   `protobuf:"varint,2,opt,name=notes_count,json=notesCount,proto3" json:"notes_count,omitempty"`             // количество заметок
+	BinariesCount  int32   `protobuf:"varint,3,opt,name=binaries_count,json=binariesCount,proto3" json:"binaries_count,omitempty"`    // количество хранимых двоичных данных
+	PasswordsCount int32   `protobuf:"varint,4,opt,name=passwords_count,json=passwordsCount,proto3" json:"passwords_count,omitempty"` // количество хранимых пользователем паролей
+	BinariesSize   int64   `protobuf:"varint,5,opt,name=binaries_size,json=binariesSize,proto3" json:"binaries_size,omitempty"`       // объём хранимых файлов в байтах
+	Limits         *Limits `protobuf:"bytes,6,opt,name=limits,proto3" json:"limits,omitempty"`                                        // ограничения хранилища пользователя
+	ItemsCount     int32   `protobuf:"varint,7,opt,name=items_count,json=itemsCount,proto3" json:"items_count,omitempty"`             // количество произвольных элементов
+	OtpsCount      int32   `protobuf:"varint,8,opt,name=otps_count,json=otpsCount,proto3" json:"otps_count,omitempty"`                // количество секретов одноразовых паролей
+	SshKeysCount   int32   `protobuf:"varint,9,opt,name=ssh_keys_count,json=sshKeysCount,proto3" json:"ssh_keys_count,omitempty"`     // количество ключей SSH
 }
 
 func (x *ListResponse) Reset() {
@@ -345,16 +607,54 @@ func (x *ListResponse) GetPasswordsCount() int32 {
 	return 0
 }
 
-type PasswordListResponse struct {
+func (x *ListResponse) GetBinariesSize() int64 {
+	if x != nil {
+		return x.BinariesSize
+	}
+	return 0
+}
+
+func (x *ListResponse) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ListResponse) GetItemsCount() int32 {
+	if x != nil {
+		return x.ItemsCount
+	}
+	return 0
+}
+
+func (x *ListResponse) GetOtpsCount() int32 {
+	if x != nil {
+		return x.OtpsCount
+	}
+	return 0
+}
+
+func (x *ListResponse) GetSshKeysCount() int32 {
+	if x != nil {
+		return x.SshKeysCount
+	}
+	return 0
+}
+
+// Limits ограничения хранилища, 0 - без ограничения
+type Limits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // массив наименований
+	Bytes    int64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`                       // общий объём файлов
+	Items    int32 `protobuf:"varint,2,opt,name=items,proto3" json:"items,omitempty"`                       // количество элементов каждого вида
+	FileSize int64 `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"` // размер одного файла
 }
 
-func (x *PasswordListResponse) Reset() {
-	*x = PasswordListResponse{}
+func (x *Limits) Reset() {
+	*x = Limits{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1_gophkeeper_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -362,13 +662,13 @@ func (x *PasswordListResponse) Reset() {
 	}
 }
 
-func (x *PasswordListResponse) String() string {
+func (x *Limits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordListResponse) ProtoMessage() {}
+func (*Limits) ProtoMessage() {}
 
-func (x *PasswordListResponse) ProtoReflect() protoreflect.Message {
+func (x *Limits) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_gophkeeper_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -380,28 +680,50 @@ func (x *PasswordListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordListResponse.ProtoReflect.Descriptor instead.
-func (*PasswordListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
+func (*Limits) Descriptor() ([]byte, []int) {
 	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PasswordListResponse) GetNames() []string {
+func (x *Limits) GetBytes() int64 {
 	if x != nil {
-		return x.Names
+		return x.Bytes
 	}
-	return nil
+	return 0
 }
 
-type PasswordReadRequest struct {
+func (x *Limits) GetItems() int32 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *Limits) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+type ListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Limit     int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`  // размер страницы, 0 - по умолчанию
+	Cursor    string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // курсор из предыдущего ответа
+	Sort      ListSort `protobuf:"varint,3,opt,name=sort,proto3,enum=gophermart.v1.ListSort" json:"sort,omitempty"`
+	Desc      bool     `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`           // обратный порядок
+	Prefix    string   `protobuf:"bytes,5,opt,name=prefix,proto3" json:"prefix,omitempty"`        // фильтр по началу наименования
+	Glob      string   `protobuf:"bytes,6,opt,name=glob,proto3" json:"glob,omitempty"`            // фильтр по шаблону: * и ?
+	Tag       string   `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`              // фильтр по метке
+	Folder    string   `protobuf:"bytes,8,opt,name=folder,proto3" json:"folder,omitempty"`        // фильтр по папке, "/" - корень
+	Recursive bool     `protobuf:"varint,9,opt,name=recursive,proto3" json:"recursive,omitempty"` // вместе с вложенными папками
 }
 
-func (x *PasswordReadRequest) Reset() {
-	*x = PasswordReadRequest{}
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1_gophkeeper_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -409,13 +731,13 @@ func (x *PasswordReadRequest) Reset() {
 	}
 }
 
-func (x *PasswordReadRequest) String() string {
+func (x *ListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordReadRequest) ProtoMessage() {}
+func (*ListRequest) ProtoMessage() {}
 
-func (x *PasswordReadRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_gophkeeper_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -427,32 +749,91 @@ func (x *PasswordReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordReadRequest.ProtoReflect.Descriptor instead.
-func (*PasswordReadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
 	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PasswordReadRequest) GetName() string {
+func (x *ListRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Name
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
 	return ""
 }
 
-type PasswordReadResponse struct {
+func (x *ListRequest) GetSort() ListSort {
+	if x != nil {
+		return x.Sort
+	}
+	return ListSort_LIST_SORT_NAME
+}
+
+func (x *ListRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *ListRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListRequest) GetGlob() string {
+	if x != nil {
+		return x.Glob
+	}
+	return ""
+}
+
+func (x *ListRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *ListRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type ListEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	Notes    string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Id        int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Size      int64                `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`    // размер двоичных данных
+	Folder    string               `protobuf:"bytes,6,opt,name=folder,proto3" json:"folder,omitempty"` // путь папки, пусто - корень
+	Tags      []string             `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время удаления, не задано - бессрочно
 }
 
-func (x *PasswordReadResponse) Reset() {
-	*x = PasswordReadResponse{}
+func (x *ListEntry) Reset() {
+	*x = ListEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1_gophkeeper_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,13 +841,13 @@ func (x *PasswordReadResponse) Reset() {
 	}
 }
 
-func (x *PasswordReadResponse) String() string {
+func (x *ListEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordReadResponse) ProtoMessage() {}
+func (*ListEntry) ProtoMessage() {}
 
-func (x *PasswordReadResponse) ProtoReflect() protoreflect.Message {
+func (x *ListEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_gophkeeper_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -478,59 +859,78 @@ func (x *PasswordReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordReadResponse.ProtoReflect.Descriptor instead.
-func (*PasswordReadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEntry.ProtoReflect.Descriptor instead.
+func (*ListEntry) Descriptor() ([]byte, []int) {
 	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PasswordReadResponse) GetId() int64 {
+func (x *ListEntry) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *PasswordReadResponse) GetName() string {
+func (x *ListEntry) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *PasswordReadResponse) GetUsername() string {
+func (x *ListEntry) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Username
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *PasswordReadResponse) GetPassword() string {
+func (x *ListEntry) GetUpdatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Password
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *PasswordReadResponse) GetNotes() string {
+func (x *ListEntry) GetSize() int64 {
 	if x != nil {
-		return x.Notes
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListEntry) GetFolder() string {
+	if x != nil {
+		return x.Folder
 	}
 	return ""
 }
 
-type PasswordWriteRequest struct {
+func (x *ListEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListEntry) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type PasswordListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Notes    string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Entries    []*ListEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor string       `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // курсор следующей страницы, пусто на последней
 }
 
-func (x *PasswordWriteRequest) Reset() {
-	*x = PasswordWriteRequest{}
+func (x *PasswordListResponse) Reset() {
+	*x = PasswordListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_v1_gophkeeper_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -538,13 +938,13 @@ func (x *PasswordWriteRequest) Reset() {
 	}
 }
 
-func (x *PasswordWriteRequest) String() string {
+func (x *PasswordListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordWriteRequest) ProtoMessage() {}
+func (*PasswordListResponse) ProtoMessage() {}
 
-func (x *PasswordWriteRequest) ProtoReflect() protoreflect.Message {
+func (x *PasswordListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_gophkeeper_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -556,14 +956,225 @@ func (x *PasswordWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordWriteRequest.ProtoReflect.Descriptor instead.
-func (*PasswordWriteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PasswordListResponse.ProtoReflect.Descriptor instead.
+func (*PasswordListResponse) Descriptor() ([]byte, []int) {
 	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PasswordWriteRequest) GetName() string {
+func (x *PasswordListResponse) GetEntries() []*ListEntry {
 	if x != nil {
-		return x.Name
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *PasswordListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type PasswordReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PasswordReadRequest) Reset() {
+	*x = PasswordReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordReadRequest) ProtoMessage() {}
+
+func (x *PasswordReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordReadRequest.ProtoReflect.Descriptor instead.
+func (*PasswordReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PasswordReadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PasswordReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Username   string               `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password   string               `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Notes      string               `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Url        string               `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Searchable bool                 `protobuf:"varint,7,opt,name=searchable,proto3" json:"searchable,omitempty"`
+	ExpiresAt  *timestamp.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время удаления, не задано - бессрочно
+}
+
+func (x *PasswordReadResponse) Reset() {
+	*x = PasswordReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordReadResponse) ProtoMessage() {}
+
+func (x *PasswordReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordReadResponse.ProtoReflect.Descriptor instead.
+func (*PasswordReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PasswordReadResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PasswordReadResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PasswordReadResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PasswordReadResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *PasswordReadResponse) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *PasswordReadResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PasswordReadResponse) GetSearchable() bool {
+	if x != nil {
+		return x.Searchable
+	}
+	return false
+}
+
+func (x *PasswordReadResponse) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type PasswordWriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Username   string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password   string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Notes      string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Url        string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`                // адрес сайта, хранится открыто для поиска
+	Searchable bool   `protobuf:"varint,6,opt,name=searchable,proto3" json:"searchable,omitempty"` // хранить имя пользователя открыто для поиска
+	// время удаления, не задано - бессрочно; при обновлении задаётся заново
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *PasswordWriteRequest) Reset() {
+	*x = PasswordWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordWriteRequest) ProtoMessage() {}
+
+func (x *PasswordWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordWriteRequest.ProtoReflect.Descriptor instead.
+func (*PasswordWriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PasswordWriteRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
@@ -589,6 +1200,27 @@ func (x *PasswordWriteRequest) GetNotes() string {
 	return ""
 }
 
+func (x *PasswordWriteRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PasswordWriteRequest) GetSearchable() bool {
+	if x != nil {
+		return x.Searchable
+	}
+	return false
+}
+
+func (x *PasswordWriteRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type BinaryWriteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -600,7 +1232,7 @@ type BinaryWriteResponse struct {
 func (x *BinaryWriteResponse) Reset() {
 	*x = BinaryWriteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[10]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -613,7 +1245,7 @@ func (x *BinaryWriteResponse) String() string {
 func (*BinaryWriteResponse) ProtoMessage() {}
 
 func (x *BinaryWriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[10]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -626,7 +1258,7 @@ func (x *BinaryWriteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BinaryWriteResponse.ProtoReflect.Descriptor instead.
 func (*BinaryWriteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{10}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BinaryWriteResponse) GetId() int64 {
@@ -647,7 +1279,7 @@ type PasswordDelRequest struct {
 func (x *PasswordDelRequest) Reset() {
 	*x = PasswordDelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[11]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -660,7 +1292,7 @@ func (x *PasswordDelRequest) String() string {
 func (*PasswordDelRequest) ProtoMessage() {}
 
 func (x *PasswordDelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[11]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +1305,7 @@ func (x *PasswordDelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PasswordDelRequest.ProtoReflect.Descriptor instead.
 func (*PasswordDelRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{11}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PasswordDelRequest) GetName() string {
@@ -695,7 +1327,7 @@ type PasswordUpdateRequest struct {
 func (x *PasswordUpdateRequest) Reset() {
 	*x = PasswordUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[12]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -708,7 +1340,7 @@ func (x *PasswordUpdateRequest) String() string {
 func (*PasswordUpdateRequest) ProtoMessage() {}
 
 func (x *PasswordUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[12]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +1353,7 @@ func (x *PasswordUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PasswordUpdateRequest.ProtoReflect.Descriptor instead.
 func (*PasswordUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{12}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PasswordUpdateRequest) GetId() int64 {
@@ -743,13 +1375,14 @@ type CardListResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // массив наименований
+	Entries    []*ListEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor string       `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // курсор следующей страницы, пусто на последней
 }
 
 func (x *CardListResponse) Reset() {
 	*x = CardListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[13]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -762,7 +1395,7 @@ func (x *CardListResponse) String() string {
 func (*CardListResponse) ProtoMessage() {}
 
 func (x *CardListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[13]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,16 +1408,23 @@ func (x *CardListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardListResponse.ProtoReflect.Descriptor instead.
 func (*CardListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{13}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CardListResponse) GetNames() []string {
+func (x *CardListResponse) GetEntries() []*ListEntry {
 	if x != nil {
-		return x.Names
+		return x.Entries
 	}
 	return nil
 }
 
+func (x *CardListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type CardReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -796,7 +1436,7 @@ type CardReadRequest struct {
 func (x *CardReadRequest) Reset() {
 	*x = CardReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[14]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -809,7 +1449,7 @@ func (x *CardReadRequest) String() string {
 func (*CardReadRequest) ProtoMessage() {}
 
 func (x *CardReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[14]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +1462,7 @@ func (x *CardReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardReadRequest.ProtoReflect.Descriptor instead.
 func (*CardReadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{14}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CardReadRequest) GetName() string {
@@ -837,17 +1477,18 @@ type CardReadResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Number string `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
-	Pin    string `protobuf:"bytes,4,opt,name=pin,proto3" json:"pin,omitempty"`
-	Notes  string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Id        int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Number    string               `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
+	Pin       string               `protobuf:"bytes,4,opt,name=pin,proto3" json:"pin,omitempty"`
+	Notes     string               `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время удаления, не задано - бессрочно
 }
 
 func (x *CardReadResponse) Reset() {
 	*x = CardReadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[15]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -860,7 +1501,7 @@ func (x *CardReadResponse) String() string {
 func (*CardReadResponse) ProtoMessage() {}
 
 func (x *CardReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[15]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1514,7 @@ func (x *CardReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardReadResponse.ProtoReflect.Descriptor instead.
 func (*CardReadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{15}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CardReadResponse) GetId() int64 {
@@ -911,6 +1552,13 @@ func (x *CardReadResponse) GetNotes() string {
 	return ""
 }
 
+func (x *CardReadResponse) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type CardWriteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -920,12 +1568,14 @@ type CardWriteRequest struct {
 	Number string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
 	Pin    string `protobuf:"bytes,3,opt,name=pin,proto3" json:"pin,omitempty"`
 	Notes  string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	// время удаления, не задано - бессрочно; при обновлении задаётся заново
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (x *CardWriteRequest) Reset() {
 	*x = CardWriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[16]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -938,7 +1588,7 @@ func (x *CardWriteRequest) String() string {
 func (*CardWriteRequest) ProtoMessage() {}
 
 func (x *CardWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[16]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,7 +1601,7 @@ func (x *CardWriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardWriteRequest.ProtoReflect.Descriptor instead.
 func (*CardWriteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{16}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CardWriteRequest) GetName() string {
@@ -982,8 +1632,15 @@ func (x *CardWriteRequest) GetNotes() string {
 	return ""
 }
 
-type CardDelRequest struct {
-	state         protoimpl.MessageState
+func (x *CardWriteRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CardDelRequest struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
@@ -993,7 +1650,7 @@ type CardDelRequest struct {
 func (x *CardDelRequest) Reset() {
 	*x = CardDelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[17]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1006,7 +1663,7 @@ func (x *CardDelRequest) String() string {
 func (*CardDelRequest) ProtoMessage() {}
 
 func (x *CardDelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[17]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +1676,7 @@ func (x *CardDelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardDelRequest.ProtoReflect.Descriptor instead.
 func (*CardDelRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{17}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CardDelRequest) GetName() string {
@@ -1041,7 +1698,7 @@ type CardUpdateRequest struct {
 func (x *CardUpdateRequest) Reset() {
 	*x = CardUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[18]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1054,7 +1711,7 @@ func (x *CardUpdateRequest) String() string {
 func (*CardUpdateRequest) ProtoMessage() {}
 
 func (x *CardUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[18]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,7 +1724,7 @@ func (x *CardUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CardUpdateRequest.ProtoReflect.Descriptor instead.
 func (*CardUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{18}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CardUpdateRequest) GetId() int64 {
@@ -1089,13 +1746,14 @@ type NoteListResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // массив наименований
+	Entries    []*ListEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor string       `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // курсор следующей страницы, пусто на последней
 }
 
 func (x *NoteListResponse) Reset() {
 	*x = NoteListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[19]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1108,7 +1766,7 @@ func (x *NoteListResponse) String() string {
 func (*NoteListResponse) ProtoMessage() {}
 
 func (x *NoteListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[19]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,16 +1779,23 @@ func (x *NoteListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteListResponse.ProtoReflect.Descriptor instead.
 func (*NoteListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{19}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *NoteListResponse) GetNames() []string {
+func (x *NoteListResponse) GetEntries() []*ListEntry {
 	if x != nil {
-		return x.Names
+		return x.Entries
 	}
 	return nil
 }
 
+func (x *NoteListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type NoteReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1142,7 +1807,7 @@ type NoteReadRequest struct {
 func (x *NoteReadRequest) Reset() {
 	*x = NoteReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[20]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1155,7 +1820,7 @@ func (x *NoteReadRequest) String() string {
 func (*NoteReadRequest) ProtoMessage() {}
 
 func (x *NoteReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[20]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,7 +1833,7 @@ func (x *NoteReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteReadRequest.ProtoReflect.Descriptor instead.
 func (*NoteReadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{20}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *NoteReadRequest) GetName() string {
@@ -1183,15 +1848,16 @@ type NoteReadResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Notes string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Id        int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Notes     string               `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время удаления, не задано - бессрочно
 }
 
 func (x *NoteReadResponse) Reset() {
 	*x = NoteReadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[21]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1204,7 +1870,7 @@ func (x *NoteReadResponse) String() string {
 func (*NoteReadResponse) ProtoMessage() {}
 
 func (x *NoteReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[21]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1217,7 +1883,7 @@ func (x *NoteReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteReadResponse.ProtoReflect.Descriptor instead.
 func (*NoteReadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{21}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *NoteReadResponse) GetId() int64 {
@@ -1241,6 +1907,13 @@ func (x *NoteReadResponse) GetNotes() string {
 	return ""
 }
 
+func (x *NoteReadResponse) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type NoteWriteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1248,12 +1921,14 @@ type NoteWriteRequest struct {
 
 	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Notes string `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	// время удаления, не задано - бессрочно; при обновлении задаётся заново
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (x *NoteWriteRequest) Reset() {
 	*x = NoteWriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[22]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1266,7 +1941,7 @@ func (x *NoteWriteRequest) String() string {
 func (*NoteWriteRequest) ProtoMessage() {}
 
 func (x *NoteWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[22]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1279,7 +1954,7 @@ func (x *NoteWriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteWriteRequest.ProtoReflect.Descriptor instead.
 func (*NoteWriteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{22}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *NoteWriteRequest) GetName() string {
@@ -1296,6 +1971,13 @@ func (x *NoteWriteRequest) GetNotes() string {
 	return ""
 }
 
+func (x *NoteWriteRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type NoteDelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1307,7 +1989,7 @@ type NoteDelRequest struct {
 func (x *NoteDelRequest) Reset() {
 	*x = NoteDelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[23]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1320,7 +2002,7 @@ func (x *NoteDelRequest) String() string {
 func (*NoteDelRequest) ProtoMessage() {}
 
 func (x *NoteDelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[23]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,7 +2015,7 @@ func (x *NoteDelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteDelRequest.ProtoReflect.Descriptor instead.
 func (*NoteDelRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{23}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *NoteDelRequest) GetName() string {
@@ -1355,7 +2037,7 @@ type NoteUpdateRequest struct {
 func (x *NoteUpdateRequest) Reset() {
 	*x = NoteUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[24]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1368,7 +2050,7 @@ func (x *NoteUpdateRequest) String() string {
 func (*NoteUpdateRequest) ProtoMessage() {}
 
 func (x *NoteUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[24]
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,7 +2063,7 @@ func (x *NoteUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteUpdateRequest.ProtoReflect.Descriptor instead.
 func (*NoteUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{24}
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *NoteUpdateRequest) GetId() int64 {
@@ -1398,31 +2080,34 @@ func (x *NoteUpdateRequest) GetWrite() *NoteWriteRequest {
 	return nil
 }
 
-type BinaryListResponse struct {
+// ItemField поле произвольного элемента, значение шифруется отдельно
+type ItemField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // массив наименований
+	Label string    `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Type  FieldType `protobuf:"varint,2,opt,name=type,proto3,enum=gophermart.v1.FieldType" json:"type,omitempty"`
+	Value string    `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *BinaryListResponse) Reset() {
-	*x = BinaryListResponse{}
+func (x *ItemField) Reset() {
+	*x = ItemField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[25]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BinaryListResponse) String() string {
+func (x *ItemField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryListResponse) ProtoMessage() {}
+func (*ItemField) ProtoMessage() {}
 
-func (x *BinaryListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[25]
+func (x *ItemField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1433,19 +2118,88 @@ func (x *BinaryListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryListResponse.ProtoReflect.Descriptor instead.
-func (*BinaryListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ItemField.ProtoReflect.Descriptor instead.
+func (*ItemField) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ItemField) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ItemField) GetType() FieldType {
+	if x != nil {
+		return x.Type
+	}
+	return FieldType_FIELD_TYPE_TEXT
+}
+
+func (x *ItemField) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ItemListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries    []*ListEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor string       `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // курсор следующей страницы, пусто на последней
+}
+
+func (x *ItemListResponse) Reset() {
+	*x = ItemListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemListResponse) ProtoMessage() {}
+
+func (x *ItemListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemListResponse.ProtoReflect.Descriptor instead.
+func (*ItemListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *BinaryListResponse) GetNames() []string {
+func (x *ItemListResponse) GetEntries() []*ListEntry {
 	if x != nil {
-		return x.Names
+		return x.Entries
 	}
 	return nil
 }
 
-type BinaryReadRequest struct {
+func (x *ItemListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ItemReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1453,23 +2207,23 @@ type BinaryReadRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *BinaryReadRequest) Reset() {
-	*x = BinaryReadRequest{}
+func (x *ItemReadRequest) Reset() {
+	*x = ItemReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[26]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BinaryReadRequest) String() string {
+func (x *ItemReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryReadRequest) ProtoMessage() {}
+func (*ItemReadRequest) ProtoMessage() {}
 
-func (x *BinaryReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[26]
+func (x *ItemReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1480,47 +2234,47 @@ func (x *BinaryReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryReadRequest.ProtoReflect.Descriptor instead.
-func (*BinaryReadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ItemReadRequest.ProtoReflect.Descriptor instead.
+func (*ItemReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *BinaryReadRequest) GetName() string {
+func (x *ItemReadRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type BinaryReadResponse struct {
+type ItemReadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Size  int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	BinId int64  `protobuf:"varint,4,opt,name=bin_id,json=binId,proto3" json:"bin_id,omitempty"`
-	Notes string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Id        int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type      string               `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Fields    []*ItemField         `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`                        // в порядке записи
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время удаления, не задано - бессрочно
 }
 
-func (x *BinaryReadResponse) Reset() {
-	*x = BinaryReadResponse{}
+func (x *ItemReadResponse) Reset() {
+	*x = ItemReadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[27]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BinaryReadResponse) String() string {
+func (x *ItemReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryReadResponse) ProtoMessage() {}
+func (*ItemReadResponse) ProtoMessage() {}
 
-func (x *BinaryReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[27]
+func (x *ItemReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,73 +2285,75 @@ func (x *BinaryReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryReadResponse.ProtoReflect.Descriptor instead.
-func (*BinaryReadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ItemReadResponse.ProtoReflect.Descriptor instead.
+func (*ItemReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *BinaryReadResponse) GetId() int64 {
+func (x *ItemReadResponse) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *BinaryReadResponse) GetName() string {
+func (x *ItemReadResponse) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *BinaryReadResponse) GetSize() int64 {
+func (x *ItemReadResponse) GetType() string {
 	if x != nil {
-		return x.Size
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *BinaryReadResponse) GetBinId() int64 {
+func (x *ItemReadResponse) GetFields() []*ItemField {
 	if x != nil {
-		return x.BinId
+		return x.Fields
 	}
-	return 0
+	return nil
 }
 
-func (x *BinaryReadResponse) GetNotes() string {
+func (x *ItemReadResponse) GetExpiresAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Notes
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-type BinaryWriteRequest struct {
+type ItemWriteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Size  int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Notes string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Name   string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type   string       `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // тип элемента, например "database"
+	Fields []*ItemField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// время удаления, не задано - бессрочно; при обновлении задаётся заново
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (x *BinaryWriteRequest) Reset() {
-	*x = BinaryWriteRequest{}
+func (x *ItemWriteRequest) Reset() {
+	*x = ItemWriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[28]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BinaryWriteRequest) String() string {
+func (x *ItemWriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryWriteRequest) ProtoMessage() {}
+func (*ItemWriteRequest) ProtoMessage() {}
 
-func (x *BinaryWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[28]
+func (x *ItemWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,33 +2364,40 @@ func (x *BinaryWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryWriteRequest.ProtoReflect.Descriptor instead.
-func (*BinaryWriteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ItemWriteRequest.ProtoReflect.Descriptor instead.
+func (*ItemWriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *BinaryWriteRequest) GetName() string {
+func (x *ItemWriteRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *BinaryWriteRequest) GetSize() int64 {
+func (x *ItemWriteRequest) GetType() string {
 	if x != nil {
-		return x.Size
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *BinaryWriteRequest) GetNotes() string {
+func (x *ItemWriteRequest) GetFields() []*ItemField {
 	if x != nil {
-		return x.Notes
+		return x.Fields
 	}
-	return ""
+	return nil
 }
 
-type BinaryDelRequest struct {
+func (x *ItemWriteRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ItemDelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1642,23 +2405,23 @@ type BinaryDelRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *BinaryDelRequest) Reset() {
-	*x = BinaryDelRequest{}
+func (x *ItemDelRequest) Reset() {
+	*x = ItemDelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[29]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BinaryDelRequest) String() string {
+func (x *ItemDelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryDelRequest) ProtoMessage() {}
+func (*ItemDelRequest) ProtoMessage() {}
 
-func (x *BinaryDelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[29]
+func (x *ItemDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,45 +2432,44 @@ func (x *BinaryDelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryDelRequest.ProtoReflect.Descriptor instead.
-func (*BinaryDelRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ItemDelRequest.ProtoReflect.Descriptor instead.
+func (*ItemDelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *BinaryDelRequest) GetName() string {
+func (x *ItemDelRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type BinaryUpdateRequest struct {
+type ItemUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BinId int64               `protobuf:"varint,2,opt,name=bin_id,json=binId,proto3" json:"bin_id,omitempty"`
-	Write *BinaryWriteRequest `protobuf:"bytes,3,opt,name=write,proto3" json:"write,omitempty"`
+	Id    int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Write *ItemWriteRequest `protobuf:"bytes,2,opt,name=write,proto3" json:"write,omitempty"`
 }
 
-func (x *BinaryUpdateRequest) Reset() {
-	*x = BinaryUpdateRequest{}
+func (x *ItemUpdateRequest) Reset() {
+	*x = ItemUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[30]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BinaryUpdateRequest) String() string {
+func (x *ItemUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryUpdateRequest) ProtoMessage() {}
+func (*ItemUpdateRequest) ProtoMessage() {}
 
-func (x *BinaryUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[30]
+func (x *ItemUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1718,58 +2480,51 @@ func (x *BinaryUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryUpdateRequest.ProtoReflect.Descriptor instead.
-func (*BinaryUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ItemUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ItemUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *BinaryUpdateRequest) GetId() int64 {
+func (x *ItemUpdateRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *BinaryUpdateRequest) GetBinId() int64 {
-	if x != nil {
-		return x.BinId
-	}
-	return 0
-}
-
-func (x *BinaryUpdateRequest) GetWrite() *BinaryWriteRequest {
+func (x *ItemUpdateRequest) GetWrite() *ItemWriteRequest {
 	if x != nil {
 		return x.Write
 	}
 	return nil
 }
 
-type BinaryUplodStream struct {
+type OtpListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Chunk []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Entries    []*ListEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor string       `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // курсор следующей страницы, пусто на последней
 }
 
-func (x *BinaryUplodStream) Reset() {
-	*x = BinaryUplodStream{}
+func (x *OtpListResponse) Reset() {
+	*x = OtpListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[31]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BinaryUplodStream) String() string {
+func (x *OtpListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryUplodStream) ProtoMessage() {}
+func (*OtpListResponse) ProtoMessage() {}
 
-func (x *BinaryUplodStream) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[31]
+func (x *OtpListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1780,541 +2535,5252 @@ func (x *BinaryUplodStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BinaryUplodStream.ProtoReflect.Descriptor instead.
-func (*BinaryUplodStream) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use OtpListResponse.ProtoReflect.Descriptor instead.
+func (*OtpListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *BinaryUplodStream) GetId() int64 {
+func (x *OtpListResponse) GetEntries() []*ListEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *OtpListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type OtpReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *OtpReadRequest) Reset() {
+	*x = OtpReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OtpReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OtpReadRequest) ProtoMessage() {}
+
+func (x *OtpReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtpReadRequest.ProtoReflect.Descriptor instead.
+func (*OtpReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *OtpReadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type OtpReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Issuer    string               `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Account   string               `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Secret    string               `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"` // base32
+	Type      OtpType              `protobuf:"varint,6,opt,name=type,proto3,enum=gophermart.v1.OtpType" json:"type,omitempty"`
+	Algorithm OtpAlgorithm         `protobuf:"varint,7,opt,name=algorithm,proto3,enum=gophermart.v1.OtpAlgorithm" json:"algorithm,omitempty"`
+	Digits    uint32               `protobuf:"varint,8,opt,name=digits,proto3" json:"digits,omitempty"`
+	Period    uint32               `protobuf:"varint,9,opt,name=period,proto3" json:"period,omitempty"`                        // шаг TOTP в секундах
+	Counter   uint64               `protobuf:"varint,10,opt,name=counter,proto3" json:"counter,omitempty"`                     // счётчик следующего пароля HOTP
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время удаления, не задано - бессрочно
+}
+
+func (x *OtpReadResponse) Reset() {
+	*x = OtpReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OtpReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OtpReadResponse) ProtoMessage() {}
+
+func (x *OtpReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtpReadResponse.ProtoReflect.Descriptor instead.
+func (*OtpReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *OtpReadResponse) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *BinaryUplodStream) GetChunk() []byte {
+func (x *OtpReadResponse) GetName() string {
 	if x != nil {
-		return x.Chunk
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OtpReadResponse) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OtpReadResponse) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *OtpReadResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *OtpReadResponse) GetType() OtpType {
+	if x != nil {
+		return x.Type
+	}
+	return OtpType_OTP_TYPE_TOTP
+}
+
+func (x *OtpReadResponse) GetAlgorithm() OtpAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return OtpAlgorithm_OTP_ALGORITHM_SHA1
+}
+
+func (x *OtpReadResponse) GetDigits() uint32 {
+	if x != nil {
+		return x.Digits
+	}
+	return 0
+}
+
+func (x *OtpReadResponse) GetPeriod() uint32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *OtpReadResponse) GetCounter() uint64 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+func (x *OtpReadResponse) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type BidaryDownloadRequest struct {
+// OtpWriteRequest секрет задаётся в base32 либо ссылкой otpauth://,
+// ссылка заменяет вид, алгоритм, количество цифр, шаг и счётчик, а
+// издатель и учётная запись берутся из неё, если не заданы явно
+type OtpWriteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Issuer    string       `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"` // хранится открыто для поиска
+	Account   string       `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Secret    string       `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Type      OtpType      `protobuf:"varint,5,opt,name=type,proto3,enum=gophermart.v1.OtpType" json:"type,omitempty"`
+	Algorithm OtpAlgorithm `protobuf:"varint,6,opt,name=algorithm,proto3,enum=gophermart.v1.OtpAlgorithm" json:"algorithm,omitempty"`
+	Digits    uint32       `protobuf:"varint,7,opt,name=digits,proto3" json:"digits,omitempty"` // 0 - 6 цифр
+	Period    uint32       `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"` // 0 - 30 секунд
+	Counter   uint64       `protobuf:"varint,9,opt,name=counter,proto3" json:"counter,omitempty"`
+	// время удаления, не задано - бессрочно; при обновлении задаётся заново
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Uri       string               `protobuf:"bytes,11,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *OtpWriteRequest) Reset() {
+	*x = OtpWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *BidaryDownloadRequest) Reset() {
-	*x = BidaryDownloadRequest{}
+func (x *OtpWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OtpWriteRequest) ProtoMessage() {}
+
+func (x *OtpWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtpWriteRequest.ProtoReflect.Descriptor instead.
+func (*OtpWriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *OtpWriteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OtpWriteRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OtpWriteRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *OtpWriteRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *OtpWriteRequest) GetType() OtpType {
+	if x != nil {
+		return x.Type
+	}
+	return OtpType_OTP_TYPE_TOTP
+}
+
+func (x *OtpWriteRequest) GetAlgorithm() OtpAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return OtpAlgorithm_OTP_ALGORITHM_SHA1
+}
+
+func (x *OtpWriteRequest) GetDigits() uint32 {
+	if x != nil {
+		return x.Digits
+	}
+	return 0
+}
+
+func (x *OtpWriteRequest) GetPeriod() uint32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *OtpWriteRequest) GetCounter() uint64 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+func (x *OtpWriteRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *OtpWriteRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type OtpDelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *OtpDelRequest) Reset() {
+	*x = OtpDelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[32]
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BidaryDownloadRequest) String() string {
+func (x *OtpDelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BidaryDownloadRequest) ProtoMessage() {}
+func (*OtpDelRequest) ProtoMessage() {}
 
-func (x *BidaryDownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[32]
+func (x *OtpDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtpDelRequest.ProtoReflect.Descriptor instead.
+func (*OtpDelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *OtpDelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type OtpUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Write *OtpWriteRequest `protobuf:"bytes,2,opt,name=write,proto3" json:"write,omitempty"`
+}
+
+func (x *OtpUpdateRequest) Reset() {
+	*x = OtpUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OtpUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OtpUpdateRequest) ProtoMessage() {}
+
+func (x *OtpUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BidaryDownloadRequest.ProtoReflect.Descriptor instead.
-func (*BidaryDownloadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *BidaryDownloadRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type BinaryDownloadStream struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
-}
-
-func (x *BinaryDownloadStream) Reset() {
-	*x = BinaryDownloadStream{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_gophkeeper_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *BinaryDownloadStream) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BinaryDownloadStream) ProtoMessage() {}
-
-func (x *BinaryDownloadStream) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_gophkeeper_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtpUpdateRequest.ProtoReflect.Descriptor instead.
+func (*OtpUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *OtpUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OtpUpdateRequest) GetWrite() *OtpWriteRequest {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+type OtpCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *OtpCodeRequest) Reset() {
+	*x = OtpCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OtpCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OtpCodeRequest) ProtoMessage() {}
+
+func (x *OtpCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtpCodeRequest.ProtoReflect.Descriptor instead.
+func (*OtpCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *OtpCodeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type OtpCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Remaining uint32 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"` // секунд до смены пароля TOTP, 0 для HOTP
+	Counter   uint64 `protobuf:"varint,3,opt,name=counter,proto3" json:"counter,omitempty"`     // использованное значение счётчика HOTP
+}
+
+func (x *OtpCodeResponse) Reset() {
+	*x = OtpCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OtpCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OtpCodeResponse) ProtoMessage() {}
+
+func (x *OtpCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtpCodeResponse.ProtoReflect.Descriptor instead.
+func (*OtpCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *OtpCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OtpCodeResponse) GetRemaining() uint32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *OtpCodeResponse) GetCounter() uint64 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+type SshKeyListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries    []*ListEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor string       `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // курсор следующей страницы, пусто на последней
+}
+
+func (x *SshKeyListResponse) Reset() {
+	*x = SshKeyListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SshKeyListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshKeyListResponse) ProtoMessage() {}
+
+func (x *SshKeyListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshKeyListResponse.ProtoReflect.Descriptor instead.
+func (*SshKeyListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SshKeyListResponse) GetEntries() []*ListEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *SshKeyListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SshKeyReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SshKeyReadRequest) Reset() {
+	*x = SshKeyReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SshKeyReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshKeyReadRequest) ProtoMessage() {}
+
+func (x *SshKeyReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshKeyReadRequest.ProtoReflect.Descriptor instead.
+func (*SshKeyReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SshKeyReadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SshKeyReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type        SshKeyType           `protobuf:"varint,3,opt,name=type,proto3,enum=gophermart.v1.SshKeyType" json:"type,omitempty"`
+	Bits        uint32               `protobuf:"varint,4,opt,name=bits,proto3" json:"bits,omitempty"`
+	PublicKey   string               `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`    // строка authorized_keys
+	PrivateKey  string               `protobuf:"bytes,6,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"` // формат OpenSSH без шифрования
+	Comment     string               `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	Fingerprint string               `protobuf:"bytes,8,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`              // SHA256:...
+	ExpiresAt   *timestamp.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // время удаления, не задано - бессрочно
+}
+
+func (x *SshKeyReadResponse) Reset() {
+	*x = SshKeyReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SshKeyReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshKeyReadResponse) ProtoMessage() {}
+
+func (x *SshKeyReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshKeyReadResponse.ProtoReflect.Descriptor instead.
+func (*SshKeyReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SshKeyReadResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SshKeyReadResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SshKeyReadResponse) GetType() SshKeyType {
+	if x != nil {
+		return x.Type
+	}
+	return SshKeyType_SSH_KEY_TYPE_ED25519
+}
+
+func (x *SshKeyReadResponse) GetBits() uint32 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+func (x *SshKeyReadResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SshKeyReadResponse) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SshKeyReadResponse) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SshKeyReadResponse) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *SshKeyReadResponse) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// SshKeyWriteRequest закрытый ключ импортируется в формате OpenSSH, PKCS#1,
+// PKCS#8 или SEC 1, зашифрованный - с фразой-паролем. При generate ключ
+// вида type создаёт сервер, bits 0 - длина по умолчанию (RSA 3072, ECDSA 256).
+// Открытый ключ и отпечаток вычисляются по закрытому.
+type SshKeyWriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PrivateKey string     `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	Passphrase string     `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Comment    string     `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"` // хранится открыто для поиска
+	Generate   bool       `protobuf:"varint,5,opt,name=generate,proto3" json:"generate,omitempty"`
+	Type       SshKeyType `protobuf:"varint,6,opt,name=type,proto3,enum=gophermart.v1.SshKeyType" json:"type,omitempty"`
+	Bits       uint32     `protobuf:"varint,7,opt,name=bits,proto3" json:"bits,omitempty"`
+	// время удаления, не задано - бессрочно; при обновлении задаётся заново
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *SshKeyWriteRequest) Reset() {
+	*x = SshKeyWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SshKeyWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshKeyWriteRequest) ProtoMessage() {}
+
+func (x *SshKeyWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshKeyWriteRequest.ProtoReflect.Descriptor instead.
+func (*SshKeyWriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SshKeyWriteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SshKeyWriteRequest) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SshKeyWriteRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *SshKeyWriteRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SshKeyWriteRequest) GetGenerate() bool {
+	if x != nil {
+		return x.Generate
+	}
+	return false
+}
+
+func (x *SshKeyWriteRequest) GetType() SshKeyType {
+	if x != nil {
+		return x.Type
+	}
+	return SshKeyType_SSH_KEY_TYPE_ED25519
+}
+
+func (x *SshKeyWriteRequest) GetBits() uint32 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+func (x *SshKeyWriteRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type SshKeyDelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SshKeyDelRequest) Reset() {
+	*x = SshKeyDelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SshKeyDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshKeyDelRequest) ProtoMessage() {}
+
+func (x *SshKeyDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshKeyDelRequest.ProtoReflect.Descriptor instead.
+func (*SshKeyDelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SshKeyDelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SshKeyUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Write *SshKeyWriteRequest `protobuf:"bytes,2,opt,name=write,proto3" json:"write,omitempty"`
+}
+
+func (x *SshKeyUpdateRequest) Reset() {
+	*x = SshKeyUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SshKeyUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshKeyUpdateRequest) ProtoMessage() {}
+
+func (x *SshKeyUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshKeyUpdateRequest.ProtoReflect.Descriptor instead.
+func (*SshKeyUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SshKeyUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SshKeyUpdateRequest) GetWrite() *SshKeyWriteRequest {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+type BinaryListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries    []*ListEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor string       `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // курсор следующей страницы, пусто на последней
+}
+
+func (x *BinaryListResponse) Reset() {
+	*x = BinaryListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryListResponse) ProtoMessage() {}
+
+func (x *BinaryListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryListResponse.ProtoReflect.Descriptor instead.
+func (*BinaryListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BinaryListResponse) GetEntries() []*ListEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *BinaryListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type BinaryReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *BinaryReadRequest) Reset() {
+	*x = BinaryReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryReadRequest) ProtoMessage() {}
+
+func (x *BinaryReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryReadRequest.ProtoReflect.Descriptor instead.
+func (*BinaryReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BinaryReadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BinaryReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size        int64                `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"` // размер открытого содержимого
+	BinId       int64                `protobuf:"varint,4,opt,name=bin_id,json=binId,proto3" json:"bin_id,omitempty"`
+	Notes       string               `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Sha256      []byte               `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`                              // SHA-256 содержимого, пусто - загрузка не завершена
+	StoredSize  int64                `protobuf:"varint,7,opt,name=stored_size,json=storedSize,proto3" json:"stored_size,omitempty"`   // занимаемый содержимым объём
+	Compression string               `protobuf:"bytes,8,opt,name=compression,proto3" json:"compression,omitempty"`                    // сжатие содержимого, пусто - без сжатия
+	ContentType string               `protobuf:"bytes,9,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // тип содержимого
+	Meta        *FileMeta            `protobuf:"bytes,10,opt,name=meta,proto3" json:"meta,omitempty"`                                 // исходные атрибуты файла
+	ExpiresAt   *timestamp.Timestamp `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // время удаления, не задано - бессрочно
+}
+
+func (x *BinaryReadResponse) Reset() {
+	*x = BinaryReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryReadResponse) ProtoMessage() {}
+
+func (x *BinaryReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryReadResponse.ProtoReflect.Descriptor instead.
+func (*BinaryReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BinaryReadResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BinaryReadResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BinaryReadResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BinaryReadResponse) GetBinId() int64 {
+	if x != nil {
+		return x.BinId
+	}
+	return 0
+}
+
+func (x *BinaryReadResponse) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *BinaryReadResponse) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+func (x *BinaryReadResponse) GetStoredSize() int64 {
+	if x != nil {
+		return x.StoredSize
+	}
+	return 0
+}
+
+func (x *BinaryReadResponse) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *BinaryReadResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *BinaryReadResponse) GetMeta() *FileMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *BinaryReadResponse) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// FileMeta исходные атрибуты файла, передаваемые клиентом
+type FileMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string               `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Mtime    *timestamp.Timestamp `protobuf:"bytes,2,opt,name=mtime,proto3" json:"mtime,omitempty"` // время изменения
+	Mode     uint32               `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`  // права доступа
+}
+
+func (x *FileMeta) Reset() {
+	*x = FileMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileMeta) ProtoMessage() {}
+
+func (x *FileMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileMeta.ProtoReflect.Descriptor instead.
+func (*FileMeta) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *FileMeta) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *FileMeta) GetMtime() *timestamp.Timestamp {
+	if x != nil {
+		return x.Mtime
+	}
+	return nil
+}
+
+func (x *FileMeta) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type BinaryWriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size  int64     `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Notes string    `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Meta  *FileMeta `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	// время удаления, не задано - бессрочно; при обновлении задаётся заново
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *BinaryWriteRequest) Reset() {
+	*x = BinaryWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryWriteRequest) ProtoMessage() {}
+
+func (x *BinaryWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryWriteRequest.ProtoReflect.Descriptor instead.
+func (*BinaryWriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BinaryWriteRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BinaryWriteRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BinaryWriteRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *BinaryWriteRequest) GetMeta() *FileMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *BinaryWriteRequest) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type BinaryDelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *BinaryDelRequest) Reset() {
+	*x = BinaryDelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryDelRequest) ProtoMessage() {}
+
+func (x *BinaryDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryDelRequest.ProtoReflect.Descriptor instead.
+func (*BinaryDelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BinaryDelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BinaryUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BinId int64               `protobuf:"varint,2,opt,name=bin_id,json=binId,proto3" json:"bin_id,omitempty"`
+	Write *BinaryWriteRequest `protobuf:"bytes,3,opt,name=write,proto3" json:"write,omitempty"`
+}
+
+func (x *BinaryUpdateRequest) Reset() {
+	*x = BinaryUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryUpdateRequest) ProtoMessage() {}
+
+func (x *BinaryUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryUpdateRequest.ProtoReflect.Descriptor instead.
+func (*BinaryUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BinaryUpdateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BinaryUpdateRequest) GetBinId() int64 {
+	if x != nil {
+		return x.BinId
+	}
+	return 0
+}
+
+func (x *BinaryUpdateRequest) GetWrite() *BinaryWriteRequest {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+// BinaryUplodStream фрагмент загрузки. Смещение учитывается в первом
+// сообщении потока, последующие фрагменты пишутся следом за ним.
+// Пустой фрагмент допустим: им завершается уже загруженный файл.
+// Продолжать загрузку можно только с записанного объёма.
+type BinaryUplodStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Chunk  []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Offset int64  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// SHA-256 всего файла, учитывается в первом сообщении потока:
+	// при несовпадении с вычисленным сервером загрузка не завершается
+	Sha256 []byte `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// сжатие нового содержимого: "" или "zstd", учитывается в первом
+	// сообщении потока с нулевым смещением; несжимаемые блоки хранятся как есть
+	Compression string `protobuf:"bytes,6,opt,name=compression,proto3" json:"compression,omitempty"`
+}
+
+func (x *BinaryUplodStream) Reset() {
+	*x = BinaryUplodStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryUplodStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryUplodStream) ProtoMessage() {}
+
+func (x *BinaryUplodStream) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryUplodStream.ProtoReflect.Descriptor instead.
+func (*BinaryUplodStream) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BinaryUplodStream) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BinaryUplodStream) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *BinaryUplodStream) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BinaryUplodStream) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+func (x *BinaryUplodStream) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+// BinaryPutHeader описание создаваемого файла, первое сообщение BinaryPut
+type BinaryPutHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Notes string `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	Size  int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// тип содержимого, пусто - определяется сервером по первому фрагменту
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// SHA-256 содержимого, при несовпадении файл не создаётся
+	Sha256      []byte    `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Compression string    `protobuf:"bytes,6,opt,name=compression,proto3" json:"compression,omitempty"`
+	Meta        *FileMeta `protobuf:"bytes,7,opt,name=meta,proto3" json:"meta,omitempty"`
+	// время удаления, не задано - бессрочно
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *BinaryPutHeader) Reset() {
+	*x = BinaryPutHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryPutHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryPutHeader) ProtoMessage() {}
+
+func (x *BinaryPutHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryPutHeader.ProtoReflect.Descriptor instead.
+func (*BinaryPutHeader) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BinaryPutHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BinaryPutHeader) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *BinaryPutHeader) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BinaryPutHeader) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *BinaryPutHeader) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+func (x *BinaryPutHeader) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *BinaryPutHeader) GetMeta() *FileMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *BinaryPutHeader) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// BinaryPutStream сообщение BinaryPut: заголовок, затем фрагменты содержимого.
+// Файл появляется, только если поток завершился и получен заявленный размер.
+type BinaryPutStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*BinaryPutStream_Header
+	//	*BinaryPutStream_Chunk
+	Data isBinaryPutStream_Data `protobuf_oneof:"data"`
+}
+
+func (x *BinaryPutStream) Reset() {
+	*x = BinaryPutStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryPutStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryPutStream) ProtoMessage() {}
+
+func (x *BinaryPutStream) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryPutStream.ProtoReflect.Descriptor instead.
+func (*BinaryPutStream) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{58}
+}
+
+func (m *BinaryPutStream) GetData() isBinaryPutStream_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *BinaryPutStream) GetHeader() *BinaryPutHeader {
+	if x, ok := x.GetData().(*BinaryPutStream_Header); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *BinaryPutStream) GetChunk() []byte {
+	if x, ok := x.GetData().(*BinaryPutStream_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isBinaryPutStream_Data interface {
+	isBinaryPutStream_Data()
+}
+
+type BinaryPutStream_Header struct {
+	Header *BinaryPutHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type BinaryPutStream_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*BinaryPutStream_Header) isBinaryPutStream_Data() {}
+
+func (*BinaryPutStream_Chunk) isBinaryPutStream_Data() {}
+
+type BinaryUploadStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *BinaryUploadStatusRequest) Reset() {
+	*x = BinaryUploadStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryUploadStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryUploadStatusRequest) ProtoMessage() {}
+
+func (x *BinaryUploadStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryUploadStatusRequest.ProtoReflect.Descriptor instead.
+func (*BinaryUploadStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BinaryUploadStatusRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BinaryUploadStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Committed int64 `protobuf:"varint,1,opt,name=committed,proto3" json:"committed,omitempty"` // записано байт
+	Size      int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`           // заявленный размер
+	Uploaded  bool  `protobuf:"varint,3,opt,name=uploaded,proto3" json:"uploaded,omitempty"`   // загрузка завершена
+}
+
+func (x *BinaryUploadStatusResponse) Reset() {
+	*x = BinaryUploadStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryUploadStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryUploadStatusResponse) ProtoMessage() {}
+
+func (x *BinaryUploadStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryUploadStatusResponse.ProtoReflect.Descriptor instead.
+func (*BinaryUploadStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BinaryUploadStatusResponse) GetCommitted() int64 {
+	if x != nil {
+		return x.Committed
+	}
+	return 0
+}
+
+func (x *BinaryUploadStatusResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BinaryUploadStatusResponse) GetUploaded() bool {
+	if x != nil {
+		return x.Uploaded
+	}
+	return false
+}
+
+// BidaryDownloadRequest выгрузка содержимого с offset, не более length байт.
+// Нулевая длина - до конца файла.
+type BidaryDownloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length int64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	// размер фрагмента, 0 - по умолчанию; приводится к пределам сервера
+	ChunkSize int32 `protobuf:"varint,4,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+}
+
+func (x *BidaryDownloadRequest) Reset() {
+	*x = BidaryDownloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidaryDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidaryDownloadRequest) ProtoMessage() {}
+
+func (x *BidaryDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BidaryDownloadRequest.ProtoReflect.Descriptor instead.
+func (*BidaryDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *BidaryDownloadRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BidaryDownloadRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BidaryDownloadRequest) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *BidaryDownloadRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type BinaryDownloadStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *BinaryDownloadStream) Reset() {
+	*x = BinaryDownloadStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryDownloadStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryDownloadStream) ProtoMessage() {}
+
+func (x *BinaryDownloadStream) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryDownloadStream.ProtoReflect.Descriptor instead.
+func (*BinaryDownloadStream) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *BinaryDownloadStream) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// BinaryTransferRequest желаемые параметры передачи, 0 - по умолчанию
+type BinaryTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkSize int32 `protobuf:"varint,1,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	Parts     int32 `protobuf:"varint,2,opt,name=parts,proto3" json:"parts,omitempty"`
+}
+
+func (x *BinaryTransferRequest) Reset() {
+	*x = BinaryTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryTransferRequest) ProtoMessage() {}
+
+func (x *BinaryTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryTransferRequest.ProtoReflect.Descriptor instead.
+func (*BinaryTransferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *BinaryTransferRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *BinaryTransferRequest) GetParts() int32 {
+	if x != nil {
+		return x.Parts
+	}
+	return 0
+}
+
+// BinaryTransferResponse параметры передачи в пределах сервера
+type BinaryTransferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkSize    int32 `protobuf:"varint,1,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`            // размер фрагмента
+	Parts        int32 `protobuf:"varint,2,opt,name=parts,proto3" json:"parts,omitempty"`                                     // наибольшее число параллельных частей загрузки
+	BlockSize    int32 `protobuf:"varint,3,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`            // части начинаются со смещения, кратного размеру блока
+	MaxChunkSize int32 `protobuf:"varint,4,opt,name=max_chunk_size,json=maxChunkSize,proto3" json:"max_chunk_size,omitempty"` // наибольший размер фрагмента
+}
+
+func (x *BinaryTransferResponse) Reset() {
+	*x = BinaryTransferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryTransferResponse) ProtoMessage() {}
+
+func (x *BinaryTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryTransferResponse.ProtoReflect.Descriptor instead.
+func (*BinaryTransferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *BinaryTransferResponse) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *BinaryTransferResponse) GetParts() int32 {
+	if x != nil {
+		return x.Parts
+	}
+	return 0
+}
+
+func (x *BinaryTransferResponse) GetBlockSize() int32 {
+	if x != nil {
+		return x.BlockSize
+	}
+	return 0
+}
+
+func (x *BinaryTransferResponse) GetMaxChunkSize() int32 {
+	if x != nil {
+		return x.MaxChunkSize
+	}
+	return 0
+}
+
+// BinaryPartsBeginRequest новое содержимое загружается частями: прежнее
+// и незавершённые части отбрасываются
+type BinaryPartsBeginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Compression string `protobuf:"bytes,2,opt,name=compression,proto3" json:"compression,omitempty"`
+}
+
+func (x *BinaryPartsBeginRequest) Reset() {
+	*x = BinaryPartsBeginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryPartsBeginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryPartsBeginRequest) ProtoMessage() {}
+
+func (x *BinaryPartsBeginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryPartsBeginRequest.ProtoReflect.Descriptor instead.
+func (*BinaryPartsBeginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *BinaryPartsBeginRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BinaryPartsBeginRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+// BinaryUploadPartStream фрагмент части. Смещение части учитывается
+// в первом сообщении потока и кратно размеру блока. Часть сохраняется,
+// только если поток завершился; повторная загрузка с того же смещения
+// заменяет часть. Последний неполный блок допустим только в конце файла.
+type BinaryUploadPartStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Chunk  []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *BinaryUploadPartStream) Reset() {
+	*x = BinaryUploadPartStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryUploadPartStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryUploadPartStream) ProtoMessage() {}
+
+func (x *BinaryUploadPartStream) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryUploadPartStream.ProtoReflect.Descriptor instead.
+func (*BinaryUploadPartStream) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *BinaryUploadPartStream) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BinaryUploadPartStream) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BinaryUploadPartStream) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// BinaryPartsCompleteRequest сборка частей, которые должны покрывать
+// заявленный размер без пропусков. При несовпадении дайджеста файл
+// остаётся незавершённым.
+type BinaryPartsCompleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sha256 []byte `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *BinaryPartsCompleteRequest) Reset() {
+	*x = BinaryPartsCompleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryPartsCompleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryPartsCompleteRequest) ProtoMessage() {}
+
+func (x *BinaryPartsCompleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryPartsCompleteRequest.ProtoReflect.Descriptor instead.
+func (*BinaryPartsCompleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BinaryPartsCompleteRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BinaryPartsCompleteRequest) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+type RenameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NewName string `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+}
+
+func (x *RenameRequest) Reset() {
+	*x = RenameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRequest) ProtoMessage() {}
+
+func (x *RenameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRequest.ProtoReflect.Descriptor instead.
+func (*RenameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RenameRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RenameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type TagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *TagRequest) Reset() {
+	*x = TagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagRequest) ProtoMessage() {}
+
+func (x *TagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagRequest.ProtoReflect.Descriptor instead.
+func (*TagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TagRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *TagListResponse) Reset() {
+	*x = TagListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagListResponse) ProtoMessage() {}
+
+func (x *TagListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagListResponse.ProtoReflect.Descriptor instead.
+func (*TagListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TagListResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type FolderMoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind   string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Folder string `protobuf:"bytes,3,opt,name=folder,proto3" json:"folder,omitempty"` // путь через "/", пусто - корень
+}
+
+func (x *FolderMoveRequest) Reset() {
+	*x = FolderMoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FolderMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderMoveRequest) ProtoMessage() {}
+
+func (x *FolderMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderMoveRequest.ProtoReflect.Descriptor instead.
+func (*FolderMoveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FolderMoveRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FolderMoveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FolderMoveRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+type FolderListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folders []string `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"` // пути папок в порядке сортировки
+}
+
+func (x *FolderListResponse) Reset() {
+	*x = FolderListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FolderListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderListResponse) ProtoMessage() {}
+
+func (x *FolderListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderListResponse.ProtoReflect.Descriptor instead.
+func (*FolderListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *FolderListResponse) GetFolders() []string {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type FolderDelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folder string `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+}
+
+func (x *FolderDelRequest) Reset() {
+	*x = FolderDelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FolderDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderDelRequest) ProtoMessage() {}
+
+func (x *FolderDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderDelRequest.ProtoReflect.Descriptor instead.
+func (*FolderDelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *FolderDelRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0 - по умолчанию
+	Kinds []string `protobuf:"bytes,3,rep,name=kinds,proto3" json:"kinds,omitempty"`  // пусто - все виды
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    string  `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name    string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Score   float32 `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`   // релевантность от 0 до 1
+	Matched string  `protobuf:"bytes,4,opt,name=matched,proto3" json:"matched,omitempty"` // поле совпадения: name, tag, url, username, type, issuer, comment, fingerprint
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SearchResult) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SearchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchResult) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetMatched() string {
+	if x != nil {
+		return x.Matched
+	}
+	return ""
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // по убыванию релевантности
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type WatchEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                      // вид данных: password, card, note, binary, item, otp, ssh
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                      // наименование элемента
+	Action   string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`                  // действие: create, update, delete, rename
+	Revision int64  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`             // номер ревизии хранилища пользователя
+	OldName  string `protobuf:"bytes,5,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"` // прежнее наименование при переименовании
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *WatchEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *WatchEvent) GetOldName() string {
+	if x != nil {
+		return x.OldName
+	}
+	return ""
+}
+
+type BatchOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Op:
+	//	*BatchOperation_PasswordWrite
+	//	*BatchOperation_PasswordUpdate
+	//	*BatchOperation_PasswordDelete
+	//	*BatchOperation_CardWrite
+	//	*BatchOperation_CardUpdate
+	//	*BatchOperation_CardDelete
+	//	*BatchOperation_NoteWrite
+	//	*BatchOperation_NoteUpdate
+	//	*BatchOperation_NoteDelete
+	//	*BatchOperation_ItemWrite
+	//	*BatchOperation_ItemUpdate
+	//	*BatchOperation_ItemDelete
+	//	*BatchOperation_OtpWrite
+	//	*BatchOperation_OtpUpdate
+	//	*BatchOperation_OtpDelete
+	//	*BatchOperation_SshKeyWrite
+	//	*BatchOperation_SshKeyUpdate
+	//	*BatchOperation_SshKeyDelete
+	Op isBatchOperation_Op `protobuf_oneof:"op"`
+}
+
+func (x *BatchOperation) Reset() {
+	*x = BatchOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperation) ProtoMessage() {}
+
+func (x *BatchOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperation.ProtoReflect.Descriptor instead.
+func (*BatchOperation) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{78}
+}
+
+func (m *BatchOperation) GetOp() isBatchOperation_Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetPasswordWrite() *PasswordWriteRequest {
+	if x, ok := x.GetOp().(*BatchOperation_PasswordWrite); ok {
+		return x.PasswordWrite
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetPasswordUpdate() *PasswordUpdateRequest {
+	if x, ok := x.GetOp().(*BatchOperation_PasswordUpdate); ok {
+		return x.PasswordUpdate
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetPasswordDelete() *PasswordDelRequest {
+	if x, ok := x.GetOp().(*BatchOperation_PasswordDelete); ok {
+		return x.PasswordDelete
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetCardWrite() *CardWriteRequest {
+	if x, ok := x.GetOp().(*BatchOperation_CardWrite); ok {
+		return x.CardWrite
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetCardUpdate() *CardUpdateRequest {
+	if x, ok := x.GetOp().(*BatchOperation_CardUpdate); ok {
+		return x.CardUpdate
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetCardDelete() *CardDelRequest {
+	if x, ok := x.GetOp().(*BatchOperation_CardDelete); ok {
+		return x.CardDelete
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetNoteWrite() *NoteWriteRequest {
+	if x, ok := x.GetOp().(*BatchOperation_NoteWrite); ok {
+		return x.NoteWrite
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetNoteUpdate() *NoteUpdateRequest {
+	if x, ok := x.GetOp().(*BatchOperation_NoteUpdate); ok {
+		return x.NoteUpdate
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetNoteDelete() *NoteDelRequest {
+	if x, ok := x.GetOp().(*BatchOperation_NoteDelete); ok {
+		return x.NoteDelete
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetItemWrite() *ItemWriteRequest {
+	if x, ok := x.GetOp().(*BatchOperation_ItemWrite); ok {
+		return x.ItemWrite
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetItemUpdate() *ItemUpdateRequest {
+	if x, ok := x.GetOp().(*BatchOperation_ItemUpdate); ok {
+		return x.ItemUpdate
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetItemDelete() *ItemDelRequest {
+	if x, ok := x.GetOp().(*BatchOperation_ItemDelete); ok {
+		return x.ItemDelete
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetOtpWrite() *OtpWriteRequest {
+	if x, ok := x.GetOp().(*BatchOperation_OtpWrite); ok {
+		return x.OtpWrite
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetOtpUpdate() *OtpUpdateRequest {
+	if x, ok := x.GetOp().(*BatchOperation_OtpUpdate); ok {
+		return x.OtpUpdate
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetOtpDelete() *OtpDelRequest {
+	if x, ok := x.GetOp().(*BatchOperation_OtpDelete); ok {
+		return x.OtpDelete
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetSshKeyWrite() *SshKeyWriteRequest {
+	if x, ok := x.GetOp().(*BatchOperation_SshKeyWrite); ok {
+		return x.SshKeyWrite
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetSshKeyUpdate() *SshKeyUpdateRequest {
+	if x, ok := x.GetOp().(*BatchOperation_SshKeyUpdate); ok {
+		return x.SshKeyUpdate
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetSshKeyDelete() *SshKeyDelRequest {
+	if x, ok := x.GetOp().(*BatchOperation_SshKeyDelete); ok {
+		return x.SshKeyDelete
+	}
+	return nil
+}
+
+type isBatchOperation_Op interface {
+	isBatchOperation_Op()
+}
+
+type BatchOperation_PasswordWrite struct {
+	PasswordWrite *PasswordWriteRequest `protobuf:"bytes,1,opt,name=password_write,json=passwordWrite,proto3,oneof"`
+}
+
+type BatchOperation_PasswordUpdate struct {
+	PasswordUpdate *PasswordUpdateRequest `protobuf:"bytes,2,opt,name=password_update,json=passwordUpdate,proto3,oneof"`
+}
+
+type BatchOperation_PasswordDelete struct {
+	PasswordDelete *PasswordDelRequest `protobuf:"bytes,3,opt,name=password_delete,json=passwordDelete,proto3,oneof"`
+}
+
+type BatchOperation_CardWrite struct {
+	CardWrite *CardWriteRequest `protobuf:"bytes,4,opt,name=card_write,json=cardWrite,proto3,oneof"`
+}
+
+type BatchOperation_CardUpdate struct {
+	CardUpdate *CardUpdateRequest `protobuf:"bytes,5,opt,name=card_update,json=cardUpdate,proto3,oneof"`
+}
+
+type BatchOperation_CardDelete struct {
+	CardDelete *CardDelRequest `protobuf:"bytes,6,opt,name=card_delete,json=cardDelete,proto3,oneof"`
+}
+
+type BatchOperation_NoteWrite struct {
+	NoteWrite *NoteWriteRequest `protobuf:"bytes,7,opt,name=note_write,json=noteWrite,proto3,oneof"`
+}
+
+type BatchOperation_NoteUpdate struct {
+	NoteUpdate *NoteUpdateRequest `protobuf:"bytes,8,opt,name=note_update,json=noteUpdate,proto3,oneof"`
+}
+
+type BatchOperation_NoteDelete struct {
+	NoteDelete *NoteDelRequest `protobuf:"bytes,9,opt,name=note_delete,json=noteDelete,proto3,oneof"`
+}
+
+type BatchOperation_ItemWrite struct {
+	ItemWrite *ItemWriteRequest `protobuf:"bytes,10,opt,name=item_write,json=itemWrite,proto3,oneof"`
+}
+
+type BatchOperation_ItemUpdate struct {
+	ItemUpdate *ItemUpdateRequest `protobuf:"bytes,11,opt,name=item_update,json=itemUpdate,proto3,oneof"`
+}
+
+type BatchOperation_ItemDelete struct {
+	ItemDelete *ItemDelRequest `protobuf:"bytes,12,opt,name=item_delete,json=itemDelete,proto3,oneof"`
+}
+
+type BatchOperation_OtpWrite struct {
+	OtpWrite *OtpWriteRequest `protobuf:"bytes,13,opt,name=otp_write,json=otpWrite,proto3,oneof"`
+}
+
+type BatchOperation_OtpUpdate struct {
+	OtpUpdate *OtpUpdateRequest `protobuf:"bytes,14,opt,name=otp_update,json=otpUpdate,proto3,oneof"`
+}
+
+type BatchOperation_OtpDelete struct {
+	OtpDelete *OtpDelRequest `protobuf:"bytes,15,opt,name=otp_delete,json=otpDelete,proto3,oneof"`
+}
+
+type BatchOperation_SshKeyWrite struct {
+	SshKeyWrite *SshKeyWriteRequest `protobuf:"bytes,16,opt,name=ssh_key_write,json=sshKeyWrite,proto3,oneof"`
+}
+
+type BatchOperation_SshKeyUpdate struct {
+	SshKeyUpdate *SshKeyUpdateRequest `protobuf:"bytes,17,opt,name=ssh_key_update,json=sshKeyUpdate,proto3,oneof"`
+}
+
+type BatchOperation_SshKeyDelete struct {
+	SshKeyDelete *SshKeyDelRequest `protobuf:"bytes,18,opt,name=ssh_key_delete,json=sshKeyDelete,proto3,oneof"`
+}
+
+func (*BatchOperation_PasswordWrite) isBatchOperation_Op() {}
+
+func (*BatchOperation_PasswordUpdate) isBatchOperation_Op() {}
+
+func (*BatchOperation_PasswordDelete) isBatchOperation_Op() {}
+
+func (*BatchOperation_CardWrite) isBatchOperation_Op() {}
+
+func (*BatchOperation_CardUpdate) isBatchOperation_Op() {}
+
+func (*BatchOperation_CardDelete) isBatchOperation_Op() {}
+
+func (*BatchOperation_NoteWrite) isBatchOperation_Op() {}
+
+func (*BatchOperation_NoteUpdate) isBatchOperation_Op() {}
+
+func (*BatchOperation_NoteDelete) isBatchOperation_Op() {}
+
+func (*BatchOperation_ItemWrite) isBatchOperation_Op() {}
+
+func (*BatchOperation_ItemUpdate) isBatchOperation_Op() {}
+
+func (*BatchOperation_ItemDelete) isBatchOperation_Op() {}
+
+func (*BatchOperation_OtpWrite) isBatchOperation_Op() {}
+
+func (*BatchOperation_OtpUpdate) isBatchOperation_Op() {}
+
+func (*BatchOperation_OtpDelete) isBatchOperation_Op() {}
+
+func (*BatchOperation_SshKeyWrite) isBatchOperation_Op() {}
+
+func (*BatchOperation_SshKeyUpdate) isBatchOperation_Op() {}
+
+func (*BatchOperation_SshKeyDelete) isBatchOperation_Op() {}
+
+type BatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations []*BatchOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	BestEffort bool              `protobuf:"varint,2,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"` // выполнить успешные операции, пропустив ошибочные
+}
+
+func (x *BatchRequest) Reset() {
+	*x = BatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRequest) ProtoMessage() {}
+
+func (x *BatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRequest.ProtoReflect.Descriptor instead.
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BatchRequest) GetOperations() []*BatchOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *BatchRequest) GetBestEffort() bool {
+	if x != nil {
+		return x.BestEffort
+	}
+	return false
+}
+
+type BatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // код статуса gRPC выполнения операции
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // описание ошибки
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *BatchResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results   []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`      // результаты в порядке операций запроса
+	Committed bool           `protobuf:"varint,2,opt,name=committed,proto3" json:"committed,omitempty"` // изменения сохранены
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_gophkeeper_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_gophkeeper_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BatchResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+var File_proto_v1_gophkeeper_proto protoreflect.FileDescriptor
+
+var file_proto_v1_gophkeeper_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x70, 0x68, 0x6b,
+	0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67, 0x6f, 0x70,
+	0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x57, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x28, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x54, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x25, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xda, 0x02, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x69, 0x6e, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x74, 0x70, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6f, 0x74, 0x70,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x73, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x06,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0xa1, 0x02, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74,
+	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x1f, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x18, 0x40, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1b, 0x0a, 0x04, 0x67,
+	0x6c, 0x6f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x18, 0x40, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x12, 0x19, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x03,
+	0x74, 0x61, 0x67, 0x12, 0x20, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x06, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69,
+	0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
+	0x69, 0x76, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x71, 0x0a, 0x14, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x34, 0x0a, 0x13, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0xf5, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x98, 0x02, 0x0a, 0x14, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80,
+	0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x04, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x43, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x12, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba,
+	0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x62,
+	0x0a, 0x15, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d,
+	0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x22, 0x6d, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10,
+	0x02, 0x22, 0x30, 0x0a, 0x0f, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x64,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72,
+	0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x18, 0x14, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x03,
+	0x70, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x18, 0x0a, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x43, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x2f, 0x0a, 0x0e, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x11, 0x43, 0x61, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22,
+	0x6d, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x30,
+	0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x87, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x4e,
+	0x6f, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba,
+	0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x43, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x2f, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22,
+	0x84, 0x01, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48,
+	0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x36,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67,
+	0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x20, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f,
+	0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x30, 0x0a, 0x0f, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0xb7, 0x01, 0x0a, 0x10, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x30,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x10,
+	0x49, 0x74, 0x65, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba,
+	0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3a,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02,
+	0x10, 0x40, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xba, 0x48, 0x05, 0xb2,
+	0x01, 0x02, 0x40, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
+	0x2f, 0x0a, 0x0e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x5a, 0x0a, 0x11, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x66, 0x0a, 0x0f,
+	0x4f, 0x74, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x0e, 0x4f, 0x74, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xeb, 0x02, 0x0a, 0x0f, 0x4f, 0x74, 0x70, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d,
+	0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74, 0x70, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0xd1, 0x03, 0x0a, 0x0f, 0x4f, 0x74, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01,
+	0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03,
+	0x18, 0x80, 0x01, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x34,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74, 0x70, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1f, 0x0a, 0x06, 0x64, 0x69, 0x67,
+	0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02,
+	0x18, 0x0a, 0x52, 0x06, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xba, 0x48, 0x05, 0x2a,
+	0x03, 0x18, 0x90, 0x1c, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xba, 0x48, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x10, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x2e, 0x0a, 0x0d, 0x4f, 0x74, 0x70, 0x44, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18,
+	0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x4f, 0x74, 0x70, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x70,
+	0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74, 0x70, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x22, 0x2f, 0x0a, 0x0e, 0x4f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x5d, 0x0a, 0x0f, 0x4f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x22, 0x69, 0x0a, 0x12, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65,
+	0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x32, 0x0a, 0x11,
+	0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xb2, 0x02, 0x0a, 0x12, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x70, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x69,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x69, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xe5, 0x02, 0x0a, 0x12, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72,
+	0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05,
+	0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x62, 0x69,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xba, 0x48, 0x05, 0x2a, 0x03, 0x18,
+	0x80, 0x40, 0x52, 0x04, 0x62, 0x69, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xba, 0x48, 0x05, 0xb2, 0x01, 0x02,
+	0x40, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x31, 0x0a,
+	0x10, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x5e, 0x0a, 0x13, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d,
+	0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x22, 0x6f, 0x0a, 0x12, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x01, 0x10,
+	0x02, 0x22, 0x32, 0x0a, 0x11, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xdf, 0x02, 0x0a, 0x12, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2b, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x6d, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xba, 0x48, 0x05, 0x2a,
+	0x03, 0x18, 0xff, 0x1f, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x12, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x31, 0x0a, 0x10, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01,
+	0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7e, 0x0a, 0x13, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x37, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x11, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x17,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1f, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x22,
+	0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0xd0, 0x01, 0x01, 0x7a, 0x02, 0x68, 0x20, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x12, 0x2f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0x72, 0x08, 0x52, 0x00,
+	0x52, 0x04, 0x7a, 0x73, 0x74, 0x64, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xd7, 0x02, 0x0a, 0x0f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x75,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+	0x02, 0x28, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xd0, 0x01, 0x01, 0x7a, 0x02,
+	0x68, 0x20, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x2f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0xba, 0x48, 0x0a, 0x72, 0x08, 0x52, 0x00, 0x52, 0x04, 0x7a, 0x73, 0x74, 0x64, 0x52, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x70, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xba, 0x48, 0x05, 0xb2, 0x01, 0x02,
+	0x40, 0x01, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x72, 0x0a,
+	0x0f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x38, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x75, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x42, 0x0d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08,
+	0x01, 0x22, 0x34, 0x0a, 0x19, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6a, 0x0a, 0x1a, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x42, 0x69, 0x64, 0x61, 0x72, 0x79, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1f,
+	0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x26, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x09, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x2c, 0x0a, 0x14, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x5e, 0x0a, 0x15, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x09, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x16, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x63, 0x0a, 0x17, 0x42, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x73, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0x72, 0x08, 0x52, 0x00, 0x52, 0x04, 0x7a, 0x73,
+	0x74, 0x64, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x68, 0x0a, 0x16, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x59, 0x0a, 0x1a, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x22, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0xd0, 0x01, 0x01, 0x7a, 0x02, 0x68, 0x20, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x22, 0x9d, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xba, 0x48, 0x30, 0x72, 0x2e, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x52, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x52,
+	0x03, 0x6f, 0x74, 0x70, 0x52, 0x03, 0x73, 0x73, 0x68, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba,
+	0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24,
+	0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x07, 0x6e, 0x65, 0x77,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0a, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x33, 0xba, 0x48, 0x30, 0x72, 0x2e, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x52, 0x06,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x52, 0x03, 0x6f, 0x74,
+	0x70, 0x52, 0x03, 0x73, 0x73, 0x68, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72,
+	0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xba, 0x48, 0x1a, 0x92, 0x01,
+	0x17, 0x08, 0x01, 0x10, 0x20, 0x22, 0x11, 0x72, 0x0f, 0x10, 0x01, 0x18, 0x40, 0x32, 0x09, 0x5e,
+	0x5b, 0x5e, 0x2c, 0x5c, 0x73, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x25,
+	0x0a, 0x0f, 0x54, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xba, 0x48, 0x30, 0x72, 0x2e,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x52, 0x03, 0x6f, 0x74, 0x70, 0x52, 0x03, 0x73, 0x73, 0x68, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x06, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x22, 0x2e, 0x0a, 0x12, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0x36, 0x0a, 0x10, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x44,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x66, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x22, 0xa2, 0x01,
+	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x4e, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x38, 0xba, 0x48, 0x35, 0x92, 0x01, 0x32, 0x22, 0x30, 0x72, 0x2e, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x52, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x52, 0x03, 0x6f, 0x74, 0x70, 0x52, 0x03, 0x73, 0x73, 0x68, 0x52, 0x05, 0x6b, 0x69, 0x6e,
+	0x64, 0x73, 0x22, 0x66, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22, 0x47, 0x0a, 0x0e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x6c, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x83, 0x0a, 0x0a, 0x0e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0e,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x61, 0x72,
+	0x64, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x63,
+	0x61, 0x72, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x40, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d,
+	0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x65, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6e,
+	0x6f, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x6e, 0x6f, 0x74,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x43, 0x0a,
+	0x0b, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x6f, 0x74, 0x70, 0x5f, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6f, 0x74, 0x70, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x74, 0x70, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x74, 0x70, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x6f, 0x74, 0x70, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x70, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74, 0x70, 0x44, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x74, 0x70, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f,
+	0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x73, 0x68, 0x4b,
+	0x65, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x0b, 0x73, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x4a, 0x0a,
+	0x0e, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x73, 0x68,
+	0x4b, 0x65, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x73, 0x73, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x0b, 0x0a, 0x02, 0x6f, 0x70, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22,
+	0x7b, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4a, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x65, 0x73, 0x74, 0x5f, 0x65, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x45, 0x66, 0x66, 0x6f, 0x72, 0x74, 0x22, 0x3b, 0x0a, 0x0b,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x63, 0x0a, 0x0d, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x2a, 0x4c,
+	0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49,
+	0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x8a, 0x01, 0x0a,
+	0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12,
+	0x15, 0x0a, 0x11, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45,
+	0x43, 0x52, 0x45, 0x54, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x52, 0x4c, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x03,
+	0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4f, 0x54, 0x50, 0x10, 0x05, 0x2a, 0x2f, 0x0a, 0x07, 0x4f, 0x74, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x54, 0x50, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x54, 0x4f, 0x54, 0x50, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x54, 0x50, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x54, 0x50, 0x10, 0x01, 0x2a, 0x5a, 0x0a, 0x0c, 0x4f, 0x74,
+	0x70, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x54,
+	0x50, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x31,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x54, 0x50, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49,
+	0x54, 0x48, 0x4d, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x4f, 0x54, 0x50, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x5f, 0x53, 0x48,
+	0x41, 0x35, 0x31, 0x32, 0x10, 0x02, 0x2a, 0x54, 0x0a, 0x0a, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x53, 0x48, 0x5f, 0x4b, 0x45, 0x59, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x53, 0x53, 0x48, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x53, 0x41, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x53, 0x48, 0x5f, 0x4b, 0x45, 0x59, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x43, 0x44, 0x53, 0x41, 0x10, 0x02, 0x32, 0x87, 0x22, 0x0a,
+	0x0a, 0x47, 0x6f, 0x70, 0x68, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x04, 0x50,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x67, 0x6f,
+	0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1b,
+	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f,
+	0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x70, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d,
+	0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65,
+	0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
+	0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x08, 0x43,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0a, 0x43, 0x61,
+	0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65,
+	0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x4b, 0x0a, 0x08, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1e,
+	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1d, 0x2e,
+	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67,
+	0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x09, 0x4e, 0x6f, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x70,
+	0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x08, 0x4e,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d,
+	0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a,
+	0x08, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x70, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0a,
+	0x49, 0x74, 0x65, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x67, 0x6f, 0x70,
+	0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x61, 0x64,
+	0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x07, 0x4f, 0x74, 0x70, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74,
+	0x70, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
+	0x08, 0x4f, 0x74, 0x70, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x70, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x74, 0x70, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x44, 0x0a, 0x09, 0x4f, 0x74, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x74, 0x70, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x07, 0x4f, 0x74, 0x70, 0x52, 0x65,
+	0x61, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x74, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x74, 0x70, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x09, 0x4f, 0x74, 0x70, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c,
+	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x74, 0x70, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x07, 0x4f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
+	0x0a, 0x0a, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x67,
+	0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65,
+	0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x53,
+	0x73, 0x68, 0x4b, 0x65, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x70,
+	0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x73, 0x68, 0x4b, 0x65,
+	0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x0c, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x51, 0x0a, 0x0a, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a,
+	0x0a, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x67, 0x6f,
+	0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x42, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67,
+	0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x0a,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x12, 0x20, 0x2e, 0x67, 0x6f, 0x70,
+	0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67,
+	0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65,
+	0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55,
+	0x70, 0x6c, 0x6f, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x28, 0x01, 0x12, 0x51, 0x0a, 0x09, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x75,
+	0x74, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x1a, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x69, 0x0a, 0x12, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x2e,
+	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x64, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x6f, 0x70,
+	0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30,
+	0x01, 0x12, 0x5d, 0x0a, 0x0e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x70, 0x68,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x10, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x73, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x73,
+	0x42, 0x65, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x10, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65,
+	0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x58, 0x0a, 0x13, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x29, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e,
+	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x06, 0x54, 0x61, 0x67, 0x41, 0x64, 0x64, 0x12, 0x19, 0x2e,
+	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x3e, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x19, 0x2e,
+	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x41, 0x0a, 0x07, 0x54, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x76,
+	0x65, 0x12, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0a, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a,
+	0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x12, 0x42, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x67, 0x6f,
+	0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65,
+	0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x75, 0x67, 0x65, 0x6e, 0x65, 0x39, 0x38, 0x32, 0x2f, 0x79,
+	0x70, 0x2d, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x5f,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_gophkeeper_proto_rawDescOnce sync.Once
+	file_proto_v1_gophkeeper_proto_rawDescData = file_proto_v1_gophkeeper_proto_rawDesc
+)
+
+func file_proto_v1_gophkeeper_proto_rawDescGZIP() []byte {
+	file_proto_v1_gophkeeper_proto_rawDescOnce.Do(func() {
+		file_proto_v1_gophkeeper_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_gophkeeper_proto_rawDescData)
+	})
+	return file_proto_v1_gophkeeper_proto_rawDescData
+}
+
+var file_proto_v1_gophkeeper_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_v1_gophkeeper_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
+var file_proto_v1_gophkeeper_proto_goTypes = []interface{}{
+	(ListSort)(0),                      // 0: gophermart.v1.ListSort
+	(FieldType)(0),                     // 1: gophermart.v1.FieldType
+	(OtpType)(0),                       // 2: gophermart.v1.OtpType
+	(OtpAlgorithm)(0),                  // 3: gophermart.v1.OtpAlgorithm
+	(SshKeyType)(0),                    // 4: gophermart.v1.SshKeyType
+	(*PingResponse)(nil),               // 5: gophermart.v1.PingResponse
+	(*RegisterRequest)(nil),            // 6: gophermart.v1.RegisterRequest
+	(*RegisterResponse)(nil),           // 7: gophermart.v1.RegisterResponse
+	(*LoginRequest)(nil),               // 8: gophermart.v1.LoginRequest
+	(*LoginResponse)(nil),              // 9: gophermart.v1.LoginResponse
+	(*ListResponse)(nil),               // 10: gophermart.v1.ListResponse
+	(*Limits)(nil),                     // 11: gophermart.v1.Limits
+	(*ListRequest)(nil),                // 12: gophermart.v1.ListRequest
+	(*ListEntry)(nil),                  // 13: gophermart.v1.ListEntry
+	(*PasswordListResponse)(nil),       // 14: gophermart.v1.PasswordListResponse
+	(*PasswordReadRequest)(nil),        // 15: gophermart.v1.PasswordReadRequest
+	(*PasswordReadResponse)(nil),       // 16: gophermart.v1.PasswordReadResponse
+	(*PasswordWriteRequest)(nil),       // 17: gophermart.v1.PasswordWriteRequest
+	(*BinaryWriteResponse)(nil),        // 18: gophermart.v1.BinaryWriteResponse
+	(*PasswordDelRequest)(nil),         // 19: gophermart.v1.PasswordDelRequest
+	(*PasswordUpdateRequest)(nil),      // 20: gophermart.v1.PasswordUpdateRequest
+	(*CardListResponse)(nil),           // 21: gophermart.v1.CardListResponse
+	(*CardReadRequest)(nil),            // 22: gophermart.v1.CardReadRequest
+	(*CardReadResponse)(nil),           // 23: gophermart.v1.CardReadResponse
+	(*CardWriteRequest)(nil),           // 24: gophermart.v1.CardWriteRequest
+	(*CardDelRequest)(nil),             // 25: gophermart.v1.CardDelRequest
+	(*CardUpdateRequest)(nil),          // 26: gophermart.v1.CardUpdateRequest
+	(*NoteListResponse)(nil),           // 27: gophermart.v1.NoteListResponse
+	(*NoteReadRequest)(nil),            // 28: gophermart.v1.NoteReadRequest
+	(*NoteReadResponse)(nil),           // 29: gophermart.v1.NoteReadResponse
+	(*NoteWriteRequest)(nil),           // 30: gophermart.v1.NoteWriteRequest
+	(*NoteDelRequest)(nil),             // 31: gophermart.v1.NoteDelRequest
+	(*NoteUpdateRequest)(nil),          // 32: gophermart.v1.NoteUpdateRequest
+	(*ItemField)(nil),                  // 33: gophermart.v1.ItemField
+	(*ItemListResponse)(nil),           // 34: gophermart.v1.ItemListResponse
+	(*ItemReadRequest)(nil),            // 35: gophermart.v1.ItemReadRequest
+	(*ItemReadResponse)(nil),           // 36: gophermart.v1.ItemReadResponse
+	(*ItemWriteRequest)(nil),           // 37: gophermart.v1.ItemWriteRequest
+	(*ItemDelRequest)(nil),             // 38: gophermart.v1.ItemDelRequest
+	(*ItemUpdateRequest)(nil),          // 39: gophermart.v1.ItemUpdateRequest
+	(*OtpListResponse)(nil),            // 40: gophermart.v1.OtpListResponse
+	(*OtpReadRequest)(nil),             // 41: gophermart.v1.OtpReadRequest
+	(*OtpReadResponse)(nil),            // 42: gophermart.v1.OtpReadResponse
+	(*OtpWriteRequest)(nil),            // 43: gophermart.v1.OtpWriteRequest
+	(*OtpDelRequest)(nil),              // 44: gophermart.v1.OtpDelRequest
+	(*OtpUpdateRequest)(nil),           // 45: gophermart.v1.OtpUpdateRequest
+	(*OtpCodeRequest)(nil),             // 46: gophermart.v1.OtpCodeRequest
+	(*OtpCodeResponse)(nil),            // 47: gophermart.v1.OtpCodeResponse
+	(*SshKeyListResponse)(nil),         // 48: gophermart.v1.SshKeyListResponse
+	(*SshKeyReadRequest)(nil),          // 49: gophermart.v1.SshKeyReadRequest
+	(*SshKeyReadResponse)(nil),         // 50: gophermart.v1.SshKeyReadResponse
+	(*SshKeyWriteRequest)(nil),         // 51: gophermart.v1.SshKeyWriteRequest
+	(*SshKeyDelRequest)(nil),           // 52: gophermart.v1.SshKeyDelRequest
+	(*SshKeyUpdateRequest)(nil),        // 53: gophermart.v1.SshKeyUpdateRequest
+	(*BinaryListResponse)(nil),         // 54: gophermart.v1.BinaryListResponse
+	(*BinaryReadRequest)(nil),          // 55: gophermart.v1.BinaryReadRequest
+	(*BinaryReadResponse)(nil),         // 56: gophermart.v1.BinaryReadResponse
+	(*FileMeta)(nil),                   // 57: gophermart.v1.FileMeta
+	(*BinaryWriteRequest)(nil),         // 58: gophermart.v1.BinaryWriteRequest
+	(*BinaryDelRequest)(nil),           // 59: gophermart.v1.BinaryDelRequest
+	(*BinaryUpdateRequest)(nil),        // 60: gophermart.v1.BinaryUpdateRequest
+	(*BinaryUplodStream)(nil),          // 61: gophermart.v1.BinaryUplodStream
+	(*BinaryPutHeader)(nil),            // 62: gophermart.v1.BinaryPutHeader
+	(*BinaryPutStream)(nil),            // 63: gophermart.v1.BinaryPutStream
+	(*BinaryUploadStatusRequest)(nil),  // 64: gophermart.v1.BinaryUploadStatusRequest
+	(*BinaryUploadStatusResponse)(nil), // 65: gophermart.v1.BinaryUploadStatusResponse
+	(*BidaryDownloadRequest)(nil),      // 66: gophermart.v1.BidaryDownloadRequest
+	(*BinaryDownloadStream)(nil),       // 67: gophermart.v1.BinaryDownloadStream
+	(*BinaryTransferRequest)(nil),      // 68: gophermart.v1.BinaryTransferRequest
+	(*BinaryTransferResponse)(nil),     // 69: gophermart.v1.BinaryTransferResponse
+	(*BinaryPartsBeginRequest)(nil),    // 70: gophermart.v1.BinaryPartsBeginRequest
+	(*BinaryUploadPartStream)(nil),     // 71: gophermart.v1.BinaryUploadPartStream
+	(*BinaryPartsCompleteRequest)(nil), // 72: gophermart.v1.BinaryPartsCompleteRequest
+	(*RenameRequest)(nil),              // 73: gophermart.v1.RenameRequest
+	(*TagRequest)(nil),                 // 74: gophermart.v1.TagRequest
+	(*TagListResponse)(nil),            // 75: gophermart.v1.TagListResponse
+	(*FolderMoveRequest)(nil),          // 76: gophermart.v1.FolderMoveRequest
+	(*FolderListResponse)(nil),         // 77: gophermart.v1.FolderListResponse
+	(*FolderDelRequest)(nil),           // 78: gophermart.v1.FolderDelRequest
+	(*SearchRequest)(nil),              // 79: gophermart.v1.SearchRequest
+	(*SearchResult)(nil),               // 80: gophermart.v1.SearchResult
+	(*SearchResponse)(nil),             // 81: gophermart.v1.SearchResponse
+	(*WatchEvent)(nil),                 // 82: gophermart.v1.WatchEvent
+	(*BatchOperation)(nil),             // 83: gophermart.v1.BatchOperation
+	(*BatchRequest)(nil),               // 84: gophermart.v1.BatchRequest
+	(*BatchResult)(nil),                // 85: gophermart.v1.BatchResult
+	(*BatchResponse)(nil),              // 86: gophermart.v1.BatchResponse
+	(*timestamp.Timestamp)(nil),        // 87: google.protobuf.Timestamp
+	(*empty.Empty)(nil),                // 88: google.protobuf.Empty
+}
+var file_proto_v1_gophkeeper_proto_depIdxs = []int32{
+	11,  // 0: gophermart.v1.ListResponse.limits:type_name -> gophermart.v1.Limits
+	0,   // 1: gophermart.v1.ListRequest.sort:type_name -> gophermart.v1.ListSort
+	87,  // 2: gophermart.v1.ListEntry.created_at:type_name -> google.protobuf.Timestamp
+	87,  // 3: gophermart.v1.ListEntry.updated_at:type_name -> google.protobuf.Timestamp
+	87,  // 4: gophermart.v1.ListEntry.expires_at:type_name -> google.protobuf.Timestamp
+	13,  // 5: gophermart.v1.PasswordListResponse.entries:type_name -> gophermart.v1.ListEntry
+	87,  // 6: gophermart.v1.PasswordReadResponse.expires_at:type_name -> google.protobuf.Timestamp
+	87,  // 7: gophermart.v1.PasswordWriteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	17,  // 8: gophermart.v1.PasswordUpdateRequest.write:type_name -> gophermart.v1.PasswordWriteRequest
+	13,  // 9: gophermart.v1.CardListResponse.entries:type_name -> gophermart.v1.ListEntry
+	87,  // 10: gophermart.v1.CardReadResponse.expires_at:type_name -> google.protobuf.Timestamp
+	87,  // 11: gophermart.v1.CardWriteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	24,  // 12: gophermart.v1.CardUpdateRequest.write:type_name -> gophermart.v1.CardWriteRequest
+	13,  // 13: gophermart.v1.NoteListResponse.entries:type_name -> gophermart.v1.ListEntry
+	87,  // 14: gophermart.v1.NoteReadResponse.expires_at:type_name -> google.protobuf.Timestamp
+	87,  // 15: gophermart.v1.NoteWriteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	30,  // 16: gophermart.v1.NoteUpdateRequest.write:type_name -> gophermart.v1.NoteWriteRequest
+	1,   // 17: gophermart.v1.ItemField.type:type_name -> gophermart.v1.FieldType
+	13,  // 18: gophermart.v1.ItemListResponse.entries:type_name -> gophermart.v1.ListEntry
+	33,  // 19: gophermart.v1.ItemReadResponse.fields:type_name -> gophermart.v1.ItemField
+	87,  // 20: gophermart.v1.ItemReadResponse.expires_at:type_name -> google.protobuf.Timestamp
+	33,  // 21: gophermart.v1.ItemWriteRequest.fields:type_name -> gophermart.v1.ItemField
+	87,  // 22: gophermart.v1.ItemWriteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	37,  // 23: gophermart.v1.ItemUpdateRequest.write:type_name -> gophermart.v1.ItemWriteRequest
+	13,  // 24: gophermart.v1.OtpListResponse.entries:type_name -> gophermart.v1.ListEntry
+	2,   // 25: gophermart.v1.OtpReadResponse.type:type_name -> gophermart.v1.OtpType
+	3,   // 26: gophermart.v1.OtpReadResponse.algorithm:type_name -> gophermart.v1.OtpAlgorithm
+	87,  // 27: gophermart.v1.OtpReadResponse.expires_at:type_name -> google.protobuf.Timestamp
+	2,   // 28: gophermart.v1.OtpWriteRequest.type:type_name -> gophermart.v1.OtpType
+	3,   // 29: gophermart.v1.OtpWriteRequest.algorithm:type_name -> gophermart.v1.OtpAlgorithm
+	87,  // 30: gophermart.v1.OtpWriteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	43,  // 31: gophermart.v1.OtpUpdateRequest.write:type_name -> gophermart.v1.OtpWriteRequest
+	13,  // 32: gophermart.v1.SshKeyListResponse.entries:type_name -> gophermart.v1.ListEntry
+	4,   // 33: gophermart.v1.SshKeyReadResponse.type:type_name -> gophermart.v1.SshKeyType
+	87,  // 34: gophermart.v1.SshKeyReadResponse.expires_at:type_name -> google.protobuf.Timestamp
+	4,   // 35: gophermart.v1.SshKeyWriteRequest.type:type_name -> gophermart.v1.SshKeyType
+	87,  // 36: gophermart.v1.SshKeyWriteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	51,  // 37: gophermart.v1.SshKeyUpdateRequest.write:type_name -> gophermart.v1.SshKeyWriteRequest
+	13,  // 38: gophermart.v1.BinaryListResponse.entries:type_name -> gophermart.v1.ListEntry
+	57,  // 39: gophermart.v1.BinaryReadResponse.meta:type_name -> gophermart.v1.FileMeta
+	87,  // 40: gophermart.v1.BinaryReadResponse.expires_at:type_name -> google.protobuf.Timestamp
+	87,  // 41: gophermart.v1.FileMeta.mtime:type_name -> google.protobuf.Timestamp
+	57,  // 42: gophermart.v1.BinaryWriteRequest.meta:type_name -> gophermart.v1.FileMeta
+	87,  // 43: gophermart.v1.BinaryWriteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	58,  // 44: gophermart.v1.BinaryUpdateRequest.write:type_name -> gophermart.v1.BinaryWriteRequest
+	57,  // 45: gophermart.v1.BinaryPutHeader.meta:type_name -> gophermart.v1.FileMeta
+	87,  // 46: gophermart.v1.BinaryPutHeader.expires_at:type_name -> google.protobuf.Timestamp
+	62,  // 47: gophermart.v1.BinaryPutStream.header:type_name -> gophermart.v1.BinaryPutHeader
+	80,  // 48: gophermart.v1.SearchResponse.results:type_name -> gophermart.v1.SearchResult
+	17,  // 49: gophermart.v1.BatchOperation.password_write:type_name -> gophermart.v1.PasswordWriteRequest
+	20,  // 50: gophermart.v1.BatchOperation.password_update:type_name -> gophermart.v1.PasswordUpdateRequest
+	19,  // 51: gophermart.v1.BatchOperation.password_delete:type_name -> gophermart.v1.PasswordDelRequest
+	24,  // 52: gophermart.v1.BatchOperation.card_write:type_name -> gophermart.v1.CardWriteRequest
+	26,  // 53: gophermart.v1.BatchOperation.card_update:type_name -> gophermart.v1.CardUpdateRequest
+	25,  // 54: gophermart.v1.BatchOperation.card_delete:type_name -> gophermart.v1.CardDelRequest
+	30,  // 55: gophermart.v1.BatchOperation.note_write:type_name -> gophermart.v1.NoteWriteRequest
+	32,  // 56: gophermart.v1.BatchOperation.note_update:type_name -> gophermart.v1.NoteUpdateRequest
+	31,  // 57: gophermart.v1.BatchOperation.note_delete:type_name -> gophermart.v1.NoteDelRequest
+	37,  // 58: gophermart.v1.BatchOperation.item_write:type_name -> gophermart.v1.ItemWriteRequest
+	39,  // 59: gophermart.v1.BatchOperation.item_update:type_name -> gophermart.v1.ItemUpdateRequest
+	38,  // 60: gophermart.v1.BatchOperation.item_delete:type_name -> gophermart.v1.ItemDelRequest
+	43,  // 61: gophermart.v1.BatchOperation.otp_write:type_name -> gophermart.v1.OtpWriteRequest
+	45,  // 62: gophermart.v1.BatchOperation.otp_update:type_name -> gophermart.v1.OtpUpdateRequest
+	44,  // 63: gophermart.v1.BatchOperation.otp_delete:type_name -> gophermart.v1.OtpDelRequest
+	51,  // 64: gophermart.v1.BatchOperation.ssh_key_write:type_name -> gophermart.v1.SshKeyWriteRequest
+	53,  // 65: gophermart.v1.BatchOperation.ssh_key_update:type_name -> gophermart.v1.SshKeyUpdateRequest
+	52,  // 66: gophermart.v1.BatchOperation.ssh_key_delete:type_name -> gophermart.v1.SshKeyDelRequest
+	83,  // 67: gophermart.v1.BatchRequest.operations:type_name -> gophermart.v1.BatchOperation
+	85,  // 68: gophermart.v1.BatchResponse.results:type_name -> gophermart.v1.BatchResult
+	88,  // 69: gophermart.v1.GophKeeper.Ping:input_type -> google.protobuf.Empty
+	6,   // 70: gophermart.v1.GophKeeper.Register:input_type -> gophermart.v1.RegisterRequest
+	8,   // 71: gophermart.v1.GophKeeper.Login:input_type -> gophermart.v1.LoginRequest
+	88,  // 72: gophermart.v1.GophKeeper.List:input_type -> google.protobuf.Empty
+	12,  // 73: gophermart.v1.GophKeeper.PasswordList:input_type -> gophermart.v1.ListRequest
+	17,  // 74: gophermart.v1.GophKeeper.PasswordWrite:input_type -> gophermart.v1.PasswordWriteRequest
+	20,  // 75: gophermart.v1.GophKeeper.PasswordUpdate:input_type -> gophermart.v1.PasswordUpdateRequest
+	15,  // 76: gophermart.v1.GophKeeper.PasswordRead:input_type -> gophermart.v1.PasswordReadRequest
+	19,  // 77: gophermart.v1.GophKeeper.PasswordDelete:input_type -> gophermart.v1.PasswordDelRequest
+	12,  // 78: gophermart.v1.GophKeeper.CardList:input_type -> gophermart.v1.ListRequest
+	24,  // 79: gophermart.v1.GophKeeper.CardWrite:input_type -> gophermart.v1.CardWriteRequest
+	26,  // 80: gophermart.v1.GophKeeper.CardUpdate:input_type -> gophermart.v1.CardUpdateRequest
+	22,  // 81: gophermart.v1.GophKeeper.CardRead:input_type -> gophermart.v1.CardReadRequest
+	25,  // 82: gophermart.v1.GophKeeper.CardDelete:input_type -> gophermart.v1.CardDelRequest
+	12,  // 83: gophermart.v1.GophKeeper.NoteList:input_type -> gophermart.v1.ListRequest
+	30,  // 84: gophermart.v1.GophKeeper.NoteWrite:input_type -> gophermart.v1.NoteWriteRequest
+	32,  // 85: gophermart.v1.GophKeeper.NoteUpdate:input_type -> gophermart.v1.NoteUpdateRequest
+	28,  // 86: gophermart.v1.GophKeeper.NoteRead:input_type -> gophermart.v1.NoteReadRequest
+	31,  // 87: gophermart.v1.GophKeeper.NoteDelete:input_type -> gophermart.v1.NoteDelRequest
+	12,  // 88: gophermart.v1.GophKeeper.ItemList:input_type -> gophermart.v1.ListRequest
+	37,  // 89: gophermart.v1.GophKeeper.ItemWrite:input_type -> gophermart.v1.ItemWriteRequest
+	39,  // 90: gophermart.v1.GophKeeper.ItemUpdate:input_type -> gophermart.v1.ItemUpdateRequest
+	35,  // 91: gophermart.v1.GophKeeper.ItemRead:input_type -> gophermart.v1.ItemReadRequest
+	38,  // 92: gophermart.v1.GophKeeper.ItemDelete:input_type -> gophermart.v1.ItemDelRequest
+	12,  // 93: gophermart.v1.GophKeeper.OtpList:input_type -> gophermart.v1.ListRequest
+	43,  // 94: gophermart.v1.GophKeeper.OtpWrite:input_type -> gophermart.v1.OtpWriteRequest
+	45,  // 95: gophermart.v1.GophKeeper.OtpUpdate:input_type -> gophermart.v1.OtpUpdateRequest
+	41,  // 96: gophermart.v1.GophKeeper.OtpRead:input_type -> gophermart.v1.OtpReadRequest
+	44,  // 97: gophermart.v1.GophKeeper.OtpDelete:input_type -> gophermart.v1.OtpDelRequest
+	46,  // 98: gophermart.v1.GophKeeper.OtpCode:input_type -> gophermart.v1.OtpCodeRequest
+	12,  // 99: gophermart.v1.GophKeeper.SshKeyList:input_type -> gophermart.v1.ListRequest
+	51,  // 100: gophermart.v1.GophKeeper.SshKeyWrite:input_type -> gophermart.v1.SshKeyWriteRequest
+	53,  // 101: gophermart.v1.GophKeeper.SshKeyUpdate:input_type -> gophermart.v1.SshKeyUpdateRequest
+	49,  // 102: gophermart.v1.GophKeeper.SshKeyRead:input_type -> gophermart.v1.SshKeyReadRequest
+	52,  // 103: gophermart.v1.GophKeeper.SshKeyDelete:input_type -> gophermart.v1.SshKeyDelRequest
+	12,  // 104: gophermart.v1.GophKeeper.BinaryList:input_type -> gophermart.v1.ListRequest
+	58,  // 105: gophermart.v1.GophKeeper.BinaryWrite:input_type -> gophermart.v1.BinaryWriteRequest
+	60,  // 106: gophermart.v1.GophKeeper.BinaryUpdate:input_type -> gophermart.v1.BinaryUpdateRequest
+	55,  // 107: gophermart.v1.GophKeeper.BinaryRead:input_type -> gophermart.v1.BinaryReadRequest
+	59,  // 108: gophermart.v1.GophKeeper.BinaryDelete:input_type -> gophermart.v1.BinaryDelRequest
+	61,  // 109: gophermart.v1.GophKeeper.BinaryUpload:input_type -> gophermart.v1.BinaryUplodStream
+	63,  // 110: gophermart.v1.GophKeeper.BinaryPut:input_type -> gophermart.v1.BinaryPutStream
+	64,  // 111: gophermart.v1.GophKeeper.BinaryUploadStatus:input_type -> gophermart.v1.BinaryUploadStatusRequest
+	66,  // 112: gophermart.v1.GophKeeper.BinaryDownload:input_type -> gophermart.v1.BidaryDownloadRequest
+	68,  // 113: gophermart.v1.GophKeeper.BinaryTransfer:input_type -> gophermart.v1.BinaryTransferRequest
+	70,  // 114: gophermart.v1.GophKeeper.BinaryPartsBegin:input_type -> gophermart.v1.BinaryPartsBeginRequest
+	71,  // 115: gophermart.v1.GophKeeper.BinaryUploadPart:input_type -> gophermart.v1.BinaryUploadPartStream
+	72,  // 116: gophermart.v1.GophKeeper.BinaryPartsComplete:input_type -> gophermart.v1.BinaryPartsCompleteRequest
+	73,  // 117: gophermart.v1.GophKeeper.Rename:input_type -> gophermart.v1.RenameRequest
+	74,  // 118: gophermart.v1.GophKeeper.TagAdd:input_type -> gophermart.v1.TagRequest
+	74,  // 119: gophermart.v1.GophKeeper.TagRemove:input_type -> gophermart.v1.TagRequest
+	88,  // 120: gophermart.v1.GophKeeper.TagList:input_type -> google.protobuf.Empty
+	76,  // 121: gophermart.v1.GophKeeper.FolderMove:input_type -> gophermart.v1.FolderMoveRequest
+	88,  // 122: gophermart.v1.GophKeeper.FolderList:input_type -> google.protobuf.Empty
+	78,  // 123: gophermart.v1.GophKeeper.FolderDelete:input_type -> gophermart.v1.FolderDelRequest
+	79,  // 124: gophermart.v1.GophKeeper.Search:input_type -> gophermart.v1.SearchRequest
+	88,  // 125: gophermart.v1.GophKeeper.Watch:input_type -> google.protobuf.Empty
+	84,  // 126: gophermart.v1.GophKeeper.Batch:input_type -> gophermart.v1.BatchRequest
+	5,   // 127: gophermart.v1.GophKeeper.Ping:output_type -> gophermart.v1.PingResponse
+	7,   // 128: gophermart.v1.GophKeeper.Register:output_type -> gophermart.v1.RegisterResponse
+	9,   // 129: gophermart.v1.GophKeeper.Login:output_type -> gophermart.v1.LoginResponse
+	10,  // 130: gophermart.v1.GophKeeper.List:output_type -> gophermart.v1.ListResponse
+	14,  // 131: gophermart.v1.GophKeeper.PasswordList:output_type -> gophermart.v1.PasswordListResponse
+	88,  // 132: gophermart.v1.GophKeeper.PasswordWrite:output_type -> google.protobuf.Empty
+	88,  // 133: gophermart.v1.GophKeeper.PasswordUpdate:output_type -> google.protobuf.Empty
+	16,  // 134: gophermart.v1.GophKeeper.PasswordRead:output_type -> gophermart.v1.PasswordReadResponse
+	88,  // 135: gophermart.v1.GophKeeper.PasswordDelete:output_type -> google.protobuf.Empty
+	21,  // 136: gophermart.v1.GophKeeper.CardList:output_type -> gophermart.v1.CardListResponse
+	88,  // 137: gophermart.v1.GophKeeper.CardWrite:output_type -> google.protobuf.Empty
+	88,  // 138: gophermart.v1.GophKeeper.CardUpdate:output_type -> google.protobuf.Empty
+	23,  // 139: gophermart.v1.GophKeeper.CardRead:output_type -> gophermart.v1.CardReadResponse
+	88,  // 140: gophermart.v1.GophKeeper.CardDelete:output_type -> google.protobuf.Empty
+	27,  // 141: gophermart.v1.GophKeeper.NoteList:output_type -> gophermart.v1.NoteListResponse
+	88,  // 142: gophermart.v1.GophKeeper.NoteWrite:output_type -> google.protobuf.Empty
+	88,  // 143: gophermart.v1.GophKeeper.NoteUpdate:output_type -> google.protobuf.Empty
+	29,  // 144: gophermart.v1.GophKeeper.NoteRead:output_type -> gophermart.v1.NoteReadResponse
+	88,  // 145: gophermart.v1.GophKeeper.NoteDelete:output_type -> google.protobuf.Empty
+	34,  // 146: gophermart.v1.GophKeeper.ItemList:output_type -> gophermart.v1.ItemListResponse
+	88,  // 147: gophermart.v1.GophKeeper.ItemWrite:output_type -> google.protobuf.Empty
+	88,  // 148: gophermart.v1.GophKeeper.ItemUpdate:output_type -> google.protobuf.Empty
+	36,  // 149: gophermart.v1.GophKeeper.ItemRead:output_type -> gophermart.v1.ItemReadResponse
+	88,  // 150: gophermart.v1.GophKeeper.ItemDelete:output_type -> google.protobuf.Empty
+	40,  // 151: gophermart.v1.GophKeeper.OtpList:output_type -> gophermart.v1.OtpListResponse
+	88,  // 152: gophermart.v1.GophKeeper.OtpWrite:output_type -> google.protobuf.Empty
+	88,  // 153: gophermart.v1.GophKeeper.OtpUpdate:output_type -> google.protobuf.Empty
+	42,  // 154: gophermart.v1.GophKeeper.OtpRead:output_type -> gophermart.v1.OtpReadResponse
+	88,  // 155: gophermart.v1.GophKeeper.OtpDelete:output_type -> google.protobuf.Empty
+	47,  // 156: gophermart.v1.GophKeeper.OtpCode:output_type -> gophermart.v1.OtpCodeResponse
+	48,  // 157: gophermart.v1.GophKeeper.SshKeyList:output_type -> gophermart.v1.SshKeyListResponse
+	88,  // 158: gophermart.v1.GophKeeper.SshKeyWrite:output_type -> google.protobuf.Empty
+	88,  // 159: gophermart.v1.GophKeeper.SshKeyUpdate:output_type -> google.protobuf.Empty
+	50,  // 160: gophermart.v1.GophKeeper.SshKeyRead:output_type -> gophermart.v1.SshKeyReadResponse
+	88,  // 161: gophermart.v1.GophKeeper.SshKeyDelete:output_type -> google.protobuf.Empty
+	54,  // 162: gophermart.v1.GophKeeper.BinaryList:output_type -> gophermart.v1.BinaryListResponse
+	18,  // 163: gophermart.v1.GophKeeper.BinaryWrite:output_type -> gophermart.v1.BinaryWriteResponse
+	88,  // 164: gophermart.v1.GophKeeper.BinaryUpdate:output_type -> google.protobuf.Empty
+	56,  // 165: gophermart.v1.GophKeeper.BinaryRead:output_type -> gophermart.v1.BinaryReadResponse
+	88,  // 166: gophermart.v1.GophKeeper.BinaryDelete:output_type -> google.protobuf.Empty
+	88,  // 167: gophermart.v1.GophKeeper.BinaryUpload:output_type -> google.protobuf.Empty
+	18,  // 168: gophermart.v1.GophKeeper.BinaryPut:output_type -> gophermart.v1.BinaryWriteResponse
+	65,  // 169: gophermart.v1.GophKeeper.BinaryUploadStatus:output_type -> gophermart.v1.BinaryUploadStatusResponse
+	67,  // 170: gophermart.v1.GophKeeper.BinaryDownload:output_type -> gophermart.v1.BinaryDownloadStream
+	69,  // 171: gophermart.v1.GophKeeper.BinaryTransfer:output_type -> gophermart.v1.BinaryTransferResponse
+	88,  // 172: gophermart.v1.GophKeeper.BinaryPartsBegin:output_type -> google.protobuf.Empty
+	88,  // 173: gophermart.v1.GophKeeper.BinaryUploadPart:output_type -> google.protobuf.Empty
+	88,  // 174: gophermart.v1.GophKeeper.BinaryPartsComplete:output_type -> google.protobuf.Empty
+	88,  // 175: gophermart.v1.GophKeeper.Rename:output_type -> google.protobuf.Empty
+	88,  // 176: gophermart.v1.GophKeeper.TagAdd:output_type -> google.protobuf.Empty
+	88,  // 177: gophermart.v1.GophKeeper.TagRemove:output_type -> google.protobuf.Empty
+	75,  // 178: gophermart.v1.GophKeeper.TagList:output_type -> gophermart.v1.TagListResponse
+	88,  // 179: gophermart.v1.GophKeeper.FolderMove:output_type -> google.protobuf.Empty
+	77,  // 180: gophermart.v1.GophKeeper.FolderList:output_type -> gophermart.v1.FolderListResponse
+	88,  // 181: gophermart.v1.GophKeeper.FolderDelete:output_type -> google.protobuf.Empty
+	81,  // 182: gophermart.v1.GophKeeper.Search:output_type -> gophermart.v1.SearchResponse
+	82,  // 183: gophermart.v1.GophKeeper.Watch:output_type -> gophermart.v1.WatchEvent
+	86,  // 184: gophermart.v1.GophKeeper.Batch:output_type -> gophermart.v1.BatchResponse
+	127, // [127:185] is the sub-list for method output_type
+	69,  // [69:127] is the sub-list for method input_type
+	69,  // [69:69] is the sub-list for extension type_name
+	69,  // [69:69] is the sub-list for extension extendee
+	0,   // [0:69] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_gophkeeper_proto_init() }
+func file_proto_v1_gophkeeper_proto_init() {
+	if File_proto_v1_gophkeeper_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1_gophkeeper_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Limits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordWriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryWriteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordDelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardWriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardDelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoteListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoteReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoteReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoteWriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoteDelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoteUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemWriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemDelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtpListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtpReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtpReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtpWriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtpDelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtpUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtpCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtpCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SshKeyListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SshKeyReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SshKeyReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SshKeyWriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BinaryDownloadStream.ProtoReflect.Descriptor instead.
-func (*BinaryDownloadStream) Descriptor() ([]byte, []int) {
-	return file_proto_v1_gophkeeper_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *BinaryDownloadStream) GetChunk() []byte {
-	if x != nil {
-		return x.Chunk
-	}
-	return nil
-}
-
-var File_proto_v1_gophkeeper_proto protoreflect.FileDescriptor
-
-var file_proto_v1_gophkeeper_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x70, 0x68, 0x6b,
-	0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67, 0x6f, 0x70,
-	0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57,
-	0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x05, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x28, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x54, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x05, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x25, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa0,
-	0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x69, 0x6e, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22,
-	0x34, 0x0a, 0x13, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
-	0x22, 0x97, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01,
-	0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72,
-	0x03, 0x18, 0x80, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x42, 0x69,
-	0x6e, 0x61, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x33, 0x0a, 0x12, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x15, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x39, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x28, 0x0a, 0x10, 0x43, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x0f, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x76, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x83,
-	0x01, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x14, 0x52, 0x06, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x0a, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
-	0x6f, 0x74, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x0e, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x11, 0x43, 0x61, 0x72, 0x64, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x77, 0x72,
-	0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68,
-	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74,
-	0x65, 0x22, 0x28, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x0f, 0x4e,
-	0x6f, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48,
-	0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a,
-	0x10, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x10, 0x4e,
-	0x6f, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba,
-	0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
-	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
-	0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x2f, 0x0a,
-	0x0e, 0x4e, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba,
-	0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5a,
-	0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x42, 0x69,
-	0x6e, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x11, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04,
-	0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x79, 0x0a, 0x12, 0x42, 0x69,
-	0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x6e, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x12, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04,
-	0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
-	0x01, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x31, 0x0a,
-	0x10, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x7e, 0x0a, 0x13, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x62, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d,
-	0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65,
-	0x22, 0x4b, 0x0a, 0x11, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x64, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
-	0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x07, 0xba,
-	0x48, 0x04, 0x7a, 0x02, 0x10, 0x01, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x27, 0x0a,
-	0x15, 0x42, 0x69, 0x64, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x32, 0xa4, 0x0f, 0x0a, 0x0a, 0x47, 0x6f, 0x70, 0x68, 0x4b, 0x65, 0x65,
-	0x70, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4b, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x67,
-	0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67,
-	0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
-	0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d,
-	0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
-	0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d,
-	0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x67,
-	0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x0e, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x67, 0x6f,
-	0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x0c, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x68,
-	0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x44, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x43, 0x0a, 0x08, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0a, 0x43, 0x61,
-	0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65,
-	0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x4b, 0x0a, 0x08, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1e,
-	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x43, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1d, 0x2e,
-	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
-	0x72, 0x64, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65,
-	0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x4e, 0x6f, 0x74,
-	0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d,
-	0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x46, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e,
-	0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
-	0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x65, 0x52,
-	0x65, 0x61, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x0a, 0x42, 0x69, 0x6e,
-	0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65,
-	0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x0a, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65,
-	0x61, 0x64, 0x12, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72,
-	0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x4a, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x20, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x64, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x28, 0x01, 0x12, 0x5d, 0x0a, 0x0e,
-	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x24,
-	0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x69, 0x64, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x75, 0x67, 0x65, 0x6e, 0x65,
-	0x39, 0x38, 0x32, 0x2f, 0x79, 0x70, 0x2d, 0x67, 0x6f, 0x70, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x72,
-	0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76,
-	0x31, 0x3b, 0x70, 0x62, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_v1_gophkeeper_proto_rawDescOnce sync.Once
-	file_proto_v1_gophkeeper_proto_rawDescData = file_proto_v1_gophkeeper_proto_rawDesc
-)
-
-func file_proto_v1_gophkeeper_proto_rawDescGZIP() []byte {
-	file_proto_v1_gophkeeper_proto_rawDescOnce.Do(func() {
-		file_proto_v1_gophkeeper_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_gophkeeper_proto_rawDescData)
-	})
-	return file_proto_v1_gophkeeper_proto_rawDescData
-}
-
-var file_proto_v1_gophkeeper_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
-var file_proto_v1_gophkeeper_proto_goTypes = []interface{}{
-	(*PingResponse)(nil),          // 0: gophermart.v1.PingResponse
-	(*RegisterRequest)(nil),       // 1: gophermart.v1.RegisterRequest
-	(*RegisterResponse)(nil),      // 2: gophermart.v1.RegisterResponse
-	(*LoginRequest)(nil),          // 3: gophermart.v1.LoginRequest
-	(*LoginResponse)(nil),         // 4: gophermart.v1.LoginResponse
-	(*ListResponse)(nil),          // 5: gophermart.v1.ListResponse
-	(*PasswordListResponse)(nil),  // 6: gophermart.v1.PasswordListResponse
-	(*PasswordReadRequest)(nil),   // 7: gophermart.v1.PasswordReadRequest
-	(*PasswordReadResponse)(nil),  // 8: gophermart.v1.PasswordReadResponse
-	(*PasswordWriteRequest)(nil),  // 9: gophermart.v1.PasswordWriteRequest
-	(*BinaryWriteResponse)(nil),   // 10: gophermart.v1.BinaryWriteResponse
-	(*PasswordDelRequest)(nil),    // 11: gophermart.v1.PasswordDelRequest
-	(*PasswordUpdateRequest)(nil), // 12: gophermart.v1.PasswordUpdateRequest
-	(*CardListResponse)(nil),      // 13: gophermart.v1.CardListResponse
-	(*CardReadRequest)(nil),       // 14: gophermart.v1.CardReadRequest
-	(*CardReadResponse)(nil),      // 15: gophermart.v1.CardReadResponse
-	(*CardWriteRequest)(nil),      // 16: gophermart.v1.CardWriteRequest
-	(*CardDelRequest)(nil),        // 17: gophermart.v1.CardDelRequest
-	(*CardUpdateRequest)(nil),     // 18: gophermart.v1.CardUpdateRequest
-	(*NoteListResponse)(nil),      // 19: gophermart.v1.NoteListResponse
-	(*NoteReadRequest)(nil),       // 20: gophermart.v1.NoteReadRequest
-	(*NoteReadResponse)(nil),      // 21: gophermart.v1.NoteReadResponse
-	(*NoteWriteRequest)(nil),      // 22: gophermart.v1.NoteWriteRequest
-	(*NoteDelRequest)(nil),        // 23: gophermart.v1.NoteDelRequest
-	(*NoteUpdateRequest)(nil),     // 24: gophermart.v1.NoteUpdateRequest
-	(*BinaryListResponse)(nil),    // 25: gophermart.v1.BinaryListResponse
-	(*BinaryReadRequest)(nil),     // 26: gophermart.v1.BinaryReadRequest
-	(*BinaryReadResponse)(nil),    // 27: gophermart.v1.BinaryReadResponse
-	(*BinaryWriteRequest)(nil),    // 28: gophermart.v1.BinaryWriteRequest
-	(*BinaryDelRequest)(nil),      // 29: gophermart.v1.BinaryDelRequest
-	(*BinaryUpdateRequest)(nil),   // 30: gophermart.v1.BinaryUpdateRequest
-	(*BinaryUplodStream)(nil),     // 31: gophermart.v1.BinaryUplodStream
-	(*BidaryDownloadRequest)(nil), // 32: gophermart.v1.BidaryDownloadRequest
-	(*BinaryDownloadStream)(nil),  // 33: gophermart.v1.BinaryDownloadStream
-	(*empty.Empty)(nil),           // 34: google.protobuf.Empty
-}
-var file_proto_v1_gophkeeper_proto_depIdxs = []int32{
-	9,  // 0: gophermart.v1.PasswordUpdateRequest.write:type_name -> gophermart.v1.PasswordWriteRequest
-	16, // 1: gophermart.v1.CardUpdateRequest.write:type_name -> gophermart.v1.CardWriteRequest
-	22, // 2: gophermart.v1.NoteUpdateRequest.write:type_name -> gophermart.v1.NoteWriteRequest
-	28, // 3: gophermart.v1.BinaryUpdateRequest.write:type_name -> gophermart.v1.BinaryWriteRequest
-	34, // 4: gophermart.v1.GophKeeper.Ping:input_type -> google.protobuf.Empty
-	1,  // 5: gophermart.v1.GophKeeper.Register:input_type -> gophermart.v1.RegisterRequest
-	3,  // 6: gophermart.v1.GophKeeper.Login:input_type -> gophermart.v1.LoginRequest
-	34, // 7: gophermart.v1.GophKeeper.List:input_type -> google.protobuf.Empty
-	34, // 8: gophermart.v1.GophKeeper.PasswordList:input_type -> google.protobuf.Empty
-	9,  // 9: gophermart.v1.GophKeeper.PasswordWrite:input_type -> gophermart.v1.PasswordWriteRequest
-	12, // 10: gophermart.v1.GophKeeper.PasswordUpdate:input_type -> gophermart.v1.PasswordUpdateRequest
-	7,  // 11: gophermart.v1.GophKeeper.PasswordRead:input_type -> gophermart.v1.PasswordReadRequest
-	11, // 12: gophermart.v1.GophKeeper.PasswordDelete:input_type -> gophermart.v1.PasswordDelRequest
-	34, // 13: gophermart.v1.GophKeeper.CardList:input_type -> google.protobuf.Empty
-	16, // 14: gophermart.v1.GophKeeper.CardWrite:input_type -> gophermart.v1.CardWriteRequest
-	18, // 15: gophermart.v1.GophKeeper.CardUpdate:input_type -> gophermart.v1.CardUpdateRequest
-	14, // 16: gophermart.v1.GophKeeper.CardRead:input_type -> gophermart.v1.CardReadRequest
-	17, // 17: gophermart.v1.GophKeeper.CardDelete:input_type -> gophermart.v1.CardDelRequest
-	34, // 18: gophermart.v1.GophKeeper.NoteList:input_type -> google.protobuf.Empty
-	22, // 19: gophermart.v1.GophKeeper.NoteWrite:input_type -> gophermart.v1.NoteWriteRequest
-	24, // 20: gophermart.v1.GophKeeper.NoteUpdate:input_type -> gophermart.v1.NoteUpdateRequest
-	20, // 21: gophermart.v1.GophKeeper.NoteRead:input_type -> gophermart.v1.NoteReadRequest
-	23, // 22: gophermart.v1.GophKeeper.NoteDelete:input_type -> gophermart.v1.NoteDelRequest
-	34, // 23: gophermart.v1.GophKeeper.BinaryList:input_type -> google.protobuf.Empty
-	28, // 24: gophermart.v1.GophKeeper.BinaryWrite:input_type -> gophermart.v1.BinaryWriteRequest
-	30, // 25: gophermart.v1.GophKeeper.BinaryUpdate:input_type -> gophermart.v1.BinaryUpdateRequest
-	26, // 26: gophermart.v1.GophKeeper.BinaryRead:input_type -> gophermart.v1.BinaryReadRequest
-	29, // 27: gophermart.v1.GophKeeper.BinaryDelete:input_type -> gophermart.v1.BinaryDelRequest
-	31, // 28: gophermart.v1.GophKeeper.BinaryUpload:input_type -> gophermart.v1.BinaryUplodStream
-	32, // 29: gophermart.v1.GophKeeper.BinaryDownload:input_type -> gophermart.v1.BidaryDownloadRequest
-	0,  // 30: gophermart.v1.GophKeeper.Ping:output_type -> gophermart.v1.PingResponse
-	2,  // 31: gophermart.v1.GophKeeper.Register:output_type -> gophermart.v1.RegisterResponse
-	4,  // 32: gophermart.v1.GophKeeper.Login:output_type -> gophermart.v1.LoginResponse
-	5,  // 33: gophermart.v1.GophKeeper.List:output_type -> gophermart.v1.ListResponse
-	6,  // 34: gophermart.v1.GophKeeper.PasswordList:output_type -> gophermart.v1.PasswordListResponse
-	34, // 35: gophermart.v1.GophKeeper.PasswordWrite:output_type -> google.protobuf.Empty
-	34, // 36: gophermart.v1.GophKeeper.PasswordUpdate:output_type -> google.protobuf.Empty
-	8,  // 37: gophermart.v1.GophKeeper.PasswordRead:output_type -> gophermart.v1.PasswordReadResponse
-	34, // 38: gophermart.v1.GophKeeper.PasswordDelete:output_type -> google.protobuf.Empty
-	13, // 39: gophermart.v1.GophKeeper.CardList:output_type -> gophermart.v1.CardListResponse
-	34, // 40: gophermart.v1.GophKeeper.CardWrite:output_type -> google.protobuf.Empty
-	34, // 41: gophermart.v1.GophKeeper.CardUpdate:output_type -> google.protobuf.Empty
-	15, // 42: gophermart.v1.GophKeeper.CardRead:output_type -> gophermart.v1.CardReadResponse
-	34, // 43: gophermart.v1.GophKeeper.CardDelete:output_type -> google.protobuf.Empty
-	19, // 44: gophermart.v1.GophKeeper.NoteList:output_type -> gophermart.v1.NoteListResponse
-	34, // 45: gophermart.v1.GophKeeper.NoteWrite:output_type -> google.protobuf.Empty
-	34, // 46: gophermart.v1.GophKeeper.NoteUpdate:output_type -> google.protobuf.Empty
-	21, // 47: gophermart.v1.GophKeeper.NoteRead:output_type -> gophermart.v1.NoteReadResponse
-	34, // 48: gophermart.v1.GophKeeper.NoteDelete:output_type -> google.protobuf.Empty
-	25, // 49: gophermart.v1.GophKeeper.BinaryList:output_type -> gophermart.v1.BinaryListResponse
-	10, // 50: gophermart.v1.GophKeeper.BinaryWrite:output_type -> gophermart.v1.BinaryWriteResponse
-	34, // 51: gophermart.v1.GophKeeper.BinaryUpdate:output_type -> google.protobuf.Empty
-	27, // 52: gophermart.v1.GophKeeper.BinaryRead:output_type -> gophermart.v1.BinaryReadResponse
-	34, // 53: gophermart.v1.GophKeeper.BinaryDelete:output_type -> google.protobuf.Empty
-	34, // 54: gophermart.v1.GophKeeper.BinaryUpload:output_type -> google.protobuf.Empty
-	33, // 55: gophermart.v1.GophKeeper.BinaryDownload:output_type -> gophermart.v1.BinaryDownloadStream
-	30, // [30:56] is the sub-list for method output_type
-	4,  // [4:30] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
-}
-
-func init() { file_proto_v1_gophkeeper_proto_init() }
-func file_proto_v1_gophkeeper_proto_init() {
-	if File_proto_v1_gophkeeper_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_v1_gophkeeper_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SshKeyDelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2325,8 +7791,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SshKeyUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2337,8 +7803,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2349,8 +7815,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2361,8 +7827,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2373,8 +7839,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileMeta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2385,8 +7851,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordListResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2397,8 +7863,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordReadRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryDelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2409,8 +7875,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordReadResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2421,8 +7887,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordWriteRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryUplodStream); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2433,8 +7899,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BinaryWriteResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryPutHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2445,8 +7911,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordDelRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryPutStream); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2457,8 +7923,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordUpdateRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryUploadStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2469,8 +7935,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardListResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryUploadStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2481,8 +7947,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardReadRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidaryDownloadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2493,8 +7959,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardReadResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryDownloadStream); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2505,8 +7971,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardWriteRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryTransferRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2517,8 +7983,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardDelRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryTransferResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2529,8 +7995,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardUpdateRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryPartsBeginRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2541,8 +8007,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NoteListResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryUploadPartStream); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2553,8 +8019,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NoteReadRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryPartsCompleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2565,8 +8031,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NoteReadResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2577,8 +8043,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NoteWriteRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2589,8 +8055,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NoteDelRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2601,8 +8067,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NoteUpdateRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FolderMoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2613,8 +8079,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BinaryListResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FolderListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2625,8 +8091,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BinaryReadRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FolderDelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2637,8 +8103,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BinaryReadResponse); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2649,8 +8115,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BinaryWriteRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2661,8 +8127,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BinaryDelRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2673,8 +8139,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BinaryUpdateRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2685,8 +8151,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BinaryUplodStream); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchOperation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2697,8 +8163,8 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BidaryDownloadRequest); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2709,8 +8175,20 @@ func file_proto_v1_gophkeeper_proto_init() {
 				return nil
 			}
 		}
-		file_proto_v1_gophkeeper_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BinaryDownloadStream); i {
+		file_proto_v1_gophkeeper_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_gophkeeper_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2722,18 +8200,43 @@ func file_proto_v1_gophkeeper_proto_init() {
 			}
 		}
 	}
+	file_proto_v1_gophkeeper_proto_msgTypes[58].OneofWrappers = []interface{}{
+		(*BinaryPutStream_Header)(nil),
+		(*BinaryPutStream_Chunk)(nil),
+	}
+	file_proto_v1_gophkeeper_proto_msgTypes[78].OneofWrappers = []interface{}{
+		(*BatchOperation_PasswordWrite)(nil),
+		(*BatchOperation_PasswordUpdate)(nil),
+		(*BatchOperation_PasswordDelete)(nil),
+		(*BatchOperation_CardWrite)(nil),
+		(*BatchOperation_CardUpdate)(nil),
+		(*BatchOperation_CardDelete)(nil),
+		(*BatchOperation_NoteWrite)(nil),
+		(*BatchOperation_NoteUpdate)(nil),
+		(*BatchOperation_NoteDelete)(nil),
+		(*BatchOperation_ItemWrite)(nil),
+		(*BatchOperation_ItemUpdate)(nil),
+		(*BatchOperation_ItemDelete)(nil),
+		(*BatchOperation_OtpWrite)(nil),
+		(*BatchOperation_OtpUpdate)(nil),
+		(*BatchOperation_OtpDelete)(nil),
+		(*BatchOperation_SshKeyWrite)(nil),
+		(*BatchOperation_SshKeyUpdate)(nil),
+		(*BatchOperation_SshKeyDelete)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_v1_gophkeeper_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   34,
+			NumEnums:      5,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_v1_gophkeeper_proto_goTypes,
 		DependencyIndexes: file_proto_v1_gophkeeper_proto_depIdxs,
+		EnumInfos:         file_proto_v1_gophkeeper_proto_enumTypes,
 		MessageInfos:      file_proto_v1_gophkeeper_proto_msgTypes,
 	}.Build()
 	File_proto_v1_gophkeeper_proto = out.File
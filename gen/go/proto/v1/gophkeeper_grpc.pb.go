@@ -20,32 +20,64 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GophKeeper_Ping_FullMethodName           = "/gophermart.v1.GophKeeper/Ping"
-	GophKeeper_Register_FullMethodName       = "/gophermart.v1.GophKeeper/Register"
-	GophKeeper_Login_FullMethodName          = "/gophermart.v1.GophKeeper/Login"
-	GophKeeper_List_FullMethodName           = "/gophermart.v1.GophKeeper/List"
-	GophKeeper_PasswordList_FullMethodName   = "/gophermart.v1.GophKeeper/PasswordList"
-	GophKeeper_PasswordWrite_FullMethodName  = "/gophermart.v1.GophKeeper/PasswordWrite"
-	GophKeeper_PasswordUpdate_FullMethodName = "/gophermart.v1.GophKeeper/PasswordUpdate"
-	GophKeeper_PasswordRead_FullMethodName   = "/gophermart.v1.GophKeeper/PasswordRead"
-	GophKeeper_PasswordDelete_FullMethodName = "/gophermart.v1.GophKeeper/PasswordDelete"
-	GophKeeper_CardList_FullMethodName       = "/gophermart.v1.GophKeeper/CardList"
-	GophKeeper_CardWrite_FullMethodName      = "/gophermart.v1.GophKeeper/CardWrite"
-	GophKeeper_CardUpdate_FullMethodName     = "/gophermart.v1.GophKeeper/CardUpdate"
-	GophKeeper_CardRead_FullMethodName       = "/gophermart.v1.GophKeeper/CardRead"
-	GophKeeper_CardDelete_FullMethodName     = "/gophermart.v1.GophKeeper/CardDelete"
-	GophKeeper_NoteList_FullMethodName       = "/gophermart.v1.GophKeeper/NoteList"
-	GophKeeper_NoteWrite_FullMethodName      = "/gophermart.v1.GophKeeper/NoteWrite"
-	GophKeeper_NoteUpdate_FullMethodName     = "/gophermart.v1.GophKeeper/NoteUpdate"
-	GophKeeper_NoteRead_FullMethodName       = "/gophermart.v1.GophKeeper/NoteRead"
-	GophKeeper_NoteDelete_FullMethodName     = "/gophermart.v1.GophKeeper/NoteDelete"
-	GophKeeper_BinaryList_FullMethodName     = "/gophermart.v1.GophKeeper/BinaryList"
-	GophKeeper_BinaryWrite_FullMethodName    = "/gophermart.v1.GophKeeper/BinaryWrite"
-	GophKeeper_BinaryUpdate_FullMethodName   = "/gophermart.v1.GophKeeper/BinaryUpdate"
-	GophKeeper_BinaryRead_FullMethodName     = "/gophermart.v1.GophKeeper/BinaryRead"
-	GophKeeper_BinaryDelete_FullMethodName   = "/gophermart.v1.GophKeeper/BinaryDelete"
-	GophKeeper_BinaryUpload_FullMethodName   = "/gophermart.v1.GophKeeper/BinaryUpload"
-	GophKeeper_BinaryDownload_FullMethodName = "/gophermart.v1.GophKeeper/BinaryDownload"
+	GophKeeper_Ping_FullMethodName                = "/gophermart.v1.GophKeeper/Ping"
+	GophKeeper_Register_FullMethodName            = "/gophermart.v1.GophKeeper/Register"
+	GophKeeper_Login_FullMethodName               = "/gophermart.v1.GophKeeper/Login"
+	GophKeeper_List_FullMethodName                = "/gophermart.v1.GophKeeper/List"
+	GophKeeper_PasswordList_FullMethodName        = "/gophermart.v1.GophKeeper/PasswordList"
+	GophKeeper_PasswordWrite_FullMethodName       = "/gophermart.v1.GophKeeper/PasswordWrite"
+	GophKeeper_PasswordUpdate_FullMethodName      = "/gophermart.v1.GophKeeper/PasswordUpdate"
+	GophKeeper_PasswordRead_FullMethodName        = "/gophermart.v1.GophKeeper/PasswordRead"
+	GophKeeper_PasswordDelete_FullMethodName      = "/gophermart.v1.GophKeeper/PasswordDelete"
+	GophKeeper_CardList_FullMethodName            = "/gophermart.v1.GophKeeper/CardList"
+	GophKeeper_CardWrite_FullMethodName           = "/gophermart.v1.GophKeeper/CardWrite"
+	GophKeeper_CardUpdate_FullMethodName          = "/gophermart.v1.GophKeeper/CardUpdate"
+	GophKeeper_CardRead_FullMethodName            = "/gophermart.v1.GophKeeper/CardRead"
+	GophKeeper_CardDelete_FullMethodName          = "/gophermart.v1.GophKeeper/CardDelete"
+	GophKeeper_NoteList_FullMethodName            = "/gophermart.v1.GophKeeper/NoteList"
+	GophKeeper_NoteWrite_FullMethodName           = "/gophermart.v1.GophKeeper/NoteWrite"
+	GophKeeper_NoteUpdate_FullMethodName          = "/gophermart.v1.GophKeeper/NoteUpdate"
+	GophKeeper_NoteRead_FullMethodName            = "/gophermart.v1.GophKeeper/NoteRead"
+	GophKeeper_NoteDelete_FullMethodName          = "/gophermart.v1.GophKeeper/NoteDelete"
+	GophKeeper_ItemList_FullMethodName            = "/gophermart.v1.GophKeeper/ItemList"
+	GophKeeper_ItemWrite_FullMethodName           = "/gophermart.v1.GophKeeper/ItemWrite"
+	GophKeeper_ItemUpdate_FullMethodName          = "/gophermart.v1.GophKeeper/ItemUpdate"
+	GophKeeper_ItemRead_FullMethodName            = "/gophermart.v1.GophKeeper/ItemRead"
+	GophKeeper_ItemDelete_FullMethodName          = "/gophermart.v1.GophKeeper/ItemDelete"
+	GophKeeper_OtpList_FullMethodName             = "/gophermart.v1.GophKeeper/OtpList"
+	GophKeeper_OtpWrite_FullMethodName            = "/gophermart.v1.GophKeeper/OtpWrite"
+	GophKeeper_OtpUpdate_FullMethodName           = "/gophermart.v1.GophKeeper/OtpUpdate"
+	GophKeeper_OtpRead_FullMethodName             = "/gophermart.v1.GophKeeper/OtpRead"
+	GophKeeper_OtpDelete_FullMethodName           = "/gophermart.v1.GophKeeper/OtpDelete"
+	GophKeeper_OtpCode_FullMethodName             = "/gophermart.v1.GophKeeper/OtpCode"
+	GophKeeper_SshKeyList_FullMethodName          = "/gophermart.v1.GophKeeper/SshKeyList"
+	GophKeeper_SshKeyWrite_FullMethodName         = "/gophermart.v1.GophKeeper/SshKeyWrite"
+	GophKeeper_SshKeyUpdate_FullMethodName        = "/gophermart.v1.GophKeeper/SshKeyUpdate"
+	GophKeeper_SshKeyRead_FullMethodName          = "/gophermart.v1.GophKeeper/SshKeyRead"
+	GophKeeper_SshKeyDelete_FullMethodName        = "/gophermart.v1.GophKeeper/SshKeyDelete"
+	GophKeeper_BinaryList_FullMethodName          = "/gophermart.v1.GophKeeper/BinaryList"
+	GophKeeper_BinaryWrite_FullMethodName         = "/gophermart.v1.GophKeeper/BinaryWrite"
+	GophKeeper_BinaryUpdate_FullMethodName        = "/gophermart.v1.GophKeeper/BinaryUpdate"
+	GophKeeper_BinaryRead_FullMethodName          = "/gophermart.v1.GophKeeper/BinaryRead"
+	GophKeeper_BinaryDelete_FullMethodName        = "/gophermart.v1.GophKeeper/BinaryDelete"
+	GophKeeper_BinaryUpload_FullMethodName        = "/gophermart.v1.GophKeeper/BinaryUpload"
+	GophKeeper_BinaryPut_FullMethodName           = "/gophermart.v1.GophKeeper/BinaryPut"
+	GophKeeper_BinaryUploadStatus_FullMethodName  = "/gophermart.v1.GophKeeper/BinaryUploadStatus"
+	GophKeeper_BinaryDownload_FullMethodName      = "/gophermart.v1.GophKeeper/BinaryDownload"
+	GophKeeper_BinaryTransfer_FullMethodName      = "/gophermart.v1.GophKeeper/BinaryTransfer"
+	GophKeeper_BinaryPartsBegin_FullMethodName    = "/gophermart.v1.GophKeeper/BinaryPartsBegin"
+	GophKeeper_BinaryUploadPart_FullMethodName    = "/gophermart.v1.GophKeeper/BinaryUploadPart"
+	GophKeeper_BinaryPartsComplete_FullMethodName = "/gophermart.v1.GophKeeper/BinaryPartsComplete"
+	GophKeeper_Rename_FullMethodName              = "/gophermart.v1.GophKeeper/Rename"
+	GophKeeper_TagAdd_FullMethodName              = "/gophermart.v1.GophKeeper/TagAdd"
+	GophKeeper_TagRemove_FullMethodName           = "/gophermart.v1.GophKeeper/TagRemove"
+	GophKeeper_TagList_FullMethodName             = "/gophermart.v1.GophKeeper/TagList"
+	GophKeeper_FolderMove_FullMethodName          = "/gophermart.v1.GophKeeper/FolderMove"
+	GophKeeper_FolderList_FullMethodName          = "/gophermart.v1.GophKeeper/FolderList"
+	GophKeeper_FolderDelete_FullMethodName        = "/gophermart.v1.GophKeeper/FolderDelete"
+	GophKeeper_Search_FullMethodName              = "/gophermart.v1.GophKeeper/Search"
+	GophKeeper_Watch_FullMethodName               = "/gophermart.v1.GophKeeper/Watch"
+	GophKeeper_Batch_FullMethodName               = "/gophermart.v1.GophKeeper/Batch"
 )
 
 // GophKeeperClient is the client API for GophKeeper service.
@@ -58,10 +90,11 @@ type GophKeeperClient interface {
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	// Login регистрация нового пользователя
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	// List возвращает количество хранимых данных пользователя (защищённый)
+	// List возвращает количество хранимых данных пользователя
+	// и ограничения хранилища (защищённый)
 	List(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListResponse, error)
 	// PasswordList - возвращает список паролей пользователя
-	PasswordList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PasswordListResponse, error)
+	PasswordList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*PasswordListResponse, error)
 	// PasswordWrite запись нового пароля
 	PasswordWrite(ctx context.Context, in *PasswordWriteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// PasswordUpdate обновление имеющегочя пароля
@@ -71,7 +104,7 @@ type GophKeeperClient interface {
 	// PasswordDelete удаление пароля
 	PasswordDelete(ctx context.Context, in *PasswordDelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// CardList - возвращает список банковских карт
-	CardList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CardListResponse, error)
+	CardList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*CardListResponse, error)
 	// CardWrite запись нового
 	CardWrite(ctx context.Context, in *CardWriteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// CardUpdate обновление
@@ -81,7 +114,7 @@ type GophKeeperClient interface {
 	// CardDelete удаление
 	CardDelete(ctx context.Context, in *CardDelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// NoteList - список
-	NoteList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NoteListResponse, error)
+	NoteList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*NoteListResponse, error)
 	// NoteWrite запись нового
 	NoteWrite(ctx context.Context, in *NoteWriteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// NoteUpdate обновление
@@ -90,8 +123,40 @@ type GophKeeperClient interface {
 	NoteRead(ctx context.Context, in *NoteReadRequest, opts ...grpc.CallOption) (*NoteReadResponse, error)
 	// NoteDelete удаление
 	NoteDelete(ctx context.Context, in *NoteDelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ItemList - список
+	ItemList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ItemListResponse, error)
+	// ItemWrite запись нового
+	ItemWrite(ctx context.Context, in *ItemWriteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ItemUpdate обновление
+	ItemUpdate(ctx context.Context, in *ItemUpdateRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ItemRead чтение
+	ItemRead(ctx context.Context, in *ItemReadRequest, opts ...grpc.CallOption) (*ItemReadResponse, error)
+	// ItemDelete удаление
+	ItemDelete(ctx context.Context, in *ItemDelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// OtpList - список
+	OtpList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*OtpListResponse, error)
+	// OtpWrite запись нового
+	OtpWrite(ctx context.Context, in *OtpWriteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// OtpUpdate обновление
+	OtpUpdate(ctx context.Context, in *OtpUpdateRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// OtpRead чтение
+	OtpRead(ctx context.Context, in *OtpReadRequest, opts ...grpc.CallOption) (*OtpReadResponse, error)
+	// OtpDelete удаление
+	OtpDelete(ctx context.Context, in *OtpDelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// OtpCode текущий одноразовый пароль, для HOTP счётчик увеличивается
+	OtpCode(ctx context.Context, in *OtpCodeRequest, opts ...grpc.CallOption) (*OtpCodeResponse, error)
+	// SshKeyList - список
+	SshKeyList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SshKeyListResponse, error)
+	// SshKeyWrite запись нового: импорт или создание ключа
+	SshKeyWrite(ctx context.Context, in *SshKeyWriteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// SshKeyUpdate обновление
+	SshKeyUpdate(ctx context.Context, in *SshKeyUpdateRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// SshKeyRead чтение
+	SshKeyRead(ctx context.Context, in *SshKeyReadRequest, opts ...grpc.CallOption) (*SshKeyReadResponse, error)
+	// SshKeyDelete удаление
+	SshKeyDelete(ctx context.Context, in *SshKeyDelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// BinaryList - список
-	BinaryList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BinaryListResponse, error)
+	BinaryList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*BinaryListResponse, error)
 	// BinaryWrite запись нового
 	BinaryWrite(ctx context.Context, in *BinaryWriteRequest, opts ...grpc.CallOption) (*BinaryWriteResponse, error)
 	// BinaryUpdate обновление
@@ -102,8 +167,40 @@ type GophKeeperClient interface {
 	BinaryDelete(ctx context.Context, in *BinaryDelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// BinaryUpload потоковая выгрузка бинарника
 	BinaryUpload(ctx context.Context, opts ...grpc.CallOption) (GophKeeper_BinaryUploadClient, error)
+	// BinaryPut создание файла вместе с содержимым одним потоком
+	BinaryPut(ctx context.Context, opts ...grpc.CallOption) (GophKeeper_BinaryPutClient, error)
+	// BinaryUploadStatus состояние загрузки для её продолжения после обрыва
+	BinaryUploadStatus(ctx context.Context, in *BinaryUploadStatusRequest, opts ...grpc.CallOption) (*BinaryUploadStatusResponse, error)
 	// BinaryDownload потоковая загрузка
 	BinaryDownload(ctx context.Context, in *BidaryDownloadRequest, opts ...grpc.CallOption) (GophKeeper_BinaryDownloadClient, error)
+	// BinaryTransfer согласование размера фрагмента и числа параллельных частей
+	BinaryTransfer(ctx context.Context, in *BinaryTransferRequest, opts ...grpc.CallOption) (*BinaryTransferResponse, error)
+	// BinaryPartsBegin начало загрузки нового содержимого параллельными частями
+	BinaryPartsBegin(ctx context.Context, in *BinaryPartsBeginRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// BinaryUploadPart потоковая загрузка одной части
+	BinaryUploadPart(ctx context.Context, opts ...grpc.CallOption) (GophKeeper_BinaryUploadPartClient, error)
+	// BinaryPartsComplete сборка загруженных частей в содержимое файла
+	BinaryPartsComplete(ctx context.Context, in *BinaryPartsCompleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Rename переименование элемента любого вида с сохранением идентификатора и содержимого
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// TagAdd добавление меток элементу
+	TagAdd(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// TagRemove снятие меток с элемента
+	TagRemove(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// TagList список используемых меток
+	TagList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TagListResponse, error)
+	// FolderMove перемещение элемента в папку, недостающие папки создаются
+	FolderMove(ctx context.Context, in *FolderMoveRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// FolderList список папок
+	FolderList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FolderListResponse, error)
+	// FolderDelete удаление папки с вложенными, элементы переносятся в корень
+	FolderDelete(ctx context.Context, in *FolderDelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Search нечёткий поиск по наименованиям, меткам и открытым полям элементов всех видов
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Watch потоковая подписка на изменения хранилища пользователя
+	Watch(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (GophKeeper_WatchClient, error)
+	// Batch пакетное выполнение операций записи в одной транзакции
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
 }
 
 type gophKeeperClient struct {
@@ -150,7 +247,7 @@ func (c *gophKeeperClient) List(ctx context.Context, in *empty.Empty, opts ...gr
 	return out, nil
 }
 
-func (c *gophKeeperClient) PasswordList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PasswordListResponse, error) {
+func (c *gophKeeperClient) PasswordList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*PasswordListResponse, error) {
 	out := new(PasswordListResponse)
 	err := c.cc.Invoke(ctx, GophKeeper_PasswordList_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -195,7 +292,7 @@ func (c *gophKeeperClient) PasswordDelete(ctx context.Context, in *PasswordDelRe
 	return out, nil
 }
 
-func (c *gophKeeperClient) CardList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CardListResponse, error) {
+func (c *gophKeeperClient) CardList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*CardListResponse, error) {
 	out := new(CardListResponse)
 	err := c.cc.Invoke(ctx, GophKeeper_CardList_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -240,7 +337,7 @@ func (c *gophKeeperClient) CardDelete(ctx context.Context, in *CardDelRequest, o
 	return out, nil
 }
 
-func (c *gophKeeperClient) NoteList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NoteListResponse, error) {
+func (c *gophKeeperClient) NoteList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*NoteListResponse, error) {
 	out := new(NoteListResponse)
 	err := c.cc.Invoke(ctx, GophKeeper_NoteList_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -285,7 +382,151 @@ func (c *gophKeeperClient) NoteDelete(ctx context.Context, in *NoteDelRequest, o
 	return out, nil
 }
 
-func (c *gophKeeperClient) BinaryList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BinaryListResponse, error) {
+func (c *gophKeeperClient) ItemList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ItemListResponse, error) {
+	out := new(ItemListResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_ItemList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) ItemWrite(ctx context.Context, in *ItemWriteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_ItemWrite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) ItemUpdate(ctx context.Context, in *ItemUpdateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_ItemUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) ItemRead(ctx context.Context, in *ItemReadRequest, opts ...grpc.CallOption) (*ItemReadResponse, error) {
+	out := new(ItemReadResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_ItemRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) ItemDelete(ctx context.Context, in *ItemDelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_ItemDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) OtpList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*OtpListResponse, error) {
+	out := new(OtpListResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_OtpList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) OtpWrite(ctx context.Context, in *OtpWriteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_OtpWrite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) OtpUpdate(ctx context.Context, in *OtpUpdateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_OtpUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) OtpRead(ctx context.Context, in *OtpReadRequest, opts ...grpc.CallOption) (*OtpReadResponse, error) {
+	out := new(OtpReadResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_OtpRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) OtpDelete(ctx context.Context, in *OtpDelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_OtpDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) OtpCode(ctx context.Context, in *OtpCodeRequest, opts ...grpc.CallOption) (*OtpCodeResponse, error) {
+	out := new(OtpCodeResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_OtpCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) SshKeyList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SshKeyListResponse, error) {
+	out := new(SshKeyListResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_SshKeyList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) SshKeyWrite(ctx context.Context, in *SshKeyWriteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_SshKeyWrite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) SshKeyUpdate(ctx context.Context, in *SshKeyUpdateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_SshKeyUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) SshKeyRead(ctx context.Context, in *SshKeyReadRequest, opts ...grpc.CallOption) (*SshKeyReadResponse, error) {
+	out := new(SshKeyReadResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_SshKeyRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) SshKeyDelete(ctx context.Context, in *SshKeyDelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_SshKeyDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) BinaryList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*BinaryListResponse, error) {
 	out := new(BinaryListResponse)
 	err := c.cc.Invoke(ctx, GophKeeper_BinaryList_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -364,8 +605,51 @@ func (x *gophKeeperBinaryUploadClient) CloseAndRecv() (*empty.Empty, error) {
 	return m, nil
 }
 
+func (c *gophKeeperClient) BinaryPut(ctx context.Context, opts ...grpc.CallOption) (GophKeeper_BinaryPutClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GophKeeper_ServiceDesc.Streams[1], GophKeeper_BinaryPut_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gophKeeperBinaryPutClient{stream}
+	return x, nil
+}
+
+type GophKeeper_BinaryPutClient interface {
+	Send(*BinaryPutStream) error
+	CloseAndRecv() (*BinaryWriteResponse, error)
+	grpc.ClientStream
+}
+
+type gophKeeperBinaryPutClient struct {
+	grpc.ClientStream
+}
+
+func (x *gophKeeperBinaryPutClient) Send(m *BinaryPutStream) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gophKeeperBinaryPutClient) CloseAndRecv() (*BinaryWriteResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BinaryWriteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gophKeeperClient) BinaryUploadStatus(ctx context.Context, in *BinaryUploadStatusRequest, opts ...grpc.CallOption) (*BinaryUploadStatusResponse, error) {
+	out := new(BinaryUploadStatusResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_BinaryUploadStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gophKeeperClient) BinaryDownload(ctx context.Context, in *BidaryDownloadRequest, opts ...grpc.CallOption) (GophKeeper_BinaryDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GophKeeper_ServiceDesc.Streams[1], GophKeeper_BinaryDownload_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &GophKeeper_ServiceDesc.Streams[2], GophKeeper_BinaryDownload_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -396,98 +680,337 @@ func (x *gophKeeperBinaryDownloadClient) Recv() (*BinaryDownloadStream, error) {
 	return m, nil
 }
 
-// GophKeeperServer is the server API for GophKeeper service.
-// All implementations must embed UnimplementedGophKeeperServer
-// for forward compatibility
-type GophKeeperServer interface {
-	// Ping проверка соединения
-	Ping(context.Context, *empty.Empty) (*PingResponse, error)
-	// Register регистрация нового пользователя
-	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	// Login регистрация нового пользователя
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	// List возвращает количество хранимых данных пользователя (защищённый)
-	List(context.Context, *empty.Empty) (*ListResponse, error)
-	// PasswordList - возвращает список паролей пользователя
-	PasswordList(context.Context, *empty.Empty) (*PasswordListResponse, error)
-	// PasswordWrite запись нового пароля
-	PasswordWrite(context.Context, *PasswordWriteRequest) (*empty.Empty, error)
-	// PasswordUpdate обновление имеющегочя пароля
-	PasswordUpdate(context.Context, *PasswordUpdateRequest) (*empty.Empty, error)
-	// PasswordRead удаление пароля
-	PasswordRead(context.Context, *PasswordReadRequest) (*PasswordReadResponse, error)
-	// PasswordDelete удаление пароля
-	PasswordDelete(context.Context, *PasswordDelRequest) (*empty.Empty, error)
-	// CardList - возвращает список банковских карт
-	CardList(context.Context, *empty.Empty) (*CardListResponse, error)
-	// CardWrite запись нового
-	CardWrite(context.Context, *CardWriteRequest) (*empty.Empty, error)
-	// CardUpdate обновление
-	CardUpdate(context.Context, *CardUpdateRequest) (*empty.Empty, error)
-	// CardRead чтение
-	CardRead(context.Context, *CardReadRequest) (*CardReadResponse, error)
-	// CardDelete удаление
-	CardDelete(context.Context, *CardDelRequest) (*empty.Empty, error)
-	// NoteList - список
-	NoteList(context.Context, *empty.Empty) (*NoteListResponse, error)
-	// NoteWrite запись нового
-	NoteWrite(context.Context, *NoteWriteRequest) (*empty.Empty, error)
-	// NoteUpdate обновление
-	NoteUpdate(context.Context, *NoteUpdateRequest) (*empty.Empty, error)
-	// NoteRead чтение
-	NoteRead(context.Context, *NoteReadRequest) (*NoteReadResponse, error)
-	// NoteDelete удаление
-	NoteDelete(context.Context, *NoteDelRequest) (*empty.Empty, error)
-	// BinaryList - список
-	BinaryList(context.Context, *empty.Empty) (*BinaryListResponse, error)
-	// BinaryWrite запись нового
-	BinaryWrite(context.Context, *BinaryWriteRequest) (*BinaryWriteResponse, error)
-	// BinaryUpdate обновление
-	BinaryUpdate(context.Context, *BinaryUpdateRequest) (*empty.Empty, error)
-	// BinaryRead чтение информации о двоичных данных
-	BinaryRead(context.Context, *BinaryReadRequest) (*BinaryReadResponse, error)
-	// BinaryDelete удаление
-	BinaryDelete(context.Context, *BinaryDelRequest) (*empty.Empty, error)
-	// BinaryUpload потоковая выгрузка бинарника
-	BinaryUpload(GophKeeper_BinaryUploadServer) error
-	// BinaryDownload потоковая загрузка
-	BinaryDownload(*BidaryDownloadRequest, GophKeeper_BinaryDownloadServer) error
-	mustEmbedUnimplementedGophKeeperServer()
+func (c *gophKeeperClient) BinaryTransfer(ctx context.Context, in *BinaryTransferRequest, opts ...grpc.CallOption) (*BinaryTransferResponse, error) {
+	out := new(BinaryTransferResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_BinaryTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedGophKeeperServer must be embedded to have forward compatible implementations.
-type UnimplementedGophKeeperServer struct {
+func (c *gophKeeperClient) BinaryPartsBegin(ctx context.Context, in *BinaryPartsBeginRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_BinaryPartsBegin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedGophKeeperServer) Ping(context.Context, *empty.Empty) (*PingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
-}
-func (UnimplementedGophKeeperServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
-}
-func (UnimplementedGophKeeperServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+func (c *gophKeeperClient) BinaryUploadPart(ctx context.Context, opts ...grpc.CallOption) (GophKeeper_BinaryUploadPartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GophKeeper_ServiceDesc.Streams[3], GophKeeper_BinaryUploadPart_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gophKeeperBinaryUploadPartClient{stream}
+	return x, nil
 }
-func (UnimplementedGophKeeperServer) List(context.Context, *empty.Empty) (*ListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+
+type GophKeeper_BinaryUploadPartClient interface {
+	Send(*BinaryUploadPartStream) error
+	CloseAndRecv() (*empty.Empty, error)
+	grpc.ClientStream
 }
-func (UnimplementedGophKeeperServer) PasswordList(context.Context, *empty.Empty) (*PasswordListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PasswordList not implemented")
+
+type gophKeeperBinaryUploadPartClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGophKeeperServer) PasswordWrite(context.Context, *PasswordWriteRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PasswordWrite not implemented")
+
+func (x *gophKeeperBinaryUploadPartClient) Send(m *BinaryUploadPartStream) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (UnimplementedGophKeeperServer) PasswordUpdate(context.Context, *PasswordUpdateRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PasswordUpdate not implemented")
+
+func (x *gophKeeperBinaryUploadPartClient) CloseAndRecv() (*empty.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(empty.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGophKeeperServer) PasswordRead(context.Context, *PasswordReadRequest) (*PasswordReadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PasswordRead not implemented")
+
+func (c *gophKeeperClient) BinaryPartsComplete(ctx context.Context, in *BinaryPartsCompleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_BinaryPartsComplete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGophKeeperServer) PasswordDelete(context.Context, *PasswordDelRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PasswordDelete not implemented")
+
+func (c *gophKeeperClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_Rename_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGophKeeperServer) CardList(context.Context, *empty.Empty) (*CardListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CardList not implemented")
+
+func (c *gophKeeperClient) TagAdd(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_TagAdd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) TagRemove(ctx context.Context, in *TagRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_TagRemove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) TagList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TagListResponse, error) {
+	out := new(TagListResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_TagList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) FolderMove(ctx context.Context, in *FolderMoveRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_FolderMove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) FolderList(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FolderListResponse, error) {
+	out := new(FolderListResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_FolderList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) FolderDelete(ctx context.Context, in *FolderDelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, GophKeeper_FolderDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gophKeeperClient) Watch(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (GophKeeper_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GophKeeper_ServiceDesc.Streams[4], GophKeeper_Watch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gophKeeperWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GophKeeper_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type gophKeeperWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *gophKeeperWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gophKeeperClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, GophKeeper_Batch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GophKeeperServer is the server API for GophKeeper service.
+// All implementations must embed UnimplementedGophKeeperServer
+// for forward compatibility
+type GophKeeperServer interface {
+	// Ping проверка соединения
+	Ping(context.Context, *empty.Empty) (*PingResponse, error)
+	// Register регистрация нового пользователя
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// Login регистрация нового пользователя
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// List возвращает количество хранимых данных пользователя
+	// и ограничения хранилища (защищённый)
+	List(context.Context, *empty.Empty) (*ListResponse, error)
+	// PasswordList - возвращает список паролей пользователя
+	PasswordList(context.Context, *ListRequest) (*PasswordListResponse, error)
+	// PasswordWrite запись нового пароля
+	PasswordWrite(context.Context, *PasswordWriteRequest) (*empty.Empty, error)
+	// PasswordUpdate обновление имеющегочя пароля
+	PasswordUpdate(context.Context, *PasswordUpdateRequest) (*empty.Empty, error)
+	// PasswordRead удаление пароля
+	PasswordRead(context.Context, *PasswordReadRequest) (*PasswordReadResponse, error)
+	// PasswordDelete удаление пароля
+	PasswordDelete(context.Context, *PasswordDelRequest) (*empty.Empty, error)
+	// CardList - возвращает список банковских карт
+	CardList(context.Context, *ListRequest) (*CardListResponse, error)
+	// CardWrite запись нового
+	CardWrite(context.Context, *CardWriteRequest) (*empty.Empty, error)
+	// CardUpdate обновление
+	CardUpdate(context.Context, *CardUpdateRequest) (*empty.Empty, error)
+	// CardRead чтение
+	CardRead(context.Context, *CardReadRequest) (*CardReadResponse, error)
+	// CardDelete удаление
+	CardDelete(context.Context, *CardDelRequest) (*empty.Empty, error)
+	// NoteList - список
+	NoteList(context.Context, *ListRequest) (*NoteListResponse, error)
+	// NoteWrite запись нового
+	NoteWrite(context.Context, *NoteWriteRequest) (*empty.Empty, error)
+	// NoteUpdate обновление
+	NoteUpdate(context.Context, *NoteUpdateRequest) (*empty.Empty, error)
+	// NoteRead чтение
+	NoteRead(context.Context, *NoteReadRequest) (*NoteReadResponse, error)
+	// NoteDelete удаление
+	NoteDelete(context.Context, *NoteDelRequest) (*empty.Empty, error)
+	// ItemList - список
+	ItemList(context.Context, *ListRequest) (*ItemListResponse, error)
+	// ItemWrite запись нового
+	ItemWrite(context.Context, *ItemWriteRequest) (*empty.Empty, error)
+	// ItemUpdate обновление
+	ItemUpdate(context.Context, *ItemUpdateRequest) (*empty.Empty, error)
+	// ItemRead чтение
+	ItemRead(context.Context, *ItemReadRequest) (*ItemReadResponse, error)
+	// ItemDelete удаление
+	ItemDelete(context.Context, *ItemDelRequest) (*empty.Empty, error)
+	// OtpList - список
+	OtpList(context.Context, *ListRequest) (*OtpListResponse, error)
+	// OtpWrite запись нового
+	OtpWrite(context.Context, *OtpWriteRequest) (*empty.Empty, error)
+	// OtpUpdate обновление
+	OtpUpdate(context.Context, *OtpUpdateRequest) (*empty.Empty, error)
+	// OtpRead чтение
+	OtpRead(context.Context, *OtpReadRequest) (*OtpReadResponse, error)
+	// OtpDelete удаление
+	OtpDelete(context.Context, *OtpDelRequest) (*empty.Empty, error)
+	// OtpCode текущий одноразовый пароль, для HOTP счётчик увеличивается
+	OtpCode(context.Context, *OtpCodeRequest) (*OtpCodeResponse, error)
+	// SshKeyList - список
+	SshKeyList(context.Context, *ListRequest) (*SshKeyListResponse, error)
+	// SshKeyWrite запись нового: импорт или создание ключа
+	SshKeyWrite(context.Context, *SshKeyWriteRequest) (*empty.Empty, error)
+	// SshKeyUpdate обновление
+	SshKeyUpdate(context.Context, *SshKeyUpdateRequest) (*empty.Empty, error)
+	// SshKeyRead чтение
+	SshKeyRead(context.Context, *SshKeyReadRequest) (*SshKeyReadResponse, error)
+	// SshKeyDelete удаление
+	SshKeyDelete(context.Context, *SshKeyDelRequest) (*empty.Empty, error)
+	// BinaryList - список
+	BinaryList(context.Context, *ListRequest) (*BinaryListResponse, error)
+	// BinaryWrite запись нового
+	BinaryWrite(context.Context, *BinaryWriteRequest) (*BinaryWriteResponse, error)
+	// BinaryUpdate обновление
+	BinaryUpdate(context.Context, *BinaryUpdateRequest) (*empty.Empty, error)
+	// BinaryRead чтение информации о двоичных данных
+	BinaryRead(context.Context, *BinaryReadRequest) (*BinaryReadResponse, error)
+	// BinaryDelete удаление
+	BinaryDelete(context.Context, *BinaryDelRequest) (*empty.Empty, error)
+	// BinaryUpload потоковая выгрузка бинарника
+	BinaryUpload(GophKeeper_BinaryUploadServer) error
+	// BinaryPut создание файла вместе с содержимым одним потоком
+	BinaryPut(GophKeeper_BinaryPutServer) error
+	// BinaryUploadStatus состояние загрузки для её продолжения после обрыва
+	BinaryUploadStatus(context.Context, *BinaryUploadStatusRequest) (*BinaryUploadStatusResponse, error)
+	// BinaryDownload потоковая загрузка
+	BinaryDownload(*BidaryDownloadRequest, GophKeeper_BinaryDownloadServer) error
+	// BinaryTransfer согласование размера фрагмента и числа параллельных частей
+	BinaryTransfer(context.Context, *BinaryTransferRequest) (*BinaryTransferResponse, error)
+	// BinaryPartsBegin начало загрузки нового содержимого параллельными частями
+	BinaryPartsBegin(context.Context, *BinaryPartsBeginRequest) (*empty.Empty, error)
+	// BinaryUploadPart потоковая загрузка одной части
+	BinaryUploadPart(GophKeeper_BinaryUploadPartServer) error
+	// BinaryPartsComplete сборка загруженных частей в содержимое файла
+	BinaryPartsComplete(context.Context, *BinaryPartsCompleteRequest) (*empty.Empty, error)
+	// Rename переименование элемента любого вида с сохранением идентификатора и содержимого
+	Rename(context.Context, *RenameRequest) (*empty.Empty, error)
+	// TagAdd добавление меток элементу
+	TagAdd(context.Context, *TagRequest) (*empty.Empty, error)
+	// TagRemove снятие меток с элемента
+	TagRemove(context.Context, *TagRequest) (*empty.Empty, error)
+	// TagList список используемых меток
+	TagList(context.Context, *empty.Empty) (*TagListResponse, error)
+	// FolderMove перемещение элемента в папку, недостающие папки создаются
+	FolderMove(context.Context, *FolderMoveRequest) (*empty.Empty, error)
+	// FolderList список папок
+	FolderList(context.Context, *empty.Empty) (*FolderListResponse, error)
+	// FolderDelete удаление папки с вложенными, элементы переносятся в корень
+	FolderDelete(context.Context, *FolderDelRequest) (*empty.Empty, error)
+	// Search нечёткий поиск по наименованиям, меткам и открытым полям элементов всех видов
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Watch потоковая подписка на изменения хранилища пользователя
+	Watch(*empty.Empty, GophKeeper_WatchServer) error
+	// Batch пакетное выполнение операций записи в одной транзакции
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+	mustEmbedUnimplementedGophKeeperServer()
+}
+
+// UnimplementedGophKeeperServer must be embedded to have forward compatible implementations.
+type UnimplementedGophKeeperServer struct {
+}
+
+func (UnimplementedGophKeeperServer) Ping(context.Context, *empty.Empty) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedGophKeeperServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedGophKeeperServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedGophKeeperServer) List(context.Context, *empty.Empty) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGophKeeperServer) PasswordList(context.Context, *ListRequest) (*PasswordListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PasswordList not implemented")
+}
+func (UnimplementedGophKeeperServer) PasswordWrite(context.Context, *PasswordWriteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PasswordWrite not implemented")
+}
+func (UnimplementedGophKeeperServer) PasswordUpdate(context.Context, *PasswordUpdateRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PasswordUpdate not implemented")
+}
+func (UnimplementedGophKeeperServer) PasswordRead(context.Context, *PasswordReadRequest) (*PasswordReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PasswordRead not implemented")
+}
+func (UnimplementedGophKeeperServer) PasswordDelete(context.Context, *PasswordDelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PasswordDelete not implemented")
+}
+func (UnimplementedGophKeeperServer) CardList(context.Context, *ListRequest) (*CardListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CardList not implemented")
 }
 func (UnimplementedGophKeeperServer) CardWrite(context.Context, *CardWriteRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CardWrite not implemented")
@@ -501,7 +1024,7 @@ func (UnimplementedGophKeeperServer) CardRead(context.Context, *CardReadRequest)
 func (UnimplementedGophKeeperServer) CardDelete(context.Context, *CardDelRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CardDelete not implemented")
 }
-func (UnimplementedGophKeeperServer) NoteList(context.Context, *empty.Empty) (*NoteListResponse, error) {
+func (UnimplementedGophKeeperServer) NoteList(context.Context, *ListRequest) (*NoteListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NoteList not implemented")
 }
 func (UnimplementedGophKeeperServer) NoteWrite(context.Context, *NoteWriteRequest) (*empty.Empty, error) {
@@ -510,523 +1033,1214 @@ func (UnimplementedGophKeeperServer) NoteWrite(context.Context, *NoteWriteReques
 func (UnimplementedGophKeeperServer) NoteUpdate(context.Context, *NoteUpdateRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NoteUpdate not implemented")
 }
-func (UnimplementedGophKeeperServer) NoteRead(context.Context, *NoteReadRequest) (*NoteReadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NoteRead not implemented")
+func (UnimplementedGophKeeperServer) NoteRead(context.Context, *NoteReadRequest) (*NoteReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NoteRead not implemented")
+}
+func (UnimplementedGophKeeperServer) NoteDelete(context.Context, *NoteDelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NoteDelete not implemented")
+}
+func (UnimplementedGophKeeperServer) ItemList(context.Context, *ListRequest) (*ItemListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ItemList not implemented")
+}
+func (UnimplementedGophKeeperServer) ItemWrite(context.Context, *ItemWriteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ItemWrite not implemented")
+}
+func (UnimplementedGophKeeperServer) ItemUpdate(context.Context, *ItemUpdateRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ItemUpdate not implemented")
+}
+func (UnimplementedGophKeeperServer) ItemRead(context.Context, *ItemReadRequest) (*ItemReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ItemRead not implemented")
+}
+func (UnimplementedGophKeeperServer) ItemDelete(context.Context, *ItemDelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ItemDelete not implemented")
+}
+func (UnimplementedGophKeeperServer) OtpList(context.Context, *ListRequest) (*OtpListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OtpList not implemented")
+}
+func (UnimplementedGophKeeperServer) OtpWrite(context.Context, *OtpWriteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OtpWrite not implemented")
+}
+func (UnimplementedGophKeeperServer) OtpUpdate(context.Context, *OtpUpdateRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OtpUpdate not implemented")
+}
+func (UnimplementedGophKeeperServer) OtpRead(context.Context, *OtpReadRequest) (*OtpReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OtpRead not implemented")
+}
+func (UnimplementedGophKeeperServer) OtpDelete(context.Context, *OtpDelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OtpDelete not implemented")
+}
+func (UnimplementedGophKeeperServer) OtpCode(context.Context, *OtpCodeRequest) (*OtpCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OtpCode not implemented")
+}
+func (UnimplementedGophKeeperServer) SshKeyList(context.Context, *ListRequest) (*SshKeyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SshKeyList not implemented")
+}
+func (UnimplementedGophKeeperServer) SshKeyWrite(context.Context, *SshKeyWriteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SshKeyWrite not implemented")
+}
+func (UnimplementedGophKeeperServer) SshKeyUpdate(context.Context, *SshKeyUpdateRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SshKeyUpdate not implemented")
+}
+func (UnimplementedGophKeeperServer) SshKeyRead(context.Context, *SshKeyReadRequest) (*SshKeyReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SshKeyRead not implemented")
+}
+func (UnimplementedGophKeeperServer) SshKeyDelete(context.Context, *SshKeyDelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SshKeyDelete not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryList(context.Context, *ListRequest) (*BinaryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryList not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryWrite(context.Context, *BinaryWriteRequest) (*BinaryWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryWrite not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryUpdate(context.Context, *BinaryUpdateRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryUpdate not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryRead(context.Context, *BinaryReadRequest) (*BinaryReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryRead not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryDelete(context.Context, *BinaryDelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryDelete not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryUpload(GophKeeper_BinaryUploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method BinaryUpload not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryPut(GophKeeper_BinaryPutServer) error {
+	return status.Errorf(codes.Unimplemented, "method BinaryPut not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryUploadStatus(context.Context, *BinaryUploadStatusRequest) (*BinaryUploadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryUploadStatus not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryDownload(*BidaryDownloadRequest, GophKeeper_BinaryDownloadServer) error {
+	return status.Errorf(codes.Unimplemented, "method BinaryDownload not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryTransfer(context.Context, *BinaryTransferRequest) (*BinaryTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryTransfer not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryPartsBegin(context.Context, *BinaryPartsBeginRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryPartsBegin not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryUploadPart(GophKeeper_BinaryUploadPartServer) error {
+	return status.Errorf(codes.Unimplemented, "method BinaryUploadPart not implemented")
+}
+func (UnimplementedGophKeeperServer) BinaryPartsComplete(context.Context, *BinaryPartsCompleteRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryPartsComplete not implemented")
+}
+func (UnimplementedGophKeeperServer) Rename(context.Context, *RenameRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedGophKeeperServer) TagAdd(context.Context, *TagRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagAdd not implemented")
+}
+func (UnimplementedGophKeeperServer) TagRemove(context.Context, *TagRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagRemove not implemented")
+}
+func (UnimplementedGophKeeperServer) TagList(context.Context, *empty.Empty) (*TagListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagList not implemented")
+}
+func (UnimplementedGophKeeperServer) FolderMove(context.Context, *FolderMoveRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FolderMove not implemented")
+}
+func (UnimplementedGophKeeperServer) FolderList(context.Context, *empty.Empty) (*FolderListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FolderList not implemented")
+}
+func (UnimplementedGophKeeperServer) FolderDelete(context.Context, *FolderDelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FolderDelete not implemented")
+}
+func (UnimplementedGophKeeperServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedGophKeeperServer) Watch(*empty.Empty, GophKeeper_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedGophKeeperServer) Batch(context.Context, *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedGophKeeperServer) mustEmbedUnimplementedGophKeeperServer() {}
+
+// UnsafeGophKeeperServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GophKeeperServer will
+// result in compilation errors.
+type UnsafeGophKeeperServer interface {
+	mustEmbedUnimplementedGophKeeperServer()
+}
+
+func RegisterGophKeeperServer(s grpc.ServiceRegistrar, srv GophKeeperServer) {
+	s.RegisterService(&GophKeeper_ServiceDesc, srv)
+}
+
+func _GophKeeper_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).Ping(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).List(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_PasswordList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).PasswordList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_PasswordList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).PasswordList(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_PasswordWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).PasswordWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_PasswordWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).PasswordWrite(ctx, req.(*PasswordWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_PasswordUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).PasswordUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_PasswordUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).PasswordUpdate(ctx, req.(*PasswordUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_PasswordRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).PasswordRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_PasswordRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).PasswordRead(ctx, req.(*PasswordReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_PasswordDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).PasswordDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_PasswordDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).PasswordDelete(ctx, req.(*PasswordDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_CardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).CardList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_CardList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).CardList(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_CardWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).CardWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_CardWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).CardWrite(ctx, req.(*CardWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_CardUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).CardUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_CardUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).CardUpdate(ctx, req.(*CardUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_CardRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).CardRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_CardRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).CardRead(ctx, req.(*CardReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_CardDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CardDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).CardDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_CardDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).CardDelete(ctx, req.(*CardDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_NoteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).NoteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_NoteList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).NoteList(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_NoteWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).NoteWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_NoteWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).NoteWrite(ctx, req.(*NoteWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_NoteUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).NoteUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_NoteUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).NoteUpdate(ctx, req.(*NoteUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GophKeeper_NoteRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).NoteRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_NoteRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).NoteRead(ctx, req.(*NoteReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophKeeperServer) NoteDelete(context.Context, *NoteDelRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NoteDelete not implemented")
+
+func _GophKeeper_NoteDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).NoteDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_NoteDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).NoteDelete(ctx, req.(*NoteDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophKeeperServer) BinaryList(context.Context, *empty.Empty) (*BinaryListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BinaryList not implemented")
+
+func _GophKeeper_ItemList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).ItemList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_ItemList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).ItemList(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophKeeperServer) BinaryWrite(context.Context, *BinaryWriteRequest) (*BinaryWriteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BinaryWrite not implemented")
+
+func _GophKeeper_ItemWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).ItemWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_ItemWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).ItemWrite(ctx, req.(*ItemWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophKeeperServer) BinaryUpdate(context.Context, *BinaryUpdateRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BinaryUpdate not implemented")
+
+func _GophKeeper_ItemUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).ItemUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_ItemUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).ItemUpdate(ctx, req.(*ItemUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophKeeperServer) BinaryRead(context.Context, *BinaryReadRequest) (*BinaryReadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BinaryRead not implemented")
+
+func _GophKeeper_ItemRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).ItemRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_ItemRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).ItemRead(ctx, req.(*ItemReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophKeeperServer) BinaryDelete(context.Context, *BinaryDelRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BinaryDelete not implemented")
+
+func _GophKeeper_ItemDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).ItemDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_ItemDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).ItemDelete(ctx, req.(*ItemDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophKeeperServer) BinaryUpload(GophKeeper_BinaryUploadServer) error {
-	return status.Errorf(codes.Unimplemented, "method BinaryUpload not implemented")
+
+func _GophKeeper_OtpList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).OtpList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_OtpList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).OtpList(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophKeeperServer) BinaryDownload(*BidaryDownloadRequest, GophKeeper_BinaryDownloadServer) error {
-	return status.Errorf(codes.Unimplemented, "method BinaryDownload not implemented")
+
+func _GophKeeper_OtpWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OtpWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).OtpWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_OtpWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).OtpWrite(ctx, req.(*OtpWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGophKeeperServer) mustEmbedUnimplementedGophKeeperServer() {}
 
-// UnsafeGophKeeperServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GophKeeperServer will
-// result in compilation errors.
-type UnsafeGophKeeperServer interface {
-	mustEmbedUnimplementedGophKeeperServer()
+func _GophKeeper_OtpUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OtpUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).OtpUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_OtpUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).OtpUpdate(ctx, req.(*OtpUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGophKeeperServer(s grpc.ServiceRegistrar, srv GophKeeperServer) {
-	s.RegisterService(&GophKeeper_ServiceDesc, srv)
+func _GophKeeper_OtpRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OtpReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GophKeeperServer).OtpRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_OtpRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).OtpRead(ctx, req.(*OtpReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _GophKeeper_OtpDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OtpDelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).Ping(ctx, in)
+		return srv.(GophKeeperServer).OtpDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_Ping_FullMethodName,
+		FullMethod: GophKeeper_OtpDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).Ping(ctx, req.(*empty.Empty))
+		return srv.(GophKeeperServer).OtpDelete(ctx, req.(*OtpDelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _GophKeeper_OtpCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OtpCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).Register(ctx, in)
+		return srv.(GophKeeperServer).OtpCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_Register_FullMethodName,
+		FullMethod: GophKeeper_OtpCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(GophKeeperServer).OtpCode(ctx, req.(*OtpCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _GophKeeper_SshKeyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).Login(ctx, in)
+		return srv.(GophKeeperServer).SshKeyList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_Login_FullMethodName,
+		FullMethod: GophKeeper_SshKeyList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).Login(ctx, req.(*LoginRequest))
+		return srv.(GophKeeperServer).SshKeyList(ctx, req.(*ListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _GophKeeper_SshKeyWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SshKeyWriteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).List(ctx, in)
+		return srv.(GophKeeperServer).SshKeyWrite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_List_FullMethodName,
+		FullMethod: GophKeeper_SshKeyWrite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).List(ctx, req.(*empty.Empty))
+		return srv.(GophKeeperServer).SshKeyWrite(ctx, req.(*SshKeyWriteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_PasswordList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _GophKeeper_SshKeyUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SshKeyUpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).PasswordList(ctx, in)
+		return srv.(GophKeeperServer).SshKeyUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_PasswordList_FullMethodName,
+		FullMethod: GophKeeper_SshKeyUpdate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).PasswordList(ctx, req.(*empty.Empty))
+		return srv.(GophKeeperServer).SshKeyUpdate(ctx, req.(*SshKeyUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_PasswordWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordWriteRequest)
+func _GophKeeper_SshKeyRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SshKeyReadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).PasswordWrite(ctx, in)
+		return srv.(GophKeeperServer).SshKeyRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_PasswordWrite_FullMethodName,
+		FullMethod: GophKeeper_SshKeyRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).PasswordWrite(ctx, req.(*PasswordWriteRequest))
+		return srv.(GophKeeperServer).SshKeyRead(ctx, req.(*SshKeyReadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_PasswordUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordUpdateRequest)
+func _GophKeeper_SshKeyDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SshKeyDelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).PasswordUpdate(ctx, in)
+		return srv.(GophKeeperServer).SshKeyDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_PasswordUpdate_FullMethodName,
+		FullMethod: GophKeeper_SshKeyDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).PasswordUpdate(ctx, req.(*PasswordUpdateRequest))
+		return srv.(GophKeeperServer).SshKeyDelete(ctx, req.(*SshKeyDelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_PasswordRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordReadRequest)
+func _GophKeeper_BinaryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).PasswordRead(ctx, in)
+		return srv.(GophKeeperServer).BinaryList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_PasswordRead_FullMethodName,
+		FullMethod: GophKeeper_BinaryList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).PasswordRead(ctx, req.(*PasswordReadRequest))
+		return srv.(GophKeeperServer).BinaryList(ctx, req.(*ListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_PasswordDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordDelRequest)
+func _GophKeeper_BinaryWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinaryWriteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).PasswordDelete(ctx, in)
+		return srv.(GophKeeperServer).BinaryWrite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_PasswordDelete_FullMethodName,
+		FullMethod: GophKeeper_BinaryWrite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).PasswordDelete(ctx, req.(*PasswordDelRequest))
+		return srv.(GophKeeperServer).BinaryWrite(ctx, req.(*BinaryWriteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_CardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _GophKeeper_BinaryUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinaryUpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).CardList(ctx, in)
+		return srv.(GophKeeperServer).BinaryUpdate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_CardList_FullMethodName,
+		FullMethod: GophKeeper_BinaryUpdate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).CardList(ctx, req.(*empty.Empty))
+		return srv.(GophKeeperServer).BinaryUpdate(ctx, req.(*BinaryUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_CardWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CardWriteRequest)
+func _GophKeeper_BinaryRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinaryReadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).CardWrite(ctx, in)
+		return srv.(GophKeeperServer).BinaryRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_CardWrite_FullMethodName,
+		FullMethod: GophKeeper_BinaryRead_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).CardWrite(ctx, req.(*CardWriteRequest))
+		return srv.(GophKeeperServer).BinaryRead(ctx, req.(*BinaryReadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_CardUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CardUpdateRequest)
+func _GophKeeper_BinaryDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinaryDelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).CardUpdate(ctx, in)
+		return srv.(GophKeeperServer).BinaryDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_CardUpdate_FullMethodName,
+		FullMethod: GophKeeper_BinaryDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).CardUpdate(ctx, req.(*CardUpdateRequest))
+		return srv.(GophKeeperServer).BinaryDelete(ctx, req.(*BinaryDelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_CardRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CardReadRequest)
+func _GophKeeper_BinaryUpload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GophKeeperServer).BinaryUpload(&gophKeeperBinaryUploadServer{stream})
+}
+
+type GophKeeper_BinaryUploadServer interface {
+	SendAndClose(*empty.Empty) error
+	Recv() (*BinaryUplodStream, error)
+	grpc.ServerStream
+}
+
+type gophKeeperBinaryUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *gophKeeperBinaryUploadServer) SendAndClose(m *empty.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gophKeeperBinaryUploadServer) Recv() (*BinaryUplodStream, error) {
+	m := new(BinaryUplodStream)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GophKeeper_BinaryPut_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GophKeeperServer).BinaryPut(&gophKeeperBinaryPutServer{stream})
+}
+
+type GophKeeper_BinaryPutServer interface {
+	SendAndClose(*BinaryWriteResponse) error
+	Recv() (*BinaryPutStream, error)
+	grpc.ServerStream
+}
+
+type gophKeeperBinaryPutServer struct {
+	grpc.ServerStream
+}
+
+func (x *gophKeeperBinaryPutServer) SendAndClose(m *BinaryWriteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gophKeeperBinaryPutServer) Recv() (*BinaryPutStream, error) {
+	m := new(BinaryPutStream)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GophKeeper_BinaryUploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinaryUploadStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).CardRead(ctx, in)
+		return srv.(GophKeeperServer).BinaryUploadStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_CardRead_FullMethodName,
+		FullMethod: GophKeeper_BinaryUploadStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).CardRead(ctx, req.(*CardReadRequest))
+		return srv.(GophKeeperServer).BinaryUploadStatus(ctx, req.(*BinaryUploadStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_CardDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CardDelRequest)
+func _GophKeeper_BinaryDownload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BidaryDownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GophKeeperServer).BinaryDownload(m, &gophKeeperBinaryDownloadServer{stream})
+}
+
+type GophKeeper_BinaryDownloadServer interface {
+	Send(*BinaryDownloadStream) error
+	grpc.ServerStream
+}
+
+type gophKeeperBinaryDownloadServer struct {
+	grpc.ServerStream
+}
+
+func (x *gophKeeperBinaryDownloadServer) Send(m *BinaryDownloadStream) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GophKeeper_BinaryTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinaryTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).CardDelete(ctx, in)
+		return srv.(GophKeeperServer).BinaryTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_CardDelete_FullMethodName,
+		FullMethod: GophKeeper_BinaryTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).CardDelete(ctx, req.(*CardDelRequest))
+		return srv.(GophKeeperServer).BinaryTransfer(ctx, req.(*BinaryTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_NoteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _GophKeeper_BinaryPartsBegin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinaryPartsBeginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).NoteList(ctx, in)
+		return srv.(GophKeeperServer).BinaryPartsBegin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_NoteList_FullMethodName,
+		FullMethod: GophKeeper_BinaryPartsBegin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).NoteList(ctx, req.(*empty.Empty))
+		return srv.(GophKeeperServer).BinaryPartsBegin(ctx, req.(*BinaryPartsBeginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_NoteWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NoteWriteRequest)
+func _GophKeeper_BinaryUploadPart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GophKeeperServer).BinaryUploadPart(&gophKeeperBinaryUploadPartServer{stream})
+}
+
+type GophKeeper_BinaryUploadPartServer interface {
+	SendAndClose(*empty.Empty) error
+	Recv() (*BinaryUploadPartStream, error)
+	grpc.ServerStream
+}
+
+type gophKeeperBinaryUploadPartServer struct {
+	grpc.ServerStream
+}
+
+func (x *gophKeeperBinaryUploadPartServer) SendAndClose(m *empty.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gophKeeperBinaryUploadPartServer) Recv() (*BinaryUploadPartStream, error) {
+	m := new(BinaryUploadPartStream)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GophKeeper_BinaryPartsComplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BinaryPartsCompleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).NoteWrite(ctx, in)
+		return srv.(GophKeeperServer).BinaryPartsComplete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_NoteWrite_FullMethodName,
+		FullMethod: GophKeeper_BinaryPartsComplete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).NoteWrite(ctx, req.(*NoteWriteRequest))
+		return srv.(GophKeeperServer).BinaryPartsComplete(ctx, req.(*BinaryPartsCompleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_NoteUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NoteUpdateRequest)
+func _GophKeeper_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).NoteUpdate(ctx, in)
+		return srv.(GophKeeperServer).Rename(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_NoteUpdate_FullMethodName,
+		FullMethod: GophKeeper_Rename_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).NoteUpdate(ctx, req.(*NoteUpdateRequest))
+		return srv.(GophKeeperServer).Rename(ctx, req.(*RenameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_NoteRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NoteReadRequest)
+func _GophKeeper_TagAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).NoteRead(ctx, in)
+		return srv.(GophKeeperServer).TagAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_NoteRead_FullMethodName,
+		FullMethod: GophKeeper_TagAdd_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).NoteRead(ctx, req.(*NoteReadRequest))
+		return srv.(GophKeeperServer).TagAdd(ctx, req.(*TagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_NoteDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NoteDelRequest)
+func _GophKeeper_TagRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).NoteDelete(ctx, in)
+		return srv.(GophKeeperServer).TagRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_NoteDelete_FullMethodName,
+		FullMethod: GophKeeper_TagRemove_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).NoteDelete(ctx, req.(*NoteDelRequest))
+		return srv.(GophKeeperServer).TagRemove(ctx, req.(*TagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_BinaryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _GophKeeper_TagList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).BinaryList(ctx, in)
+		return srv.(GophKeeperServer).TagList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_BinaryList_FullMethodName,
+		FullMethod: GophKeeper_TagList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).BinaryList(ctx, req.(*empty.Empty))
+		return srv.(GophKeeperServer).TagList(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_BinaryWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BinaryWriteRequest)
+func _GophKeeper_FolderMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FolderMoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).BinaryWrite(ctx, in)
+		return srv.(GophKeeperServer).FolderMove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_BinaryWrite_FullMethodName,
+		FullMethod: GophKeeper_FolderMove_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).BinaryWrite(ctx, req.(*BinaryWriteRequest))
+		return srv.(GophKeeperServer).FolderMove(ctx, req.(*FolderMoveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_BinaryUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BinaryUpdateRequest)
+func _GophKeeper_FolderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).BinaryUpdate(ctx, in)
+		return srv.(GophKeeperServer).FolderList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_BinaryUpdate_FullMethodName,
+		FullMethod: GophKeeper_FolderList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).BinaryUpdate(ctx, req.(*BinaryUpdateRequest))
+		return srv.(GophKeeperServer).FolderList(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_BinaryRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BinaryReadRequest)
+func _GophKeeper_FolderDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FolderDelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).BinaryRead(ctx, in)
+		return srv.(GophKeeperServer).FolderDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_BinaryRead_FullMethodName,
+		FullMethod: GophKeeper_FolderDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).BinaryRead(ctx, req.(*BinaryReadRequest))
+		return srv.(GophKeeperServer).FolderDelete(ctx, req.(*FolderDelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_BinaryDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BinaryDelRequest)
+func _GophKeeper_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GophKeeperServer).BinaryDelete(ctx, in)
+		return srv.(GophKeeperServer).Search(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GophKeeper_BinaryDelete_FullMethodName,
+		FullMethod: GophKeeper_Search_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GophKeeperServer).BinaryDelete(ctx, req.(*BinaryDelRequest))
+		return srv.(GophKeeperServer).Search(ctx, req.(*SearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GophKeeper_BinaryUpload_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(GophKeeperServer).BinaryUpload(&gophKeeperBinaryUploadServer{stream})
+func _GophKeeper_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GophKeeperServer).Watch(m, &gophKeeperWatchServer{stream})
 }
 
-type GophKeeper_BinaryUploadServer interface {
-	SendAndClose(*empty.Empty) error
-	Recv() (*BinaryUplodStream, error)
+type GophKeeper_WatchServer interface {
+	Send(*WatchEvent) error
 	grpc.ServerStream
 }
 
-type gophKeeperBinaryUploadServer struct {
+type gophKeeperWatchServer struct {
 	grpc.ServerStream
 }
 
-func (x *gophKeeperBinaryUploadServer) SendAndClose(m *empty.Empty) error {
+func (x *gophKeeperWatchServer) Send(m *WatchEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *gophKeeperBinaryUploadServer) Recv() (*BinaryUplodStream, error) {
-	m := new(BinaryUplodStream)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _GophKeeper_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-func _GophKeeper_BinaryDownload_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(BidaryDownloadRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(GophKeeperServer).Batch(ctx, in)
 	}
-	return srv.(GophKeeperServer).BinaryDownload(m, &gophKeeperBinaryDownloadServer{stream})
-}
-
-type GophKeeper_BinaryDownloadServer interface {
-	Send(*BinaryDownloadStream) error
-	grpc.ServerStream
-}
-
-type gophKeeperBinaryDownloadServer struct {
-	grpc.ServerStream
-}
-
-func (x *gophKeeperBinaryDownloadServer) Send(m *BinaryDownloadStream) error {
-	return x.ServerStream.SendMsg(m)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GophKeeper_Batch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GophKeeperServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 // GophKeeper_ServiceDesc is the grpc.ServiceDesc for GophKeeper service.
@@ -1112,6 +2326,70 @@ var GophKeeper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NoteDelete",
 			Handler:    _GophKeeper_NoteDelete_Handler,
 		},
+		{
+			MethodName: "ItemList",
+			Handler:    _GophKeeper_ItemList_Handler,
+		},
+		{
+			MethodName: "ItemWrite",
+			Handler:    _GophKeeper_ItemWrite_Handler,
+		},
+		{
+			MethodName: "ItemUpdate",
+			Handler:    _GophKeeper_ItemUpdate_Handler,
+		},
+		{
+			MethodName: "ItemRead",
+			Handler:    _GophKeeper_ItemRead_Handler,
+		},
+		{
+			MethodName: "ItemDelete",
+			Handler:    _GophKeeper_ItemDelete_Handler,
+		},
+		{
+			MethodName: "OtpList",
+			Handler:    _GophKeeper_OtpList_Handler,
+		},
+		{
+			MethodName: "OtpWrite",
+			Handler:    _GophKeeper_OtpWrite_Handler,
+		},
+		{
+			MethodName: "OtpUpdate",
+			Handler:    _GophKeeper_OtpUpdate_Handler,
+		},
+		{
+			MethodName: "OtpRead",
+			Handler:    _GophKeeper_OtpRead_Handler,
+		},
+		{
+			MethodName: "OtpDelete",
+			Handler:    _GophKeeper_OtpDelete_Handler,
+		},
+		{
+			MethodName: "OtpCode",
+			Handler:    _GophKeeper_OtpCode_Handler,
+		},
+		{
+			MethodName: "SshKeyList",
+			Handler:    _GophKeeper_SshKeyList_Handler,
+		},
+		{
+			MethodName: "SshKeyWrite",
+			Handler:    _GophKeeper_SshKeyWrite_Handler,
+		},
+		{
+			MethodName: "SshKeyUpdate",
+			Handler:    _GophKeeper_SshKeyUpdate_Handler,
+		},
+		{
+			MethodName: "SshKeyRead",
+			Handler:    _GophKeeper_SshKeyRead_Handler,
+		},
+		{
+			MethodName: "SshKeyDelete",
+			Handler:    _GophKeeper_SshKeyDelete_Handler,
+		},
 		{
 			MethodName: "BinaryList",
 			Handler:    _GophKeeper_BinaryList_Handler,
@@ -1132,6 +2410,58 @@ var GophKeeper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BinaryDelete",
 			Handler:    _GophKeeper_BinaryDelete_Handler,
 		},
+		{
+			MethodName: "BinaryUploadStatus",
+			Handler:    _GophKeeper_BinaryUploadStatus_Handler,
+		},
+		{
+			MethodName: "BinaryTransfer",
+			Handler:    _GophKeeper_BinaryTransfer_Handler,
+		},
+		{
+			MethodName: "BinaryPartsBegin",
+			Handler:    _GophKeeper_BinaryPartsBegin_Handler,
+		},
+		{
+			MethodName: "BinaryPartsComplete",
+			Handler:    _GophKeeper_BinaryPartsComplete_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _GophKeeper_Rename_Handler,
+		},
+		{
+			MethodName: "TagAdd",
+			Handler:    _GophKeeper_TagAdd_Handler,
+		},
+		{
+			MethodName: "TagRemove",
+			Handler:    _GophKeeper_TagRemove_Handler,
+		},
+		{
+			MethodName: "TagList",
+			Handler:    _GophKeeper_TagList_Handler,
+		},
+		{
+			MethodName: "FolderMove",
+			Handler:    _GophKeeper_FolderMove_Handler,
+		},
+		{
+			MethodName: "FolderList",
+			Handler:    _GophKeeper_FolderList_Handler,
+		},
+		{
+			MethodName: "FolderDelete",
+			Handler:    _GophKeeper_FolderDelete_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _GophKeeper_Search_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _GophKeeper_Batch_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1139,11 +2469,26 @@ var GophKeeper_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GophKeeper_BinaryUpload_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "BinaryPut",
+			Handler:       _GophKeeper_BinaryPut_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "BinaryDownload",
 			Handler:       _GophKeeper_BinaryDownload_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "BinaryUploadPart",
+			Handler:       _GophKeeper_BinaryUploadPart_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _GophKeeper_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/v1/gophkeeper.proto",
 }
@@ -4,7 +4,11 @@ go 1.20
 
 require (
 	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.31.0-20230914171853-63dfe56cc2c4.1
+	github.com/DATA-DOG/go-sqlmock v1.5.2
+	github.com/fsnotify/fsnotify v1.6.0
 	github.com/golang/protobuf v1.5.3
+	github.com/klauspost/compress v1.15.11
+	go.etcd.io/bbolt v1.3.8
 	go.uber.org/zap v1.26.0
 	google.golang.org/protobuf v1.31.0
 )
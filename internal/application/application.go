@@ -2,10 +2,20 @@
 package application
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/broker/memory"
+	pgbroker "github.com/eugene982/yp-gophkeeper/internal/broker/postgres"
 	"github.com/eugene982/yp-gophkeeper/internal/config"
 	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
 	aescrypt "github.com/eugene982/yp-gophkeeper/internal/crypto/aes"
+	"github.com/eugene982/yp-gophkeeper/internal/gc"
 	grpc_v1 "github.com/eugene982/yp-gophkeeper/internal/grpc/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/binary"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
 
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
 	_ "github.com/eugene982/yp-gophkeeper/internal/storage/postgres"
@@ -16,6 +26,9 @@ type Application struct {
 	grpcServer *grpc_v1.GRPCServer
 	storage    storage.Storage
 	crypt      crypt.EncryptDecryptor
+	broker     broker.Broker
+	conf       config.Config
+	stop       context.CancelFunc // остановка фоновых задач
 }
 
 // New конструктор
@@ -25,6 +38,7 @@ func New(conf config.Config) (*Application, error) {
 		err error
 	)
 
+	app.conf = conf
 	app.storage, err = storage.Open(conf.DSN, conf.MigratePath)
 	if err != nil {
 		return nil, err
@@ -35,7 +49,26 @@ func New(conf config.Config) (*Application, error) {
 		return nil, err
 	}
 
-	app.grpcServer, err = grpc_v1.NewServer(app.storage, app.crypt, conf.ServerAddres)
+	app.broker, err = newBroker(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := handler.Limits{
+		Bytes:    conf.QuotaBytes,
+		Items:    conf.QuotaItems,
+		FileSize: conf.MaxFileSize,
+	}
+
+	transfer := handler.Transfer{
+		ChunkSize:      conf.ChunkSize,
+		MaxRecvMsgSize: conf.MaxRecvMsgSize,
+		MaxSendMsgSize: conf.MaxSendMsgSize,
+		MaxParts:       conf.MaxParts,
+	}
+
+	app.grpcServer, err = grpc_v1.NewServer(app.storage, app.crypt, app.broker, limits,
+		transfer, conf.ServerAddres)
 	if err != nil {
 		return nil, err
 	}
@@ -43,13 +76,50 @@ func New(conf config.Config) (*Application, error) {
 	return &app, nil
 }
 
-// Start запуск прослушивания
+// Start запуск прослушивания, шифрования файлов, загруженных
+// до его появления, и периодической сборки мусора
 func (app *Application) Start() error {
+	var ctx context.Context
+	ctx, app.stop = context.WithCancel(context.Background())
+
+	go app.encryptPlain(ctx)
+	if app.conf.GCInterval > 0 {
+		go gc.Run(ctx, app.storage, app.conf.GCInterval, app.conf.GCGrace)
+	}
 	return app.grpcServer.Start()
 }
 
 // Stop остановка приложения
 func (app *Application) Stop() error {
+	if app.stop != nil {
+		app.stop()
+	}
 	app.grpcServer.Stop()
+	if err := app.broker.Close(); err != nil {
+		return err
+	}
 	return app.storage.Close()
 }
+
+// encryptPlain шифрование содержимого файлов без ключа
+func (app *Application) encryptPlain(ctx context.Context) {
+	n, err := binary.EncryptPlain(ctx, app.storage, app.crypt)
+	if err != nil {
+		logger.Errorf("encrypt plain binaries error: %w", err)
+	}
+	if n > 0 {
+		logger.Info("plain binaries encrypted", "count", n)
+	}
+}
+
+// newBroker брокер уведомлений об изменениях из конфигурации
+func newBroker(conf config.Config) (broker.Broker, error) {
+	switch conf.WatchBroker {
+	case "", "memory":
+		return memory.New(), nil
+	case "postgres":
+		return pgbroker.New(conf.DSN)
+	default:
+		return nil, fmt.Errorf("unknown watch broker: %s", conf.WatchBroker)
+	}
+}
@@ -0,0 +1,116 @@
+// Package broker рассылка уведомлений об изменениях хранилища
+package broker
+
+import (
+	"context"
+	"sync"
+)
+
+// Действия над данными пользователя
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+	ActionRename = "rename"
+)
+
+// размер буфера канала подписчика
+const subscriberBuffer = 64
+
+// Event событие изменения данных пользователя
+type Event struct {
+	UserID   string `json:"user_id"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	Action   string `json:"action"`
+	Revision int64  `json:"revision"`
+	OldName  string `json:"old_name,omitempty"` // прежнее наименование при переименовании
+}
+
+// Publisher публикация события
+type Publisher interface {
+	Publish(ctx context.Context, e Event) error
+}
+
+type PublisherFunc func(ctx context.Context, e Event) error
+
+func (f PublisherFunc) Publish(ctx context.Context, e Event) error {
+	return f(ctx, e)
+}
+
+var _ Publisher = PublisherFunc(nil)
+
+// Subscriber подписка на события пользователя.
+// Канал закрывается после завершения контекста.
+type Subscriber interface {
+	Subscribe(ctx context.Context, userID string) (<-chan Event, error)
+}
+
+type SubscriberFunc func(ctx context.Context, userID string) (<-chan Event, error)
+
+func (f SubscriberFunc) Subscribe(ctx context.Context, userID string) (<-chan Event, error) {
+	return f(ctx, userID)
+}
+
+var _ Subscriber = SubscriberFunc(nil)
+
+// Broker брокер событий
+type Broker interface {
+	Publisher
+	Subscriber
+	Close() error
+}
+
+// Hub рассылка событий подписчикам текущего процесса
+type Hub struct {
+	mu   sync.Mutex
+	subs map[string]map[chan Event]struct{}
+}
+
+// NewHub конструктор
+func NewHub() *Hub {
+	return &Hub{
+		subs: make(map[string]map[chan Event]struct{}),
+	}
+}
+
+// Subscribe регистрация нового подписчика
+func (h *Hub) Subscribe(ctx context.Context, userID string) (<-chan Event, error) {
+	ch := make(chan Event, subscriberBuffer)
+
+	h.mu.Lock()
+	if h.subs[userID] == nil {
+		h.subs[userID] = make(map[chan Event]struct{})
+	}
+	h.subs[userID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subs[userID], ch)
+		if len(h.subs[userID]) == 0 {
+			delete(h.subs, userID)
+		}
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// Broadcast отправка события всем подписчикам пользователя.
+// Возвращает количество подписчиков, не успевших принять событие.
+func (h *Hub) Broadcast(e Event) (dropped int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subs[e.UserID] {
+		select {
+		case ch <- e:
+		default:
+			dropped++
+		}
+	}
+	return
+}
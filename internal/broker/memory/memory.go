@@ -0,0 +1,49 @@
+// Package memory брокер событий в памяти процесса
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+)
+
+// Broker брокер событий одного экземпляра сервера
+type Broker struct {
+	*broker.Hub
+
+	mu        sync.Mutex
+	revisions map[string]int64
+}
+
+// Утверждение типа, ошибка компиляции
+var _ broker.Broker = (*Broker)(nil)
+
+// New конструктор
+func New() *Broker {
+	return &Broker{
+		Hub:       broker.NewHub(),
+		revisions: make(map[string]int64),
+	}
+}
+
+// Publish присваивает событию номер ревизии и рассылает подписчикам
+func (b *Broker) Publish(_ context.Context, e broker.Event) error {
+	b.mu.Lock()
+	b.revisions[e.UserID]++
+	e.Revision = b.revisions[e.UserID]
+	b.mu.Unlock()
+
+	if n := b.Hub.Broadcast(e); n > 0 {
+		logger.Warn("watch event dropped",
+			"user_id", e.UserID,
+			"subscribers", n)
+	}
+	return nil
+}
+
+// Close закрытие брокера
+func (b *Broker) Close() error {
+	return nil
+}
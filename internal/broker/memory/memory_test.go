@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+)
+
+func TestBroker(t *testing.T) {
+	b := New()
+	defer b.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	first, err := b.Subscribe(ctx, "user")
+	require.NoError(t, err)
+	second, err := b.Subscribe(ctx, "user")
+	require.NoError(t, err)
+	other, err := b.Subscribe(ctx, "other")
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		err = b.Publish(ctx, broker.Event{
+			UserID: "user",
+			Kind:   "note",
+			Name:   "note",
+			Action: broker.ActionCreate,
+		})
+		require.NoError(t, err)
+	}
+
+	for _, ch := range []<-chan broker.Event{first, second} {
+		for rev := int64(1); rev <= 2; rev++ {
+			select {
+			case e := <-ch:
+				assert.Equal(t, "note", e.Name)
+				assert.Equal(t, rev, e.Revision)
+			case <-time.After(time.Second):
+				t.Fatal("event not received")
+			}
+		}
+	}
+
+	select {
+	case e := <-other:
+		t.Fatalf("unexpected event %v", e)
+	default:
+	}
+
+	// после отмены контекста каналы закрываются
+	cancel()
+	for _, ch := range []<-chan broker.Event{first, second, other} {
+		select {
+		case _, ok := <-ch:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("channel not closed")
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Package postgres брокер событий на основе LISTEN/NOTIFY postgres.
+// События доставляются подписчикам всех экземпляров сервера,
+// подключённых к одной базе данных.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+)
+
+const (
+	// канал уведомлений
+	channel = "gophkeeper_watch"
+	// пауза перед повторным подключением слушателя
+	reconnectDelay = time.Second
+)
+
+// Broker брокер событий
+type Broker struct {
+	*broker.Hub
+
+	dsn    string
+	db     *sqlx.DB
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Утверждение типа, ошибка компиляции
+var _ broker.Broker = (*Broker)(nil)
+
+// New конструктор, запускает прослушивание канала уведомлений
+func New(dsn string) (*Broker, error) {
+	db, err := sqlx.Open("pgx", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Ping(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &Broker{
+		Hub:    broker.NewHub(),
+		dsn:    dsn,
+		db:     db,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go b.listen(ctx)
+
+	return b, nil
+}
+
+// Publish увеличивает ревизию хранилища пользователя
+// и отправляет уведомление в канал
+func (b *Broker) Publish(ctx context.Context, e broker.Event) error {
+	tx, err := b.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			logger.Error(fmt.Errorf("psql rollbacck error: %w", err))
+		}
+	}()
+
+	query := `UPDATE users SET revision = revision + 1
+		WHERE user_id = $1 RETURNING revision`
+
+	if err = tx.GetContext(ctx, &e.Revision, query, e.UserID); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `SELECT pg_notify($1, $2)`, channel, string(payload)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Close остановка прослушивания
+func (b *Broker) Close() error {
+	b.cancel()
+	<-b.done
+	return b.db.Close()
+}
+
+// listen прослушивание канала с переподключением при обрыве соединения
+func (b *Broker) listen(ctx context.Context) {
+	defer close(b.done)
+
+	for {
+		err := b.receive(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Errorf("watch listen error: %w", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (b *Broker) receive(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, b.dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err = conn.Exec(ctx, "LISTEN "+channel); err != nil {
+		return err
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var e broker.Event
+		if err = json.Unmarshal([]byte(n.Payload), &e); err != nil {
+			logger.Errorf("watch payload error: %w", err)
+			continue
+		}
+
+		if dropped := b.Hub.Broadcast(e); dropped > 0 {
+			logger.Warn("watch event dropped",
+				"user_id", e.UserID,
+				"subscribers", dropped)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package stream
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Алгоритмы сжатия блоков
+const (
+	CompressionNone = ""
+	CompressionZstd = "zstd"
+)
+
+// Признак сжатия в начале открытых данных блока
+const (
+	flagRaw  byte = 0
+	flagZstd byte = 1
+)
+
+// ZstdSlotSize место блока при сжатии: длина записи, признак и блок,
+// если сжатие не дало выигрыша. Неиспользованный хвост места остаётся
+// пустым и в большом объекте не хранится.
+const ZstdSlotSize = 4 + 1 + SealedSize
+
+// ErrCompression неизвестный алгоритм сжатия
+var ErrCompression = errors.New("unknown compression")
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// Codec запись и чтение блоков файла: сжатие, если оно задано и выгодно,
+// затем шифрование. Без сжатия блоки идут подряд по SealedSize,
+// со сжатием каждый занимает место ZstdSlotSize.
+type Codec struct {
+	cipher      *Cipher
+	compression string
+}
+
+// NewCodec кодек блоков по ключу файла и алгоритму сжатия
+func NewCodec(key []byte, compression string) (*Codec, error) {
+	switch compression {
+	case CompressionNone:
+	case CompressionZstd:
+		if err := initZstd(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrCompression, compression)
+	}
+
+	cipher, err := New(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Codec{cipher: cipher, compression: compression}, nil
+}
+
+// SlotSize место, отводимое под блок
+func (c *Codec) SlotSize() int {
+	if c.compression == CompressionZstd {
+		return ZstdSlotSize
+	}
+	return SealedSize
+}
+
+// Offset смещение блока в хранимом содержимом
+func (c *Codec) Offset(index int64) int64 {
+	return index * int64(c.SlotSize())
+}
+
+// Encode запись блока index. Несжимаемые данные хранятся как есть.
+func (c *Codec) Encode(index int64, block []byte) ([]byte, error) {
+	if c.compression == CompressionNone {
+		return c.cipher.Seal(index, block)
+	}
+
+	rec := zstdEncoder.EncodeAll(block, []byte{flagZstd})
+	if len(rec)-1 >= len(block) {
+		rec = append([]byte{flagRaw}, block...)
+	}
+
+	sealed, err := c.cipher.Seal(index, rec)
+	if err != nil {
+		return nil, err
+	}
+	res := binary.BigEndian.AppendUint32(make([]byte, 0, 4+len(sealed)), uint32(len(sealed)))
+	return append(res, sealed...), nil
+}
+
+// Record запись блока в его месте без пустого хвоста
+func (c *Codec) Record(slot []byte) ([]byte, error) {
+	if c.compression == CompressionNone {
+		return slot, nil
+	}
+	if len(slot) < 4 {
+		return nil, ErrBlock
+	}
+	n := int(binary.BigEndian.Uint32(slot))
+	if n > len(slot)-4 {
+		return nil, ErrBlock
+	}
+	return slot[:4+n], nil
+}
+
+// Decode чтение блока index из его места
+func (c *Codec) Decode(index int64, slot []byte) ([]byte, error) {
+	if c.compression == CompressionNone {
+		return c.cipher.Open(index, slot)
+	}
+
+	sealed, err := c.Record(slot)
+	if err != nil {
+		return nil, err
+	}
+	rec, err := c.cipher.Open(index, sealed[4:])
+	if err != nil {
+		return nil, err
+	}
+	if len(rec) == 0 {
+		return nil, ErrBlock
+	}
+
+	switch rec[0] {
+	case flagRaw:
+		return rec[1:], nil
+	case flagZstd:
+		res, err := zstdDecoder.DecodeAll(rec[1:], make([]byte, 0, BlockSize))
+		if err != nil || len(res) > BlockSize {
+			return nil, ErrBlock
+		}
+		return res, nil
+	}
+	return nil, ErrBlock
+}
+
+// initZstd общие кодировщик и декодировщик, безопасны для
+// одновременного использования
+func initZstd() error {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil)
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(0),
+			zstd.WithDecoderMaxMemory(2*BlockSize))
+	})
+	return zstdErr
+}
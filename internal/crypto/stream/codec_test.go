@@ -0,0 +1,110 @@
+package stream
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCodec(t *testing.T) {
+	key, err := NewKey()
+	require.NoError(t, err)
+
+	t.Run("unknown compression", func(t *testing.T) {
+		_, err := NewCodec(key, "lz4")
+		assert.ErrorIs(t, err, ErrCompression)
+	})
+
+	t.Run("layout", func(t *testing.T) {
+		none, err := NewCodec(key, CompressionNone)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2*SealedSize), none.Offset(2))
+
+		zstd, err := NewCodec(key, CompressionZstd)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2*ZstdSlotSize), zstd.Offset(2))
+	})
+}
+
+func TestCodec(t *testing.T) {
+	key, err := NewKey()
+	require.NoError(t, err)
+
+	text := bytes.Repeat([]byte("log line: request served\n"), BlockSize/25)
+	random := make([]byte, BlockSize)
+	_, err = rand.Read(random)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		compression string
+		block       []byte
+		maxSize     int // наибольший размер записи
+	}{
+		{
+			name:    "plain text",
+			block:   text,
+			maxSize: SealedSize,
+		},
+		{
+			name:        "compressible",
+			compression: CompressionZstd,
+			block:       text,
+			maxSize:     BlockSize / 10,
+		},
+		{
+			name:        "incompressible",
+			compression: CompressionZstd,
+			block:       random,
+			maxSize:     ZstdSlotSize,
+		},
+		{
+			name:        "short",
+			compression: CompressionZstd,
+			block:       []byte("abc"),
+			maxSize:     4 + 1 + Overhead + 3,
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			c, err := NewCodec(key, tcase.compression)
+			require.NoError(t, err)
+
+			rec, err := c.Encode(5, tcase.block)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, len(rec), tcase.maxSize)
+
+			// при сжатии место блока дополнено нулями до следующего блока
+			slot := rec
+			if tcase.compression != CompressionNone {
+				slot = append(rec, make([]byte, c.SlotSize()-len(rec))...)
+			}
+			plain, err := c.Decode(5, slot)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.block, plain)
+
+			stored, err := c.Record(slot)
+			require.NoError(t, err)
+			assert.Equal(t, rec, stored)
+
+			_, err = c.Decode(6, slot)
+			assert.ErrorIs(t, err, ErrBlock)
+		})
+	}
+
+	t.Run("broken length", func(t *testing.T) {
+		c, err := NewCodec(key, CompressionZstd)
+		require.NoError(t, err)
+
+		_, err = c.Decode(0, []byte{0, 0, 1, 0, 1})
+		assert.ErrorIs(t, err, ErrBlock)
+		_, err = c.Decode(0, []byte{0})
+		assert.ErrorIs(t, err, ErrBlock)
+		_, err = c.Record([]byte{0, 0, 1, 0, 1})
+		assert.ErrorIs(t, err, ErrBlock)
+	})
+}
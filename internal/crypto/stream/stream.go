@@ -0,0 +1,83 @@
+// Package stream блочное шифрование и сжатие содержимого файлов.
+// Открытые данные делятся на блоки BlockSize, каждый блок хранится как
+// nonce, шифротекст и тег AES-GCM. Номер блока входит в дополнительные
+// данные, поэтому блоки нельзя переставить незаметно. Место каждого блока
+// постоянно, что позволяет расшифровать любой диапазон без чтения
+// предыдущих блоков.
+package stream
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	KeySize    = 32                   // размер ключа файла
+	BlockSize  = 64 << 10             // размер открытого блока
+	nonceSize  = 12                   // размер nonce блока
+	tagSize    = 16                   // размер тега блока
+	Overhead   = nonceSize + tagSize  // прирост размера блока при шифровании
+	SealedSize = BlockSize + Overhead // размер зашифрованного блока
+)
+
+// ErrBlock повреждённый или чужой блок
+var ErrBlock = errors.New("invalid sealed block")
+
+// Cipher шифрование блоков одного файла
+type Cipher struct {
+	gcm cipher.AEAD
+}
+
+// NewKey случайный ключ файла
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// New шифр блоков по ключу файла
+func New(key []byte) (*Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{gcm}, nil
+}
+
+// Seal шифрование блока с номером index, nonce случайный при каждой записи
+func (c *Cipher) Seal(index int64, block []byte) ([]byte, error) {
+	res := make([]byte, nonceSize, nonceSize+len(block)+tagSize)
+	if _, err := io.ReadFull(rand.Reader, res); err != nil {
+		return nil, err
+	}
+	return c.gcm.Seal(res, res, block, indexData(index)), nil
+}
+
+// Open расшифровка блока с номером index
+func (c *Cipher) Open(index int64, sealed []byte) ([]byte, error) {
+	if len(sealed) < Overhead {
+		return nil, ErrBlock
+	}
+
+	res, err := c.gcm.Open(nil, sealed[:nonceSize], sealed[nonceSize:], indexData(index))
+	if err != nil {
+		return nil, ErrBlock
+	}
+	return res, nil
+}
+
+// indexData дополнительные данные блока
+func indexData(index int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(index))
+}
@@ -0,0 +1,76 @@
+package stream
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+
+	t.Run("error", func(t *testing.T) {
+		_, err := New([]byte("err"))
+		require.Error(t, err) // invalid key size 3
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		key, err := NewKey()
+		require.NoError(t, err)
+		require.Len(t, key, KeySize)
+
+		c, err := New(key)
+		require.NoError(t, err)
+		require.NotNil(t, c)
+	})
+}
+
+func TestSealOpen(t *testing.T) {
+	key, err := NewKey()
+	require.NoError(t, err)
+	c, err := New(key)
+	require.NoError(t, err)
+
+	block := bytes.Repeat([]byte("x"), BlockSize)
+
+	t.Run("round trip", func(t *testing.T) {
+		sealed, err := c.Seal(3, block)
+		require.NoError(t, err)
+		assert.Len(t, sealed, SealedSize)
+
+		plain, err := c.Open(3, sealed)
+		require.NoError(t, err)
+		assert.Equal(t, block, plain)
+	})
+
+	t.Run("nonce per seal", func(t *testing.T) {
+		a, err := c.Seal(0, []byte("abc"))
+		require.NoError(t, err)
+		b, err := c.Seal(0, []byte("abc"))
+		require.NoError(t, err)
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("wrong index", func(t *testing.T) {
+		sealed, err := c.Seal(1, []byte("abc"))
+		require.NoError(t, err)
+
+		_, err = c.Open(2, sealed)
+		assert.ErrorIs(t, err, ErrBlock)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		sealed, err := c.Seal(0, []byte("abc"))
+		require.NoError(t, err)
+		sealed[len(sealed)-1] ^= 1
+
+		_, err = c.Open(0, sealed)
+		assert.ErrorIs(t, err, ErrBlock)
+	})
+
+	t.Run("short", func(t *testing.T) {
+		_, err := c.Open(0, []byte("abc"))
+		assert.ErrorIs(t, err, ErrBlock)
+	})
+}
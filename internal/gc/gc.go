@@ -0,0 +1,50 @@
+// Package gc периодическая сборка мусора хранилища файлов
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type Collector interface {
+	CollectGarbage(ctx context.Context, grace time.Duration, dryRun bool) (storage.GCReport, error)
+}
+
+type CollectorFunc func(ctx context.Context, grace time.Duration, dryRun bool) (storage.GCReport, error)
+
+func (f CollectorFunc) CollectGarbage(ctx context.Context, grace time.Duration, dryRun bool) (storage.GCReport, error) {
+	return f(ctx, grace, dryRun)
+}
+
+var _ Collector = CollectorFunc(nil)
+
+// Run сборка мусора сразу и далее с интервалом interval до отмены контекста.
+// Незавершённые загрузки удаляются, если не менялись дольше grace,
+// элементы с истёкшим сроком хранения - при первой сборке после него.
+func Run(ctx context.Context, c Collector, interval, grace time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := c.CollectGarbage(ctx, grace, false)
+		if err != nil && ctx.Err() == nil {
+			logger.Errorf("collect garbage error: %w", err)
+		} else if report.Objects > 0 || report.Binaries > 0 || report.Parts > 0 || report.Expired > 0 {
+			logger.Info("garbage collected",
+				"objects", report.Objects,
+				"binaries", report.Binaries,
+				"parts", report.Parts,
+				"expired", report.Expired,
+				"bytes", report.Bytes)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
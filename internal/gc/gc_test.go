@@ -0,0 +1,44 @@
+package gc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	calls := 0
+	c := CollectorFunc(func(_ context.Context, grace time.Duration, dryRun bool) (storage.GCReport, error) {
+		assert.Equal(t, time.Hour, grace)
+		assert.False(t, dryRun)
+		calls++
+		if calls == 3 {
+			cancel()
+		}
+		if calls == 2 {
+			return storage.GCReport{}, errors.New("gc error")
+		}
+		return storage.GCReport{Objects: 1, Bytes: 10}, nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		Run(ctx, c, time.Millisecond, time.Hour)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("gc not stopped")
+	}
+	assert.Equal(t, 3, calls)
+}
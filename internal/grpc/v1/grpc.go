@@ -12,18 +12,28 @@ import (
 	"google.golang.org/grpc"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
 
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/batch"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/binary"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/card"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/folder"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/item"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/list"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/login"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/note"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/otp"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/password"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/ping"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/register"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/rename"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/search"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/sshkey"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/tag"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/watch"
 )
 
 var (
@@ -66,6 +76,27 @@ type GRPCServer struct {
 	noteDeleteHandler note.GRPCDeleteHandler
 	noteUpdateHandler note.GRPCUpdateHandler
 
+	// items
+	itemListHandler   item.GRPCListHandler
+	itemWriteHandler  item.GRPCWriteHandler
+	itemReadHandler   item.GRPCReadHandler
+	itemDeleteHandler item.GRPCDeleteHandler
+	itemUpdateHandler item.GRPCUpdateHandler
+
+	// one-time passwords
+	otpListHandler   otp.GRPCListHandler
+	otpWriteHandler  otp.GRPCWriteHandler
+	otpReadHandler   otp.GRPCReadHandler
+	otpDeleteHandler otp.GRPCDeleteHandler
+	otpUpdateHandler otp.GRPCUpdateHandler
+	otpCodeHandler   otp.GRPCCodeHandler
+
+	sshKeyListHandler   sshkey.GRPCListHandler
+	sshKeyWriteHandler  sshkey.GRPCWriteHandler
+	sshKeyReadHandler   sshkey.GRPCReadHandler
+	sshKeyDeleteHandler sshkey.GRPCDeleteHandler
+	sshKeyUpdateHandler sshkey.GRPCUpdateHandler
+
 	// binary
 	binaryListHandler     binary.GRPCListHandler
 	binaryWriteHandler    binary.GRPCWriteHandler
@@ -73,11 +104,40 @@ type GRPCServer struct {
 	binaryDeleteHandler   binary.GRPCDeleteHandler
 	binaryUpdateHandler   binary.GRPCUpdateHandler
 	binaryUploadHandler   binary.GRPCUploadHandler
+	binaryPutHandler      binary.GRPCPutHandler
+	binaryStatusHandler   binary.GRPCUploadStatusHandler
 	binaryDownloadHandler binary.GRPCDownloadHandler
+	binaryTransferHandler binary.GRPCTransferHandler
+	binaryBeginHandler    binary.GRPCPartsBeginHandler
+	binaryPartHandler     binary.GRPCUploadPartHandler
+	binaryCompleteHandler binary.GRPCPartsCompleteHandler
+
+	// rename
+	renameHandler rename.GRPCRenameHandler
+
+	// search
+	searchHandler search.GRPCSearchHandler
+
+	// tags
+	tagAddHandler    tag.GRPCAddHandler
+	tagRemoveHandler tag.GRPCRemoveHandler
+	tagListHandler   tag.GRPCListHandler
+
+	// folders
+	folderMoveHandler   folder.GRPCMoveHandler
+	folderListHandler   folder.GRPCListHandler
+	folderDeleteHandler folder.GRPCDeleteHandler
+
+	// watch
+	watchHandler watch.GRPCWatchHandler
+
+	// batch
+	batchHandler batch.GRPCBatchHandler
 }
 
 // NewServer функция-коструктор нового grps сервера
-func NewServer(store storage.Storage, crypt crypt.EncryptDecryptor, addr string) (*GRPCServer, error) {
+func NewServer(store storage.Storage, crypt crypt.EncryptDecryptor, events broker.Broker, limits handler.Limits,
+	transfer handler.Transfer, addr string) (*GRPCServer, error) {
 	var (
 		srv GRPCServer
 		err error
@@ -98,14 +158,22 @@ func NewServer(store storage.Storage, crypt crypt.EncryptDecryptor, addr string)
 	// с прослойками:
 	//	- логирования
 	//	- валидации входящих данных
-	srv.server = grpc.NewServer(
+	opts := []grpc.ServerOption{
 		grpc.ChainStreamInterceptor(
 			loggerStreamInterceptor,
 			protovalidate_middleware.StreamServerInterceptor(validator)),
 		grpc.ChainUnaryInterceptor(
 			loggerInterceptor,
 			protovalidate_middleware.UnaryServerInterceptor(validator)),
-	)
+	}
+	// размеры сообщений ограничивают согласуемый размер фрагмента
+	if transfer.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(transfer.MaxRecvMsgSize))
+	}
+	if transfer.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(transfer.MaxSendMsgSize))
+	}
+	srv.server = grpc.NewServer(opts...)
 
 	// Функция хеширования паролей
 	hashFn := func(passwd string) (string, error) {
@@ -125,41 +193,95 @@ func NewServer(store storage.Storage, crypt crypt.EncryptDecryptor, addr string)
 		return handler.GetUserIDFromMD(ctx, TokenSecretKey)
 	}
 
+	// Ограничения хранилища пользователя
+	quota := handler.NewQuota(limits, store)
+
 	// Подключаем ручки
 	srv.pingHandler = ping.NewRPCPingHandler(store)
 	srv.regHandler = register.NewRPCRegisterHandler(store, hashFn, tokenFn)
 	srv.loginHandler = login.NewRPCLoginHandler(store, checkFn, tokenFn)
-	srv.listHandler = list.NewRPCListHandler(store, getUserID)
+	srv.listHandler = list.NewRPCListHandler(store, getUserID, limits)
 
 	// Password
 	srv.passwdListHandler = password.NewGRPCListHandler(store, getUserID)
-	srv.passwdWriteHandler = password.NewGRPCWriteHandler(store, getUserID, crypt)
+	srv.passwdWriteHandler = password.NewGRPCWriteHandler(store, getUserID, crypt, events, quota)
 	srv.passwdReadHandler = password.NewGRPCReadHandler(store, getUserID, crypt)
-	srv.passwdDeleteHandler = password.NewGRPCDeleteHandler(store, getUserID)
-	srv.passwdUpdateHandler = password.NewGRPCUpdateHandler(store, getUserID, crypt)
+	srv.passwdDeleteHandler = password.NewGRPCDeleteHandler(store, getUserID, events)
+	srv.passwdUpdateHandler = password.NewGRPCUpdateHandler(store, getUserID, crypt, events)
 
 	// Payment card
 	srv.cardListHandler = card.NewGRPCListHandler(store, getUserID)
-	srv.cardWriteHandler = card.NewGRPCWriteHandler(store, getUserID, crypt)
+	srv.cardWriteHandler = card.NewGRPCWriteHandler(store, getUserID, crypt, events, quota)
 	srv.cardReadHandler = card.NewGRPCReadHandler(store, getUserID, crypt)
-	srv.cardDeleteHandler = card.NewGRPCDeleteHandler(store, getUserID)
-	srv.cardUpdateHandler = card.NewGRPCUpdateHandler(store, getUserID, crypt)
+	srv.cardDeleteHandler = card.NewGRPCDeleteHandler(store, getUserID, events)
+	srv.cardUpdateHandler = card.NewGRPCUpdateHandler(store, getUserID, crypt, events)
 
 	// Notes
 	srv.noteListHandler = note.NewGRPCListHandler(store, getUserID)
-	srv.noteWriteHandler = note.NewGRPCWriteHandler(store, getUserID, crypt)
+	srv.noteWriteHandler = note.NewGRPCWriteHandler(store, getUserID, crypt, events, quota)
 	srv.noteReadHandler = note.NewGRPCReadHandler(store, getUserID, crypt)
-	srv.noteDeleteHandler = note.NewGRPCDeleteHandler(store, getUserID)
-	srv.noteUpdateHandler = note.NewGRPCUpdateHandler(store, getUserID, crypt)
+	srv.noteDeleteHandler = note.NewGRPCDeleteHandler(store, getUserID, events)
+	srv.noteUpdateHandler = note.NewGRPCUpdateHandler(store, getUserID, crypt, events)
+
+	// Items
+	srv.itemListHandler = item.NewGRPCListHandler(store, getUserID)
+	srv.itemWriteHandler = item.NewGRPCWriteHandler(store, getUserID, crypt, events, quota)
+	srv.itemReadHandler = item.NewGRPCReadHandler(store, getUserID, crypt)
+	srv.itemDeleteHandler = item.NewGRPCDeleteHandler(store, getUserID, events)
+	srv.itemUpdateHandler = item.NewGRPCUpdateHandler(store, getUserID, crypt, events)
+
+	// One-time passwords
+	srv.otpListHandler = otp.NewGRPCListHandler(store, getUserID)
+	srv.otpWriteHandler = otp.NewGRPCWriteHandler(store, getUserID, crypt, events, quota)
+	srv.otpReadHandler = otp.NewGRPCReadHandler(store, getUserID, crypt)
+	srv.otpDeleteHandler = otp.NewGRPCDeleteHandler(store, getUserID, events)
+	srv.otpUpdateHandler = otp.NewGRPCUpdateHandler(store, getUserID, crypt, events)
+	srv.otpCodeHandler = otp.NewGRPCCodeHandler(store, store, getUserID, crypt)
+
+	srv.sshKeyListHandler = sshkey.NewGRPCListHandler(store, getUserID)
+	srv.sshKeyWriteHandler = sshkey.NewGRPCWriteHandler(store, getUserID, crypt, events, quota)
+	srv.sshKeyReadHandler = sshkey.NewGRPCReadHandler(store, getUserID, crypt)
+	srv.sshKeyDeleteHandler = sshkey.NewGRPCDeleteHandler(store, getUserID, events)
+	srv.sshKeyUpdateHandler = sshkey.NewGRPCUpdateHandler(store, getUserID, crypt, events)
 
 	// binary
 	srv.binaryListHandler = binary.NewGRPCListHandler(store, getUserID)
-	srv.binaryWriteHandler = binary.NewGRPCWriteHandler(store, getUserID, crypt)
+	srv.binaryWriteHandler = binary.NewGRPCWriteHandler(store, getUserID, crypt, events, quota)
 	srv.binaryReadHandler = binary.NewGRPCReadHandler(store, getUserID, crypt)
-	srv.binaryDeleteHandler = binary.NewGRPCDeleteHandler(store, getUserID)
-	srv.binaryUpdateHandler = binary.NewGRPCUpdateHandler(store, getUserID, crypt)
-	srv.binaryUploadHandler = binary.NewGRPCUploaderHandler(store)
-	srv.binaryDownloadHandler = binary.NewGRPCDownloadHandler(store)
+	srv.binaryDeleteHandler = binary.NewGRPCDeleteHandler(store, getUserID, events)
+	srv.binaryUpdateHandler = binary.NewGRPCUpdateHandler(store, getUserID, crypt, events, quota)
+	srv.binaryUploadHandler = binary.NewGRPCUploaderHandler(store, store, store, getUserID, crypt, quota)
+	srv.binaryPutHandler = binary.NewGRPCPutHandler(store, getUserID, crypt, events, quota)
+	srv.binaryStatusHandler = binary.NewGRPCUploadStatusHandler(store, getUserID)
+	srv.binaryDownloadHandler = binary.NewGRPCDownloadHandler(store, store, getUserID, crypt, transfer)
+	srv.binaryTransferHandler = binary.NewGRPCTransferHandler(transfer)
+	if transfer.MaxParts > 1 {
+		srv.binaryBeginHandler = binary.NewGRPCPartsBeginHandler(store, store, getUserID, crypt)
+		srv.binaryPartHandler = binary.NewGRPCUploadPartHandler(store, store, getUserID, crypt)
+		srv.binaryCompleteHandler = binary.NewGRPCPartsCompleteHandler(store, store, getUserID, crypt)
+	}
+
+	// rename
+	srv.renameHandler = rename.NewGRPCRenameHandler(store, getUserID, events)
+
+	// search
+	srv.searchHandler = search.NewGRPCSearchHandler(store, getUserID)
+
+	// tags
+	srv.tagAddHandler = tag.NewGRPCAddHandler(store, getUserID, events)
+	srv.tagRemoveHandler = tag.NewGRPCRemoveHandler(store, getUserID, events)
+	srv.tagListHandler = tag.NewGRPCListHandler(store, getUserID)
+
+	// folders
+	srv.folderMoveHandler = folder.NewGRPCMoveHandler(store, getUserID, events)
+	srv.folderListHandler = folder.NewGRPCListHandler(store, getUserID)
+	srv.folderDeleteHandler = folder.NewGRPCDeleteHandler(store, getUserID)
+
+	// watch
+	srv.watchHandler = watch.NewGRPCWatchHandler(events, getUserID)
+
+	// batch
+	srv.batchHandler = batch.NewGRPCBatchHandler(store, getUserID, crypt, events, quota)
 
 	// регистрируем сервис
 	pb.RegisterGophKeeperServer(srv.server, &srv)
@@ -209,7 +331,7 @@ func (s *GRPCServer) List(ctx context.Context, in *empty.Empty) (*pb.ListRespons
 
 // Password
 
-func (s *GRPCServer) PasswordList(ctx context.Context, in *empty.Empty) (*pb.PasswordListResponse, error) {
+func (s *GRPCServer) PasswordList(ctx context.Context, in *pb.ListRequest) (*pb.PasswordListResponse, error) {
 	if s.passwdListHandler != nil {
 		return s.passwdListHandler(ctx, in)
 	}
@@ -246,7 +368,7 @@ func (s *GRPCServer) PasswordDelete(ctx context.Context, in *pb.PasswordDelReque
 
 // Cards
 
-func (s *GRPCServer) CardList(ctx context.Context, in *empty.Empty) (*pb.CardListResponse, error) {
+func (s *GRPCServer) CardList(ctx context.Context, in *pb.ListRequest) (*pb.CardListResponse, error) {
 	if s.cardListHandler != nil {
 		return s.cardListHandler(ctx, in)
 	}
@@ -283,7 +405,7 @@ func (s *GRPCServer) CardDelete(ctx context.Context, in *pb.CardDelRequest) (*em
 
 // Notes
 
-func (s *GRPCServer) NoteList(ctx context.Context, in *empty.Empty) (*pb.NoteListResponse, error) {
+func (s *GRPCServer) NoteList(ctx context.Context, in *pb.ListRequest) (*pb.NoteListResponse, error) {
 	if s.noteListHandler != nil {
 		return s.noteListHandler(ctx, in)
 	}
@@ -318,9 +440,125 @@ func (s *GRPCServer) NoteDelete(ctx context.Context, in *pb.NoteDelRequest) (*em
 	return s.UnimplementedGophKeeperServer.NoteDelete(ctx, in)
 }
 
+// Items
+
+func (s *GRPCServer) ItemList(ctx context.Context, in *pb.ListRequest) (*pb.ItemListResponse, error) {
+	if s.itemListHandler != nil {
+		return s.itemListHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.ItemList(ctx, in)
+}
+
+func (s *GRPCServer) ItemWrite(ctx context.Context, in *pb.ItemWriteRequest) (*empty.Empty, error) {
+	if s.itemWriteHandler != nil {
+		return s.itemWriteHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.ItemWrite(ctx, in)
+}
+
+func (s *GRPCServer) ItemRead(ctx context.Context, in *pb.ItemReadRequest) (*pb.ItemReadResponse, error) {
+	if s.itemReadHandler != nil {
+		return s.itemReadHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.ItemRead(ctx, in)
+}
+
+func (s *GRPCServer) ItemUpdate(ctx context.Context, in *pb.ItemUpdateRequest) (*empty.Empty, error) {
+	if s.itemUpdateHandler != nil {
+		return s.itemUpdateHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.ItemUpdate(ctx, in)
+}
+
+func (s *GRPCServer) ItemDelete(ctx context.Context, in *pb.ItemDelRequest) (*empty.Empty, error) {
+	if s.itemDeleteHandler != nil {
+		return s.itemDeleteHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.ItemDelete(ctx, in)
+}
+
+// One-time passwords
+
+func (s *GRPCServer) OtpList(ctx context.Context, in *pb.ListRequest) (*pb.OtpListResponse, error) {
+	if s.otpListHandler != nil {
+		return s.otpListHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.OtpList(ctx, in)
+}
+
+func (s *GRPCServer) OtpWrite(ctx context.Context, in *pb.OtpWriteRequest) (*empty.Empty, error) {
+	if s.otpWriteHandler != nil {
+		return s.otpWriteHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.OtpWrite(ctx, in)
+}
+
+func (s *GRPCServer) OtpRead(ctx context.Context, in *pb.OtpReadRequest) (*pb.OtpReadResponse, error) {
+	if s.otpReadHandler != nil {
+		return s.otpReadHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.OtpRead(ctx, in)
+}
+
+func (s *GRPCServer) OtpUpdate(ctx context.Context, in *pb.OtpUpdateRequest) (*empty.Empty, error) {
+	if s.otpUpdateHandler != nil {
+		return s.otpUpdateHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.OtpUpdate(ctx, in)
+}
+
+func (s *GRPCServer) OtpDelete(ctx context.Context, in *pb.OtpDelRequest) (*empty.Empty, error) {
+	if s.otpDeleteHandler != nil {
+		return s.otpDeleteHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.OtpDelete(ctx, in)
+}
+
+func (s *GRPCServer) OtpCode(ctx context.Context, in *pb.OtpCodeRequest) (*pb.OtpCodeResponse, error) {
+	if s.otpCodeHandler != nil {
+		return s.otpCodeHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.OtpCode(ctx, in)
+}
+
+func (s *GRPCServer) SshKeyList(ctx context.Context, in *pb.ListRequest) (*pb.SshKeyListResponse, error) {
+	if s.sshKeyListHandler != nil {
+		return s.sshKeyListHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.SshKeyList(ctx, in)
+}
+
+func (s *GRPCServer) SshKeyWrite(ctx context.Context, in *pb.SshKeyWriteRequest) (*empty.Empty, error) {
+	if s.sshKeyWriteHandler != nil {
+		return s.sshKeyWriteHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.SshKeyWrite(ctx, in)
+}
+
+func (s *GRPCServer) SshKeyRead(ctx context.Context, in *pb.SshKeyReadRequest) (*pb.SshKeyReadResponse, error) {
+	if s.sshKeyReadHandler != nil {
+		return s.sshKeyReadHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.SshKeyRead(ctx, in)
+}
+
+func (s *GRPCServer) SshKeyUpdate(ctx context.Context, in *pb.SshKeyUpdateRequest) (*empty.Empty, error) {
+	if s.sshKeyUpdateHandler != nil {
+		return s.sshKeyUpdateHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.SshKeyUpdate(ctx, in)
+}
+
+func (s *GRPCServer) SshKeyDelete(ctx context.Context, in *pb.SshKeyDelRequest) (*empty.Empty, error) {
+	if s.sshKeyDeleteHandler != nil {
+		return s.sshKeyDeleteHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.SshKeyDelete(ctx, in)
+}
+
 // Binaries
 
-func (s *GRPCServer) BinaryList(ctx context.Context, in *empty.Empty) (*pb.BinaryListResponse, error) {
+func (s *GRPCServer) BinaryList(ctx context.Context, in *pb.ListRequest) (*pb.BinaryListResponse, error) {
 	if s.binaryListHandler != nil {
 		return s.binaryListHandler(ctx, in)
 	}
@@ -362,9 +600,133 @@ func (s *GRPCServer) BinaryUpload(us pb.GophKeeper_BinaryUploadServer) error {
 	return s.UnimplementedGophKeeperServer.BinaryUpload(us)
 }
 
+func (s *GRPCServer) BinaryPut(ps pb.GophKeeper_BinaryPutServer) error {
+	if s.binaryPutHandler != nil {
+		return s.binaryPutHandler(ps)
+	}
+	return s.UnimplementedGophKeeperServer.BinaryPut(ps)
+}
+
+func (s *GRPCServer) BinaryUploadStatus(ctx context.Context, in *pb.BinaryUploadStatusRequest) (*pb.BinaryUploadStatusResponse, error) {
+	if s.binaryStatusHandler != nil {
+		return s.binaryStatusHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.BinaryUploadStatus(ctx, in)
+}
+
 func (s *GRPCServer) BinaryDownload(req *pb.BidaryDownloadRequest, ds pb.GophKeeper_BinaryDownloadServer) error {
 	if s.binaryDownloadHandler != nil {
 		return s.binaryDownloadHandler(req, ds)
 	}
 	return s.UnimplementedGophKeeperServer.BinaryDownload(req, ds)
 }
+
+func (s *GRPCServer) BinaryTransfer(ctx context.Context, in *pb.BinaryTransferRequest) (*pb.BinaryTransferResponse, error) {
+	if s.binaryTransferHandler != nil {
+		return s.binaryTransferHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.BinaryTransfer(ctx, in)
+}
+
+func (s *GRPCServer) BinaryPartsBegin(ctx context.Context, in *pb.BinaryPartsBeginRequest) (*empty.Empty, error) {
+	if s.binaryBeginHandler != nil {
+		return s.binaryBeginHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.BinaryPartsBegin(ctx, in)
+}
+
+func (s *GRPCServer) BinaryUploadPart(ps pb.GophKeeper_BinaryUploadPartServer) error {
+	if s.binaryPartHandler != nil {
+		return s.binaryPartHandler(ps)
+	}
+	return s.UnimplementedGophKeeperServer.BinaryUploadPart(ps)
+}
+
+func (s *GRPCServer) BinaryPartsComplete(ctx context.Context, in *pb.BinaryPartsCompleteRequest) (*empty.Empty, error) {
+	if s.binaryCompleteHandler != nil {
+		return s.binaryCompleteHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.BinaryPartsComplete(ctx, in)
+}
+
+// Rename
+
+func (s *GRPCServer) Rename(ctx context.Context, in *pb.RenameRequest) (*empty.Empty, error) {
+	if s.renameHandler != nil {
+		return s.renameHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.Rename(ctx, in)
+}
+
+// Search
+
+func (s *GRPCServer) Search(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
+	if s.searchHandler != nil {
+		return s.searchHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.Search(ctx, in)
+}
+
+// Tags
+
+func (s *GRPCServer) TagAdd(ctx context.Context, in *pb.TagRequest) (*empty.Empty, error) {
+	if s.tagAddHandler != nil {
+		return s.tagAddHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.TagAdd(ctx, in)
+}
+
+func (s *GRPCServer) TagRemove(ctx context.Context, in *pb.TagRequest) (*empty.Empty, error) {
+	if s.tagRemoveHandler != nil {
+		return s.tagRemoveHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.TagRemove(ctx, in)
+}
+
+func (s *GRPCServer) TagList(ctx context.Context, in *empty.Empty) (*pb.TagListResponse, error) {
+	if s.tagListHandler != nil {
+		return s.tagListHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.TagList(ctx, in)
+}
+
+// Folders
+
+func (s *GRPCServer) FolderMove(ctx context.Context, in *pb.FolderMoveRequest) (*empty.Empty, error) {
+	if s.folderMoveHandler != nil {
+		return s.folderMoveHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.FolderMove(ctx, in)
+}
+
+func (s *GRPCServer) FolderList(ctx context.Context, in *empty.Empty) (*pb.FolderListResponse, error) {
+	if s.folderListHandler != nil {
+		return s.folderListHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.FolderList(ctx, in)
+}
+
+func (s *GRPCServer) FolderDelete(ctx context.Context, in *pb.FolderDelRequest) (*empty.Empty, error) {
+	if s.folderDeleteHandler != nil {
+		return s.folderDeleteHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.FolderDelete(ctx, in)
+}
+
+// Watch
+
+func (s *GRPCServer) Watch(in *empty.Empty, ws pb.GophKeeper_WatchServer) error {
+	if s.watchHandler != nil {
+		return s.watchHandler(in, ws)
+	}
+	return s.UnimplementedGophKeeperServer.Watch(in, ws)
+}
+
+// Batch
+
+func (s *GRPCServer) Batch(ctx context.Context, in *pb.BatchRequest) (*pb.BatchResponse, error) {
+	if s.batchHandler != nil {
+		return s.batchHandler(ctx, in)
+	}
+	return s.UnimplementedGophKeeperServer.Batch(ctx, in)
+}
@@ -10,19 +10,26 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/batch"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/binary"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/card"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/folder"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/list"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/login"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/note"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/password"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/ping"
 	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/register"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/rename"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/search"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/tag"
+	"github.com/eugene982/yp-gophkeeper/internal/handler/v1/watch"
 )
 
 func TestNewGRPCServer(t *testing.T) {
 
-	server, err := NewServer(nil, nil, ":8080")
+	server, err := NewServer(nil, nil, nil, handler.Limits{}, handler.Transfer{}, ":8080")
 	require.NoError(t, err)
 	require.NotNil(t, server)
 }
@@ -88,7 +95,7 @@ func TestServerHandlers(t *testing.T) {
 		require.Error(t, err)
 
 		resperr := status.Error(codes.Internal, "password list error")
-		server.passwdListHandler = password.GRPCListHandler(func(ctx context.Context, in *empty.Empty) (*pb.PasswordListResponse, error) {
+		server.passwdListHandler = password.GRPCListHandler(func(ctx context.Context, in *pb.ListRequest) (*pb.PasswordListResponse, error) {
 			return nil, resperr
 		})
 
@@ -155,7 +162,7 @@ func TestServerHandlers(t *testing.T) {
 		require.Error(t, err)
 
 		resperr := status.Error(codes.Internal, "card list error")
-		server.cardListHandler = card.GRPCListHandler(func(ctx context.Context, in *empty.Empty) (*pb.CardListResponse, error) {
+		server.cardListHandler = card.GRPCListHandler(func(ctx context.Context, in *pb.ListRequest) (*pb.CardListResponse, error) {
 			return nil, resperr
 		})
 
@@ -222,7 +229,7 @@ func TestServerHandlers(t *testing.T) {
 		require.Error(t, err)
 
 		resperr := status.Error(codes.Internal, "note list error")
-		server.noteListHandler = note.GRPCListHandler(func(ctx context.Context, in *empty.Empty) (*pb.NoteListResponse, error) {
+		server.noteListHandler = note.GRPCListHandler(func(ctx context.Context, in *pb.ListRequest) (*pb.NoteListResponse, error) {
 			return nil, resperr
 		})
 
@@ -289,7 +296,7 @@ func TestServerHandlers(t *testing.T) {
 		require.Error(t, err)
 
 		resperr := status.Error(codes.Internal, "binary list error")
-		server.binaryListHandler = binary.GRPCListHandler(func(ctx context.Context, in *empty.Empty) (*pb.BinaryListResponse, error) {
+		server.binaryListHandler = binary.GRPCListHandler(func(ctx context.Context, in *pb.ListRequest) (*pb.BinaryListResponse, error) {
 			return nil, resperr
 		})
 
@@ -362,6 +369,32 @@ func TestServerHandlers(t *testing.T) {
 		require.ErrorIs(t, err, resperr)
 	})
 
+	t.Run("binary put", func(t *testing.T) {
+		err := server.BinaryPut(nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "binary put error")
+		server.binaryPutHandler = binary.GRPCPutHandler(func(ps pb.GophKeeper_BinaryPutServer) error {
+			return resperr
+		})
+
+		err = server.BinaryPut(nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	t.Run("binary upload status", func(t *testing.T) {
+		_, err := server.BinaryUploadStatus(ctx, nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "binary upload status error")
+		server.binaryStatusHandler = binary.GRPCUploadStatusHandler(func(ctx context.Context, in *pb.BinaryUploadStatusRequest) (*pb.BinaryUploadStatusResponse, error) {
+			return nil, resperr
+		})
+
+		_, err = server.BinaryUploadStatus(ctx, nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
 	t.Run("binary download", func(t *testing.T) {
 		err := server.BinaryDownload(nil, nil)
 		require.Error(t, err)
@@ -375,4 +408,195 @@ func TestServerHandlers(t *testing.T) {
 		require.ErrorIs(t, err, resperr)
 	})
 
+	t.Run("binary transfer", func(t *testing.T) {
+		_, err := server.BinaryTransfer(ctx, nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "binary transfer error")
+		server.binaryTransferHandler = binary.GRPCTransferHandler(func(ctx context.Context, in *pb.BinaryTransferRequest) (*pb.BinaryTransferResponse, error) {
+			return nil, resperr
+		})
+
+		_, err = server.BinaryTransfer(ctx, nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	t.Run("binary parts begin", func(t *testing.T) {
+		_, err := server.BinaryPartsBegin(ctx, nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "binary parts begin error")
+		server.binaryBeginHandler = binary.GRPCPartsBeginHandler(func(ctx context.Context, in *pb.BinaryPartsBeginRequest) (*empty.Empty, error) {
+			return nil, resperr
+		})
+
+		_, err = server.BinaryPartsBegin(ctx, nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	t.Run("binary upload part", func(t *testing.T) {
+		err := server.BinaryUploadPart(nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "binary upload part error")
+		server.binaryPartHandler = binary.GRPCUploadPartHandler(func(ps pb.GophKeeper_BinaryUploadPartServer) error {
+			return resperr
+		})
+
+		err = server.BinaryUploadPart(nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	t.Run("binary parts complete", func(t *testing.T) {
+		_, err := server.BinaryPartsComplete(ctx, nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "binary parts complete error")
+		server.binaryCompleteHandler = binary.GRPCPartsCompleteHandler(func(ctx context.Context, in *pb.BinaryPartsCompleteRequest) (*empty.Empty, error) {
+			return nil, resperr
+		})
+
+		_, err = server.BinaryPartsComplete(ctx, nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	// rename
+
+	t.Run("rename", func(t *testing.T) {
+		_, err := server.Rename(context.Background(), nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "rename error")
+		server.renameHandler = rename.GRPCRenameHandler(func(ctx context.Context, in *pb.RenameRequest) (*empty.Empty, error) {
+			return nil, resperr
+		})
+
+		_, err = server.Rename(context.Background(), nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	// search
+
+	t.Run("search", func(t *testing.T) {
+		_, err := server.Search(context.Background(), nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "search error")
+		server.searchHandler = search.GRPCSearchHandler(func(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
+			return nil, resperr
+		})
+
+		_, err = server.Search(context.Background(), nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	// tags and folders
+
+	t.Run("tag add", func(t *testing.T) {
+		_, err := server.TagAdd(context.Background(), nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "tag add error")
+		server.tagAddHandler = tag.GRPCAddHandler(func(ctx context.Context, in *pb.TagRequest) (*empty.Empty, error) {
+			return nil, resperr
+		})
+
+		_, err = server.TagAdd(context.Background(), nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	t.Run("tag remove", func(t *testing.T) {
+		_, err := server.TagRemove(context.Background(), nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "tag remove error")
+		server.tagRemoveHandler = tag.GRPCRemoveHandler(func(ctx context.Context, in *pb.TagRequest) (*empty.Empty, error) {
+			return nil, resperr
+		})
+
+		_, err = server.TagRemove(context.Background(), nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	t.Run("tag list", func(t *testing.T) {
+		_, err := server.TagList(context.Background(), nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "tag list error")
+		server.tagListHandler = tag.GRPCListHandler(func(ctx context.Context, in *empty.Empty) (*pb.TagListResponse, error) {
+			return nil, resperr
+		})
+
+		_, err = server.TagList(context.Background(), nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	t.Run("folder move", func(t *testing.T) {
+		_, err := server.FolderMove(context.Background(), nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "folder move error")
+		server.folderMoveHandler = folder.GRPCMoveHandler(func(ctx context.Context, in *pb.FolderMoveRequest) (*empty.Empty, error) {
+			return nil, resperr
+		})
+
+		_, err = server.FolderMove(context.Background(), nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	t.Run("folder list", func(t *testing.T) {
+		_, err := server.FolderList(context.Background(), nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "folder list error")
+		server.folderListHandler = folder.GRPCListHandler(func(ctx context.Context, in *empty.Empty) (*pb.FolderListResponse, error) {
+			return nil, resperr
+		})
+
+		_, err = server.FolderList(context.Background(), nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	t.Run("folder delete", func(t *testing.T) {
+		_, err := server.FolderDelete(context.Background(), nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "folder delete error")
+		server.folderDeleteHandler = folder.GRPCDeleteHandler(func(ctx context.Context, in *pb.FolderDelRequest) (*empty.Empty, error) {
+			return nil, resperr
+		})
+
+		_, err = server.FolderDelete(context.Background(), nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	// watch
+
+	t.Run("watch", func(t *testing.T) {
+		err := server.Watch(nil, nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "watch error")
+		server.watchHandler = watch.GRPCWatchHandler(func(e *empty.Empty, ws pb.GophKeeper_WatchServer) error {
+			return resperr
+		})
+
+		err = server.Watch(nil, nil)
+		require.ErrorIs(t, err, resperr)
+	})
+
+	// batch
+
+	t.Run("batch", func(t *testing.T) {
+		_, err := server.Batch(context.Background(), nil)
+		require.Error(t, err)
+
+		resperr := status.Error(codes.Internal, "batch error")
+		server.batchHandler = batch.GRPCBatchHandler(func(ctx context.Context, in *pb.BatchRequest) (*pb.BatchResponse, error) {
+			return nil, resperr
+		})
+
+		_, err = server.Batch(context.Background(), nil)
+		require.ErrorIs(t, err, resperr)
+	})
 }
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExpiresAt время удаления элемента из запроса, nil - бессрочно
+func ExpiresAt(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// ExpiresAtPB время удаления элемента для ответа, nil - бессрочно
+func ExpiresAtPB(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
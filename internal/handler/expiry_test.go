@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestExpiresAt(t *testing.T) {
+	assert.Nil(t, ExpiresAt(nil))
+	assert.Nil(t, ExpiresAtPB(nil))
+
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	got := ExpiresAt(timestamppb.New(at))
+	require.NotNil(t, got)
+	assert.True(t, at.Equal(*got))
+	assert.True(t, at.Equal(ExpiresAtPB(got).AsTime()))
+}
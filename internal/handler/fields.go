@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"time"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/otp"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+// ErrFieldValue значение поля не соответствует его типу
+var ErrFieldValue = errors.New("invalid field value")
+
+// fieldTypes типы полей протокола и хранилища
+var fieldTypes = map[pb.FieldType]string{
+	pb.FieldType_FIELD_TYPE_TEXT:   storage.FieldText,
+	pb.FieldType_FIELD_TYPE_SECRET: storage.FieldSecret,
+	pb.FieldType_FIELD_TYPE_URL:    storage.FieldURL,
+	pb.FieldType_FIELD_TYPE_EMAIL:  storage.FieldEmail,
+	pb.FieldType_FIELD_TYPE_DATE:   storage.FieldDate,
+	pb.FieldType_FIELD_TYPE_OTP:    storage.FieldOTP,
+}
+
+// ItemFields проверка полей запроса и шифрование значения каждого поля
+// отдельно. Ошибка проверки оборачивает ErrFieldValue.
+func ItemFields(in []*pb.ItemField, enc crypt.Encryptor) ([]storage.ItemField, error) {
+	fields := make([]storage.ItemField, len(in))
+	for i, f := range in {
+		typ, ok := fieldTypes[f.Type]
+		if !ok {
+			return nil, fmt.Errorf("field %q: %w: unknown type %v", f.Label, ErrFieldValue, f.Type)
+		}
+		if err := checkFieldValue(typ, f.Value); err != nil {
+			return nil, fmt.Errorf("field %q: %w: %s", f.Label, ErrFieldValue, err)
+		}
+
+		value, err := enc.Encrypt([]byte(f.Value))
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = storage.ItemField{Label: f.Label, Type: typ, Value: value}
+	}
+	return fields, nil
+}
+
+// ItemFieldsPB расшифровка полей элемента для ответа
+func ItemFieldsPB(fields []storage.ItemField, dec crypt.Decryptor) ([]*pb.ItemField, error) {
+	res := make([]*pb.ItemField, len(fields))
+	for i, f := range fields {
+		value, err := dec.Decrypt(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = &pb.ItemField{Label: f.Label, Type: fieldTypePB(f.Type), Value: string(value)}
+	}
+	return res, nil
+}
+
+func fieldTypePB(typ string) pb.FieldType {
+	for k, v := range fieldTypes {
+		if v == typ {
+			return k
+		}
+	}
+	return pb.FieldType_FIELD_TYPE_TEXT
+}
+
+// checkFieldValue проверка значения по типу поля, пустое значение допустимо
+func checkFieldValue(typ, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	switch typ {
+	case storage.FieldURL:
+		u, err := url.Parse(value)
+		if err != nil {
+			return err
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return errors.New("absolute url expected")
+		}
+	case storage.FieldEmail:
+		addr, err := mail.ParseAddress(value)
+		if err != nil {
+			return err
+		}
+		if addr.Address != value {
+			return errors.New("bare address expected")
+		}
+	case storage.FieldDate:
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return err
+		}
+	case storage.FieldOTP:
+		_, err := otp.Parse(value)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestCheckFieldValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     string
+		value   string
+		wantErr bool
+	}{
+		{name: "empty", typ: storage.FieldURL, value: ""},
+		{name: "text", typ: storage.FieldText, value: "any text"},
+		{name: "url", typ: storage.FieldURL, value: "https://example.com/login"},
+		{name: "relative url", typ: storage.FieldURL, value: "example.com", wantErr: true},
+		{name: "email", typ: storage.FieldEmail, value: "user@example.com"},
+		{name: "named email", typ: storage.FieldEmail, value: "User <user@example.com>", wantErr: true},
+		{name: "bad email", typ: storage.FieldEmail, value: "user", wantErr: true},
+		{name: "date", typ: storage.FieldDate, value: "2030-12-31"},
+		{name: "bad date", typ: storage.FieldDate, value: "31.12.2030", wantErr: true},
+		{name: "otp secret", typ: storage.FieldOTP, value: "jbsw y3dp ehpk 3pxp"},
+		{name: "otp uri", typ: storage.FieldOTP, value: "otpauth://totp/Example:user?secret=JBSWY3DPEHPK3PXP&issuer=Example"},
+		{name: "otp uri without secret", typ: storage.FieldOTP, value: "otpauth://totp/Example:user", wantErr: true},
+		{name: "bad otp", typ: storage.FieldOTP, value: "not base32!", wantErr: true},
+	}
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			err := checkFieldValue(tcase.typ, tcase.value)
+			if tcase.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestItemFields(t *testing.T) {
+	var encrypted []string
+	enc := crypt.EncryptFunc(func(text []byte) ([]byte, error) {
+		encrypted = append(encrypted, string(text))
+		return append([]byte("enc:"), text...), nil
+	})
+	dec := crypt.DecryptFunc(func(text []byte) ([]byte, error) {
+		return text[len("enc:"):], nil
+	})
+
+	in := []*pb.ItemField{
+		{Label: "host", Type: pb.FieldType_FIELD_TYPE_TEXT, Value: "db.local"},
+		{Label: "password", Type: pb.FieldType_FIELD_TYPE_SECRET, Value: "secret"},
+		{Label: "expires", Type: pb.FieldType_FIELD_TYPE_DATE, Value: "2030-01-01"},
+	}
+	fields, err := ItemFields(in, enc)
+	require.NoError(t, err)
+	// каждое значение шифруется отдельно
+	assert.Equal(t, []string{"db.local", "secret", "2030-01-01"}, encrypted)
+	assert.Equal(t, storage.ItemField{Label: "password", Type: storage.FieldSecret, Value: []byte("enc:secret")}, fields[1])
+
+	out, err := ItemFieldsPB(fields, dec)
+	require.NoError(t, err)
+	require.Len(t, out, len(in))
+	for i := range in {
+		assert.Equal(t, in[i].Label, out[i].Label)
+		assert.Equal(t, in[i].Type, out[i].Type)
+		assert.Equal(t, in[i].Value, out[i].Value)
+	}
+
+	_, err = ItemFields([]*pb.ItemField{{Label: "site", Type: pb.FieldType_FIELD_TYPE_URL, Value: "nope"}}, enc)
+	assert.ErrorIs(t, err, ErrFieldValue)
+
+	encErr := errors.New("encrypt error")
+	_, err = ItemFields(in, crypt.EncryptFunc(func([]byte) ([]byte, error) { return nil, encErr }))
+	assert.ErrorIs(t, err, encErr)
+	assert.NotErrorIs(t, err, ErrFieldValue)
+}
@@ -12,6 +12,9 @@ import (
 
 	"github.com/golang-jwt/jwt/v4"
 	"golang.org/x/crypto/bcrypt"
+
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
 )
 
 var (
@@ -39,6 +42,29 @@ func GetUserIDFromMD(ctx context.Context, secretKey string) (string, error) {
 	return userID, nil
 }
 
+// Notify публикация события об изменении данных пользователя.
+// Данные уже записаны, поэтому ошибка публикации только логируется.
+func Notify(ctx context.Context, pub broker.Publisher, userID, kind, name, action string) {
+	NotifyEvent(ctx, pub, broker.Event{
+		UserID: userID,
+		Kind:   kind,
+		Name:   name,
+		Action: action,
+	})
+}
+
+// NotifyEvent публикация подготовленного события, см. Notify
+func NotifyEvent(ctx context.Context, pub broker.Publisher, e broker.Event) {
+	if pub == nil {
+		return
+	}
+	if err := pub.Publish(ctx, e); err != nil {
+		logger.Errorf("publish event error: %w", err,
+			"kind", e.Kind,
+			"action", e.Action)
+	}
+}
+
 // PasswordHash - хеширование пароля
 func PasswordHash(password, salt string) (string, error) {
 	hash, err := bcrypt.GenerateFromPassword([]byte(password+salt), bcrypt.MinCost)
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"path"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// Размер страницы списка
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 1000
+)
+
+var (
+	ErrRPCInvalidCursor = status.Errorf(codes.InvalidArgument, "invalid cursor")
+
+	listSorts = map[pb.ListSort]string{
+		pb.ListSort_LIST_SORT_NAME:    storage.SortName,
+		pb.ListSort_LIST_SORT_CREATED: storage.SortCreated,
+		pb.ListSort_LIST_SORT_UPDATED: storage.SortUpdated,
+	}
+)
+
+// CleanFolder приведение пути папки к виду "a/b", корень - пустая строка
+func CleanFolder(folder string) string {
+	return strings.Trim(path.Clean("/"+folder), "/")
+}
+
+// listCursor позиция в списке, передаётся клиенту непрозрачной строкой.
+// Сортировка запоминается, чтобы курсор не применили к другому порядку.
+type listCursor struct {
+	Sort string    `json:"s"`
+	Desc bool      `json:"d"`
+	ID   int64     `json:"i"`
+	Name string    `json:"n"`
+	Time time.Time `json:"t"`
+}
+
+// NewListQuery разбор запроса списка в параметры выборки хранилища.
+// Из хранилища запрашивается на одну запись больше страницы,
+// чтобы узнать, есть ли следующая.
+func NewListQuery(userID string, in *pb.ListRequest) (storage.ListQuery, error) {
+	q := storage.ListQuery{
+		UserID: userID,
+		Limit:  int(in.GetLimit()),
+		Desc:   in.GetDesc(),
+		Prefix: in.GetPrefix(),
+		Glob:   in.GetGlob(),
+		Tag:    in.GetTag(),
+	}
+	if in.GetFolder() != "" {
+		if q.Folder = CleanFolder(in.GetFolder()); q.Folder == "" {
+			q.Folder = "/"
+		}
+		q.Recursive = in.GetRecursive()
+	}
+
+	var ok bool
+	if q.Sort, ok = listSorts[in.GetSort()]; !ok {
+		return q, status.Errorf(codes.InvalidArgument, "unknown sort %v", in.GetSort())
+	}
+	if q.Limit <= 0 || q.Limit > MaxListLimit {
+		q.Limit = DefaultListLimit
+	}
+	q.Limit++
+
+	if in.GetCursor() == "" {
+		return q, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(in.GetCursor())
+	if err != nil {
+		return q, ErrRPCInvalidCursor
+	}
+	var c listCursor
+	if err = json.Unmarshal(data, &c); err != nil || c.Sort != q.Sort || c.Desc != q.Desc {
+		return q, ErrRPCInvalidCursor
+	}
+	q.After = &storage.ListEntry{
+		ID:       c.ID,
+		Name:     c.Name,
+		CtreatAt: c.Time,
+		UpdateAt: c.Time,
+	}
+	return q, nil
+}
+
+// ListPage формирование страницы ответа и курсора следующей страницы
+func ListPage(q storage.ListQuery, entries []storage.ListEntry) ([]*pb.ListEntry, string) {
+	var cursor string
+	if q.Limit > 0 && len(entries) >= q.Limit {
+		entries = entries[:q.Limit-1]
+		last := entries[len(entries)-1]
+		c := listCursor{
+			Sort: q.Sort,
+			Desc: q.Desc,
+			ID:   last.ID,
+			Name: last.Name,
+		}
+		switch q.Sort {
+		case storage.SortCreated:
+			c.Time = last.CtreatAt
+		case storage.SortUpdated:
+			c.Time = last.UpdateAt
+		}
+		data, _ := json.Marshal(c)
+		cursor = base64.RawURLEncoding.EncodeToString(data)
+	}
+
+	res := make([]*pb.ListEntry, len(entries))
+	for i, e := range entries {
+		res[i] = &pb.ListEntry{
+			Id:        e.ID,
+			Name:      e.Name,
+			CreatedAt: timestamppb.New(e.CtreatAt),
+			UpdatedAt: timestamppb.New(e.UpdateAt),
+			Size:      e.Size,
+			Folder:    e.Folder,
+			Tags:      e.Tags,
+			ExpiresAt: ExpiresAtPB(e.ExpiresAt),
+		}
+	}
+	return res, cursor
+}
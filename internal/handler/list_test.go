@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestListCursor(t *testing.T) {
+	created := time.Date(2023, 10, 1, 12, 0, 0, 123000, time.UTC)
+	entries := []storage.ListEntry{
+		{ID: 1, Name: "a", CtreatAt: created},
+		{ID: 2, Name: "b", CtreatAt: created.Add(time.Second)},
+		{ID: 3, Name: "c", CtreatAt: created.Add(2 * time.Second)},
+	}
+
+	req := &pb.ListRequest{Limit: 2, Sort: pb.ListSort_LIST_SORT_CREATED, Desc: true}
+	q, err := NewListQuery("user", req)
+	require.NoError(t, err)
+	assert.Equal(t, 3, q.Limit)
+	assert.Nil(t, q.After)
+
+	page, cursor := ListPage(q, entries)
+	require.Len(t, page, 2)
+	require.NotEmpty(t, cursor)
+
+	req.Cursor = cursor
+	q, err = NewListQuery("user", req)
+	require.NoError(t, err)
+	require.NotNil(t, q.After)
+	assert.Equal(t, int64(2), q.After.ID)
+	assert.True(t, entries[1].CtreatAt.Equal(q.After.CtreatAt))
+
+	// последняя страница без курсора
+	page, cursor = ListPage(q, entries[2:])
+	assert.Len(t, page, 1)
+	assert.Empty(t, cursor)
+
+	// курсор другой сортировки не принимается
+	req.Sort = pb.ListSort_LIST_SORT_NAME
+	_, err = NewListQuery("user", req)
+	assert.ErrorIs(t, err, ErrRPCInvalidCursor)
+}
+
+func TestCleanFolder(t *testing.T) {
+	tests := map[string]string{
+		"":            "",
+		"/":           "",
+		"work":        "work",
+		"/work/db/":   "work/db",
+		"work//db":    "work/db",
+		"work/../own": "own",
+		"../..":       "",
+	}
+	for folder, want := range tests {
+		assert.Equal(t, want, CleanFolder(folder), folder)
+	}
+}
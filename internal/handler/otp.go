@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/otp"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+// ErrOtpParams неверные параметры одноразовых паролей
+var ErrOtpParams = errors.New("invalid otp parameters")
+
+// otpLabelLen наибольшая длина издателя и учётной записи
+const otpLabelLen = 128
+
+var otpTypes = map[pb.OtpType]string{
+	pb.OtpType_OTP_TYPE_TOTP: otp.TypeTOTP,
+	pb.OtpType_OTP_TYPE_HOTP: otp.TypeHOTP,
+}
+
+var otpAlgorithms = map[pb.OtpAlgorithm]string{
+	pb.OtpAlgorithm_OTP_ALGORITHM_SHA1:   otp.SHA1,
+	pb.OtpAlgorithm_OTP_ALGORITHM_SHA256: otp.SHA256,
+	pb.OtpAlgorithm_OTP_ALGORITHM_SHA512: otp.SHA512,
+}
+
+// OtpData проверка параметров запроса, учётная запись и секрет
+// шифруются. Ошибка проверки оборачивает ErrOtpParams.
+func OtpData(userID string, id int64, in *pb.OtpWriteRequest, enc crypt.Encryptor) (data storage.OtpData, err error) {
+	p, err := OtpParams(in)
+	if err != nil {
+		return data, fmt.Errorf("%w: %s", ErrOtpParams, err)
+	}
+
+	data = storage.OtpData{
+		ID:        id,
+		UserID:    userID,
+		Name:      in.Name,
+		Issuer:    p.Issuer,
+		Type:      p.Type,
+		Algorithm: p.Algorithm,
+		Digits:    p.Digits,
+		Period:    int(p.Period / time.Second),
+		Counter:   int64(p.Counter),
+		ExpiresAt: ExpiresAt(in.ExpiresAt),
+	}
+	if data.Account, err = enc.Encrypt([]byte(p.Account)); err != nil {
+		return
+	}
+	data.Secret, err = enc.Encrypt(p.Secret)
+	return
+}
+
+// OtpParams параметры генерации из ссылки otpauth:// или полей запроса
+func OtpParams(in *pb.OtpWriteRequest) (p otp.Params, err error) {
+	switch {
+	case in.Uri != "" && in.Secret != "":
+		return p, errors.New("secret and uri are mutually exclusive")
+	case in.Uri != "":
+		if !strings.HasPrefix(in.Uri, "otpauth://") {
+			return p, errors.New("otpauth uri expected")
+		}
+		if p, err = otp.Parse(in.Uri); err != nil {
+			return
+		}
+		if in.Issuer != "" {
+			p.Issuer = in.Issuer
+		}
+		if in.Account != "" {
+			p.Account = in.Account
+		}
+	default:
+		p = otp.Params{
+			Type:      otpTypes[in.Type],
+			Issuer:    in.Issuer,
+			Account:   in.Account,
+			Algorithm: otpAlgorithms[in.Algorithm],
+			Digits:    int(in.Digits),
+			Period:    time.Duration(in.Period) * time.Second,
+			Counter:   in.Counter,
+		}
+		if p.Digits == 0 {
+			p.Digits = otp.DefaultDigits
+		}
+		if p.Period == 0 {
+			p.Period = otp.DefaultPeriod
+		}
+		if p.Secret, err = otp.DecodeSecret(in.Secret); err != nil {
+			return
+		}
+	}
+
+	if utf8.RuneCountInString(p.Issuer) > otpLabelLen || utf8.RuneCountInString(p.Account) > otpLabelLen {
+		return p, fmt.Errorf("issuer and account must be at most %d characters", otpLabelLen)
+	}
+	return p, p.Validate()
+}
+
+// OtpParamsOf расшифровка учётной записи и секрета хранимых данных
+func OtpParamsOf(data storage.OtpData, dec crypt.Decryptor) (p otp.Params, err error) {
+	p = otp.Params{
+		Type:      data.Type,
+		Issuer:    data.Issuer,
+		Algorithm: data.Algorithm,
+		Digits:    data.Digits,
+		Period:    time.Duration(data.Period) * time.Second,
+		Counter:   uint64(data.Counter),
+	}
+	account, err := dec.Decrypt(data.Account)
+	if err != nil {
+		return
+	}
+	p.Account = string(account)
+	p.Secret, err = dec.Decrypt(data.Secret)
+	return
+}
+
+// OtpTypePB вид одноразовых паролей для ответа
+func OtpTypePB(typ string) pb.OtpType {
+	for k, v := range otpTypes {
+		if v == typ {
+			return k
+		}
+	}
+	return pb.OtpType_OTP_TYPE_TOTP
+}
+
+// OtpAlgorithmPB алгоритм HMAC для ответа
+func OtpAlgorithmPB(alg string) pb.OtpAlgorithm {
+	for k, v := range otpAlgorithms {
+		if v == alg {
+			return k
+		}
+	}
+	return pb.OtpAlgorithm_OTP_ALGORITHM_SHA1
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/otp"
+)
+
+func TestOtpParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *pb.OtpWriteRequest
+		want    otp.Params
+		wantErr bool
+	}{
+		{
+			name: "secret with defaults",
+			in:   &pb.OtpWriteRequest{Issuer: "ACME", Account: "alice", Secret: "jbsw y3dp ehpk 3pxp"},
+			want: otp.Params{Type: otp.TypeTOTP, Issuer: "ACME", Account: "alice", Secret: []byte("Hello!\xde\xad\xbe\xef"),
+				Algorithm: otp.SHA1, Digits: otp.DefaultDigits, Period: otp.DefaultPeriod},
+		},
+		{
+			name: "hotp",
+			in: &pb.OtpWriteRequest{Secret: "JBSWY3DPEHPK3PXP", Type: pb.OtpType_OTP_TYPE_HOTP,
+				Algorithm: pb.OtpAlgorithm_OTP_ALGORITHM_SHA512, Digits: 8, Counter: 5},
+			want: otp.Params{Type: otp.TypeHOTP, Secret: []byte("Hello!\xde\xad\xbe\xef"),
+				Algorithm: otp.SHA512, Digits: 8, Period: otp.DefaultPeriod, Counter: 5},
+		},
+		{
+			name: "uri",
+			in:   &pb.OtpWriteRequest{Account: "bob", Uri: "otpauth://totp/ACME:alice?secret=JBSWY3DPEHPK3PXP&period=60"},
+			want: otp.Params{Type: otp.TypeTOTP, Issuer: "ACME", Account: "bob", Secret: []byte("Hello!\xde\xad\xbe\xef"),
+				Algorithm: otp.SHA1, Digits: otp.DefaultDigits, Period: time.Minute},
+		},
+		{
+			name:    "secret and uri",
+			in:      &pb.OtpWriteRequest{Secret: "JBSWY3DPEHPK3PXP", Uri: "otpauth://totp/ACME?secret=JBSWY3DPEHPK3PXP"},
+			wantErr: true,
+		},
+		{
+			name:    "no secret",
+			in:      &pb.OtpWriteRequest{Issuer: "ACME"},
+			wantErr: true,
+		},
+		{
+			name:    "not otpauth uri",
+			in:      &pb.OtpWriteRequest{Uri: "https://example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "few digits",
+			in:      &pb.OtpWriteRequest{Secret: "JBSWY3DPEHPK3PXP", Digits: 4},
+			wantErr: true,
+		},
+		{
+			name:    "long issuer in uri",
+			in:      &pb.OtpWriteRequest{Uri: "otpauth://totp/alice?secret=JBSWY3DPEHPK3PXP&issuer=" + strings.Repeat("a", 129)},
+			wantErr: true,
+		},
+	}
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			p, err := OtpParams(tcase.in)
+			if tcase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, p)
+		})
+	}
+}
+
+func TestOtpData(t *testing.T) {
+	enc := crypt.EncryptFunc(func(text []byte) ([]byte, error) {
+		return append([]byte("enc:"), text...), nil
+	})
+	dec := crypt.DecryptFunc(func(text []byte) ([]byte, error) {
+		return text[len("enc:"):], nil
+	})
+
+	in := &pb.OtpWriteRequest{Name: "github", Uri: "otpauth://hotp/GitHub:alice?secret=JBSWY3DPEHPK3PXP&counter=3"}
+	data, err := OtpData("user", 7, in, enc)
+	require.NoError(t, err)
+	assert.Equal(t, "GitHub", data.Issuer)
+	assert.Equal(t, otp.TypeHOTP, data.Type)
+	assert.Equal(t, int64(3), data.Counter)
+	assert.Equal(t, []byte("enc:alice"), data.Account)
+	assert.Equal(t, []byte("enc:Hello!\xde\xad\xbe\xef"), data.Secret)
+
+	p, err := OtpParamsOf(data, dec)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", p.Account)
+	assert.Equal(t, []byte("Hello!\xde\xad\xbe\xef"), p.Secret)
+	assert.Equal(t, pb.OtpType_OTP_TYPE_HOTP, OtpTypePB(p.Type))
+
+	_, err = OtpData("user", 0, &pb.OtpWriteRequest{Name: "bad", Secret: "not base32!"}, enc)
+	assert.ErrorIs(t, err, ErrOtpParams)
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// Limits ограничения хранилища пользователя, 0 - без ограничения
+type Limits struct {
+	Bytes    int64 // общий объём файлов
+	Items    int   // количество элементов каждого вида
+	FileSize int64 // размер одного файла
+}
+
+// UsageReader чтение занятого пользователем места
+type UsageReader interface {
+	ReadList(ctx context.Context, userID string) (storage.ListData, error)
+	BinarySize(ctx context.Context, binID int64) (int64, error)
+}
+
+// Quota проверка ограничений хранилища.
+// Методы nil-значения ничего не проверяют.
+type Quota struct {
+	Limits
+	usage UsageReader
+}
+
+// NewQuota функция-конструктор проверки ограничений
+func NewQuota(limits Limits, usage UsageReader) *Quota {
+	return &Quota{
+		Limits: limits,
+		usage:  usage,
+	}
+}
+
+// CheckItems проверка возможности добавить n элементов вида kind
+func (q *Quota) CheckItems(ctx context.Context, userID, kind string, n int) error {
+	if q == nil || q.Items == 0 || n == 0 {
+		return nil
+	}
+
+	data, err := q.readUsage(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if count := data.Count(kind); count+n > q.Items {
+		return status.Errorf(codes.ResourceExhausted,
+			"%s quota exceeded: %d of %d items", kind, count, q.Items)
+	}
+	return nil
+}
+
+// CheckFile проверка возможности сохранить файл размером size.
+// binID - идентификатор заменяемого бинарника, 0 - новый файл.
+func (q *Quota) CheckFile(ctx context.Context, userID string, size, binID int64) error {
+	if q == nil {
+		return nil
+	}
+	if q.FileSize > 0 && size > q.FileSize {
+		return status.Errorf(codes.ResourceExhausted,
+			"file size %d exceeds limit %d", size, q.FileSize)
+	}
+	if q.Bytes == 0 {
+		return nil
+	}
+
+	data, err := q.readUsage(ctx, userID)
+	if err != nil {
+		return err
+	}
+	used := data.BinariesSize
+	if binID != 0 {
+		replaced, err := q.usage.BinarySize(ctx, binID)
+		if err != nil && !errors.Is(err, storage.ErrNoContent) {
+			logger.Errorf("read binary size error: %w", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+		used -= replaced
+	}
+	if used+size > q.Bytes {
+		return status.Errorf(codes.ResourceExhausted,
+			"storage quota exceeded: %d of %d bytes used", data.BinariesSize, q.Bytes)
+	}
+	return nil
+}
+
+// UploadLimit наибольший размер загружаемого бинарника:
+// заявленный при записи, но не больше ограничения на файл
+func (q *Quota) UploadLimit(ctx context.Context, binID int64) (int64, error) {
+	if q == nil {
+		return 0, nil
+	}
+
+	size, err := q.usage.BinarySize(ctx, binID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNoContent) {
+			return 0, status.Error(codes.NotFound, err.Error())
+		}
+		logger.Errorf("read binary size error: %w", err)
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+	if q.FileSize > 0 && size > q.FileSize {
+		size = q.FileSize
+	}
+	return size, nil
+}
+
+func (q *Quota) readUsage(ctx context.Context, userID string) (storage.ListData, error) {
+	data, err := q.usage.ReadList(ctx, userID)
+	if err != nil {
+		logger.Errorf("read usage error: %w", err)
+		return data, status.Error(codes.Internal, err.Error())
+	}
+	return data, nil
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type usageReader struct {
+	data  storage.ListData
+	sizes map[int64]int64
+}
+
+func (u usageReader) ReadList(context.Context, string) (storage.ListData, error) {
+	return u.data, nil
+}
+
+func (u usageReader) BinarySize(_ context.Context, binID int64) (int64, error) {
+	size, ok := u.sizes[binID]
+	if !ok {
+		return 0, storage.ErrNoContent
+	}
+	return size, nil
+}
+
+func TestQuota(t *testing.T) {
+	ctx := context.Background()
+	usage := usageReader{
+		data:  storage.ListData{NotesCount: 2, BinariesCount: 1, BinariesSize: 80},
+		sizes: map[int64]int64{7: 80},
+	}
+	q := NewQuota(Limits{Bytes: 100, Items: 3, FileSize: 50}, usage)
+
+	assertCode := func(t *testing.T, want codes.Code, err error) {
+		t.Helper()
+		if want == codes.OK {
+			require.NoError(t, err)
+			return
+		}
+		require.Error(t, err)
+		assert.Equal(t, want, status.Code(err))
+	}
+
+	t.Run("items", func(t *testing.T) {
+		assertCode(t, codes.OK, q.CheckItems(ctx, "user", storage.KindNote, 1))
+		assertCode(t, codes.ResourceExhausted, q.CheckItems(ctx, "user", storage.KindNote, 2))
+		assertCode(t, codes.OK, q.CheckItems(ctx, "user", storage.KindCard, 3))
+	})
+
+	t.Run("file", func(t *testing.T) {
+		assertCode(t, codes.OK, q.CheckFile(ctx, "user", 20, 0))
+		assertCode(t, codes.ResourceExhausted, q.CheckFile(ctx, "user", 30, 0))
+		assertCode(t, codes.ResourceExhausted, q.CheckFile(ctx, "user", 60, 0))
+		// заменяемый файл не учитывается
+		assertCode(t, codes.OK, q.CheckFile(ctx, "user", 50, 7))
+	})
+
+	t.Run("upload limit", func(t *testing.T) {
+		limit, err := q.UploadLimit(ctx, 7)
+		require.NoError(t, err)
+		assert.Equal(t, int64(50), limit)
+
+		_, err = q.UploadLimit(ctx, 8)
+		assertCode(t, codes.NotFound, err)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var q *Quota
+		assert.NoError(t, q.CheckItems(ctx, "user", storage.KindNote, 100))
+		assert.NoError(t, q.CheckFile(ctx, "user", 1<<40, 0))
+		limit, err := q.UploadLimit(ctx, 7)
+		assert.NoError(t, err)
+		assert.Zero(t, limit)
+	})
+}
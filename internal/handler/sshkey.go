@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/sshkey"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+// ErrSshKeyParams неверный закрытый ключ или параметры создания ключа
+var ErrSshKeyParams = errors.New("invalid ssh key")
+
+var sshKeyTypes = map[pb.SshKeyType]string{
+	pb.SshKeyType_SSH_KEY_TYPE_ED25519: sshkey.TypeEd25519,
+	pb.SshKeyType_SSH_KEY_TYPE_RSA:     sshkey.TypeRSA,
+	pb.SshKeyType_SSH_KEY_TYPE_ECDSA:   sshkey.TypeECDSA,
+}
+
+// SshKeyData импорт или создание ключа по запросу, закрытый ключ
+// шифруется. Ошибка проверки оборачивает ErrSshKeyParams.
+func SshKeyData(userID string, id int64, in *pb.SshKeyWriteRequest, enc crypt.Encryptor) (data storage.SshKeyData, err error) {
+	k, err := SshKey(in)
+	if err != nil {
+		return data, fmt.Errorf("%w: %s", ErrSshKeyParams, err)
+	}
+
+	data = storage.SshKeyData{
+		ID:          id,
+		UserID:      userID,
+		Name:        in.Name,
+		Type:        k.Type,
+		Bits:        k.Bits,
+		PublicKey:   k.AuthorizedKey(),
+		Comment:     k.Comment,
+		Fingerprint: k.Fingerprint(),
+		ExpiresAt:   ExpiresAt(in.ExpiresAt),
+	}
+	private, err := k.MarshalPrivate()
+	if err != nil {
+		return
+	}
+	data.PrivateKey, err = enc.Encrypt(private)
+	return
+}
+
+// SshKey ключ из закрытого ключа запроса либо новый ключ при generate
+func SshKey(in *pb.SshKeyWriteRequest) (k sshkey.Key, err error) {
+	switch {
+	case in.Generate && in.PrivateKey != "":
+		return k, errors.New("private key and generate are mutually exclusive")
+	case in.Generate:
+		return sshkey.Generate(sshKeyTypes[in.Type], int(in.Bits), in.Comment)
+	case in.PrivateKey == "":
+		return k, errors.New("private key required")
+	}
+
+	if k, err = sshkey.Parse([]byte(in.PrivateKey), []byte(in.Passphrase)); err != nil {
+		return
+	}
+	k.Comment = in.Comment
+	return
+}
+
+// SshKeyTypePB вид ключа для ответа
+func SshKeyTypePB(typ string) pb.SshKeyType {
+	for k, v := range sshKeyTypes {
+		if v == typ {
+			return k
+		}
+	}
+	return pb.SshKeyType_SSH_KEY_TYPE_ED25519
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/sshkey"
+)
+
+func TestSshKey(t *testing.T) {
+	k, err := sshkey.Generate(sshkey.TypeECDSA, 0, "")
+	require.NoError(t, err)
+	private, err := k.MarshalPrivate()
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKeyWithPassphrase(k.Private, "", []byte("secret"))
+	require.NoError(t, err)
+	encrypted := string(pem.EncodeToMemory(block))
+
+	tests := []struct {
+		name     string
+		in       *pb.SshKeyWriteRequest
+		wantType string
+		wantErr  bool
+	}{
+		{
+			name:     "generate default",
+			in:       &pb.SshKeyWriteRequest{Generate: true, Comment: "user@host"},
+			wantType: sshkey.TypeEd25519,
+		},
+		{
+			name:     "import",
+			in:       &pb.SshKeyWriteRequest{PrivateKey: string(private), Comment: "user@host"},
+			wantType: sshkey.TypeECDSA,
+		},
+		{
+			name:     "import encrypted",
+			in:       &pb.SshKeyWriteRequest{PrivateKey: encrypted, Passphrase: "secret"},
+			wantType: sshkey.TypeECDSA,
+		},
+		{
+			name:    "no passphrase",
+			in:      &pb.SshKeyWriteRequest{PrivateKey: encrypted},
+			wantErr: true,
+		},
+		{
+			name:    "generate and import",
+			in:      &pb.SshKeyWriteRequest{Generate: true, PrivateKey: string(private)},
+			wantErr: true,
+		},
+		{
+			name:    "no key",
+			in:      &pb.SshKeyWriteRequest{Comment: "user@host"},
+			wantErr: true,
+		},
+		{
+			name:    "wrong bits",
+			in:      &pb.SshKeyWriteRequest{Generate: true, Type: pb.SshKeyType_SSH_KEY_TYPE_ECDSA, Bits: 512},
+			wantErr: true,
+		},
+	}
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			key, err := SshKey(tcase.in)
+			if tcase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.wantType, key.Type)
+			assert.Equal(t, tcase.in.Comment, key.Comment)
+			if tcase.in.PrivateKey != "" {
+				assert.Equal(t, k.Fingerprint(), key.Fingerprint())
+			}
+		})
+	}
+}
+
+func TestSshKeyData(t *testing.T) {
+	enc := crypt.EncryptFunc(func(text []byte) ([]byte, error) {
+		return append([]byte("enc:"), text...), nil
+	})
+
+	in := &pb.SshKeyWriteRequest{Name: "deploy", Generate: true, Comment: "deploy@ci"}
+	data, err := SshKeyData("user", 3, in, enc)
+	require.NoError(t, err)
+	assert.Equal(t, sshkey.TypeEd25519, data.Type)
+	assert.Equal(t, 256, data.Bits)
+	assert.Equal(t, "deploy@ci", data.Comment)
+	assert.Regexp(t, `^ssh-ed25519 \S+ deploy@ci$`, data.PublicKey)
+	assert.Regexp(t, `^SHA256:`, data.Fingerprint)
+
+	// закрытый ключ шифруется и разбирается в тот же ключ
+	require.Contains(t, string(data.PrivateKey), "enc:")
+	k, err := sshkey.Parse(data.PrivateKey[len("enc:"):], nil)
+	require.NoError(t, err)
+	assert.Equal(t, data.Fingerprint, k.Fingerprint())
+	assert.Equal(t, pb.SshKeyType_SSH_KEY_TYPE_ED25519, SshKeyTypePB(data.Type))
+
+	_, err = SshKeyData("user", 0, &pb.SshKeyWriteRequest{Name: "bad", PrivateKey: "not a key"}, enc)
+	assert.ErrorIs(t, err, ErrSshKeyParams)
+}
@@ -0,0 +1,58 @@
+package handler
+
+// MinChunkSize наименьший согласуемый размер фрагмента передачи файла
+const MinChunkSize = 4 << 10
+
+// msgOverhead запас сообщения gRPC на поля помимо фрагмента
+const msgOverhead = 1 << 10
+
+// Transfer параметры передачи содержимого файлов
+type Transfer struct {
+	ChunkSize      int // размер фрагмента по умолчанию
+	MaxRecvMsgSize int // наибольший размер входящего сообщения, 0 - по умолчанию gRPC
+	MaxSendMsgSize int // наибольший размер исходящего сообщения, 0 - без ограничения
+	MaxParts       int // наибольшее число параллельных частей загрузки, 0 - без частей
+}
+
+// MaxChunk наибольший размер фрагмента, помещающийся в сообщения
+// обоих направлений
+func (t Transfer) MaxChunk() int {
+	recv := t.MaxRecvMsgSize
+	if recv <= 0 {
+		recv = 4 << 20 // ограничение gRPC по умолчанию
+	}
+	if t.MaxSendMsgSize > 0 && t.MaxSendMsgSize < recv {
+		recv = t.MaxSendMsgSize
+	}
+	if recv-msgOverhead < MinChunkSize {
+		return MinChunkSize
+	}
+	return recv - msgOverhead
+}
+
+// Chunk согласованный размер фрагмента: n приводится к пределам
+// MinChunkSize и MaxChunk, 0 - размер по умолчанию
+func (t Transfer) Chunk(n int) int {
+	if n <= 0 {
+		n = t.ChunkSize
+	}
+	if n < MinChunkSize {
+		return MinChunkSize
+	}
+	if limit := t.MaxChunk(); n > limit {
+		return limit
+	}
+	return n
+}
+
+// Parts согласованное число параллельных частей: не больше MaxParts,
+// 0 - наибольшее
+func (t Transfer) Parts(n int) int {
+	if t.MaxParts <= 1 {
+		return 1
+	}
+	if n <= 0 || n > t.MaxParts {
+		return t.MaxParts
+	}
+	return n
+}
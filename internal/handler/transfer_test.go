@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransfer(t *testing.T) {
+	tr := Transfer{ChunkSize: 256 << 10, MaxRecvMsgSize: 4 << 20, MaxSendMsgSize: 1 << 20, MaxParts: 8}
+
+	t.Run("max chunk", func(t *testing.T) {
+		assert.Equal(t, 1<<20-msgOverhead, tr.MaxChunk())
+		assert.Equal(t, 4<<20-msgOverhead, Transfer{}.MaxChunk())
+		assert.Equal(t, MinChunkSize, Transfer{MaxRecvMsgSize: 100}.MaxChunk())
+	})
+
+	chunks := []struct {
+		name string
+		n    int
+		want int
+	}{
+		{name: "default", n: 0, want: 256 << 10},
+		{name: "requested", n: 512 << 10, want: 512 << 10},
+		{name: "too small", n: 100, want: MinChunkSize},
+		{name: "too large", n: 8 << 20, want: 1<<20 - msgOverhead},
+	}
+	for _, tcase := range chunks {
+		t.Run("chunk "+tcase.name, func(t *testing.T) {
+			assert.Equal(t, tcase.want, tr.Chunk(tcase.n))
+		})
+	}
+
+	t.Run("default above limit", func(t *testing.T) {
+		assert.Equal(t, 1<<20-msgOverhead, Transfer{ChunkSize: 2 << 20, MaxRecvMsgSize: 1 << 20}.Chunk(0))
+	})
+
+	t.Run("parts", func(t *testing.T) {
+		assert.Equal(t, 8, tr.Parts(0))
+		assert.Equal(t, 3, tr.Parts(3))
+		assert.Equal(t, 8, tr.Parts(20))
+		assert.Equal(t, 1, Transfer{}.Parts(4))
+	})
+}
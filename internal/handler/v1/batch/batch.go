@@ -0,0 +1,280 @@
+// Package batch ручка пакетной записи данных
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+type Batcher interface {
+	Batch(ctx context.Context, userID string, ops []storage.BatchOp, bestEffort bool) ([]error, error)
+}
+
+type BatcherFunc func(ctx context.Context, userID string, ops []storage.BatchOp, bestEffort bool) ([]error, error)
+
+func (f BatcherFunc) Batch(ctx context.Context, userID string, ops []storage.BatchOp, bestEffort bool) ([]error, error) {
+	return f(ctx, userID, ops, bestEffort)
+}
+
+var _ Batcher = BatcherFunc(nil)
+
+var errEmptyData = errors.New("empty write data")
+
+type GRPCBatchHandler func(ctx context.Context, in *pb.BatchRequest) (*pb.BatchResponse, error)
+
+// NewGRPCBatchHandler - функция-конструктор ручки пакетной записи
+func NewGRPCBatchHandler(b Batcher, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher, quota *handler.Quota) GRPCBatchHandler {
+	return func(ctx context.Context, in *pb.BatchRequest) (*pb.BatchResponse, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ops := make([]storage.BatchOp, len(in.Operations))
+		creates := make(map[string]int)
+		for i, o := range in.Operations {
+			ops[i], err = batchOp(userID, o, enc)
+			if errors.Is(err, errEmptyData) || errors.Is(err, handler.ErrFieldValue) ||
+				errors.Is(err, handler.ErrOtpParams) || errors.Is(err, handler.ErrSshKeyParams) {
+				return nil, status.Errorf(codes.InvalidArgument, "operation %d: %s", i, err)
+			}
+			if err != nil {
+				logger.Errorf("batch operation error: %w", err, "index", i)
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if ops[i].Action == storage.OpCreate {
+				creates[ops[i].Kind]++
+			}
+		}
+
+		for _, kind := range []string{storage.KindPassword, storage.KindCard, storage.KindNote, storage.KindItem, storage.KindOtp,
+			storage.KindSshKey} {
+			if err = quota.CheckItems(ctx, userID, kind, creates[kind]); err != nil {
+				return nil, err
+			}
+		}
+
+		errs, err := b.Batch(ctx, userID, ops, in.BestEffort)
+		if err != nil {
+			logger.Errorf("batch error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp := pb.BatchResponse{
+			Results:   make([]*pb.BatchResult, len(ops)),
+			Committed: true,
+		}
+		for i, err := range errs {
+			resp.Results[i] = batchResult(err)
+			if err != nil && !in.BestEffort {
+				resp.Committed = false
+			}
+		}
+
+		if resp.Committed {
+			for i, op := range ops {
+				if errs[i] == nil {
+					handler.Notify(ctx, pub, userID, op.Kind, op.Name, op.Action)
+				}
+			}
+		}
+		return &resp, nil
+	}
+}
+
+// batchResult результат выполнения операции
+func batchResult(err error) *pb.BatchResult {
+	var code codes.Code
+	switch {
+	case err == nil:
+		return &pb.BatchResult{Code: int32(codes.OK)}
+	case errors.Is(err, storage.ErrWriteConflict):
+		code = codes.AlreadyExists
+	case errors.Is(err, storage.ErrNoContent):
+		code = codes.NotFound
+	case errors.Is(err, storage.ErrBatchAborted):
+		code = codes.Aborted
+	default:
+		logger.Errorf("batch operation error: %w", err)
+		code = codes.Internal
+	}
+	return &pb.BatchResult{
+		Code:    int32(code),
+		Message: err.Error(),
+	}
+}
+
+// batchOp преобразование операции запроса в операцию хранилища,
+// данные шифруются так же, как в одиночных ручках записи
+func batchOp(userID string, in *pb.BatchOperation, enc crypt.Encryptor) (op storage.BatchOp, err error) {
+	switch o := in.Op.(type) {
+	case *pb.BatchOperation_PasswordWrite:
+		op.Action, op.Kind = storage.OpCreate, storage.KindPassword
+		op.Data, err = passwordData(userID, 0, o.PasswordWrite, enc)
+		op.Name = o.PasswordWrite.Name
+	case *pb.BatchOperation_PasswordUpdate:
+		op.Action, op.Kind = storage.OpUpdate, storage.KindPassword
+		op.Data, err = passwordData(userID, o.PasswordUpdate.Id, o.PasswordUpdate.Write, enc)
+		op.Name = o.PasswordUpdate.Write.GetName()
+	case *pb.BatchOperation_PasswordDelete:
+		op.Action, op.Kind = storage.OpDelete, storage.KindPassword
+		op.Name = o.PasswordDelete.Name
+	case *pb.BatchOperation_CardWrite:
+		op.Action, op.Kind = storage.OpCreate, storage.KindCard
+		op.Data, err = cardData(userID, 0, o.CardWrite, enc)
+		op.Name = o.CardWrite.Name
+	case *pb.BatchOperation_CardUpdate:
+		op.Action, op.Kind = storage.OpUpdate, storage.KindCard
+		op.Data, err = cardData(userID, o.CardUpdate.Id, o.CardUpdate.Write, enc)
+		op.Name = o.CardUpdate.Write.GetName()
+	case *pb.BatchOperation_CardDelete:
+		op.Action, op.Kind = storage.OpDelete, storage.KindCard
+		op.Name = o.CardDelete.Name
+	case *pb.BatchOperation_NoteWrite:
+		op.Action, op.Kind = storage.OpCreate, storage.KindNote
+		op.Data, err = noteData(userID, 0, o.NoteWrite, enc)
+		op.Name = o.NoteWrite.Name
+	case *pb.BatchOperation_NoteUpdate:
+		op.Action, op.Kind = storage.OpUpdate, storage.KindNote
+		op.Data, err = noteData(userID, o.NoteUpdate.Id, o.NoteUpdate.Write, enc)
+		op.Name = o.NoteUpdate.Write.GetName()
+	case *pb.BatchOperation_NoteDelete:
+		op.Action, op.Kind = storage.OpDelete, storage.KindNote
+		op.Name = o.NoteDelete.Name
+	case *pb.BatchOperation_ItemWrite:
+		op.Action, op.Kind = storage.OpCreate, storage.KindItem
+		op.Data, err = itemData(userID, 0, o.ItemWrite, enc)
+		op.Name = o.ItemWrite.Name
+	case *pb.BatchOperation_ItemUpdate:
+		op.Action, op.Kind = storage.OpUpdate, storage.KindItem
+		op.Data, err = itemData(userID, o.ItemUpdate.Id, o.ItemUpdate.Write, enc)
+		op.Name = o.ItemUpdate.Write.GetName()
+	case *pb.BatchOperation_ItemDelete:
+		op.Action, op.Kind = storage.OpDelete, storage.KindItem
+		op.Name = o.ItemDelete.Name
+	case *pb.BatchOperation_OtpWrite:
+		op.Action, op.Kind = storage.OpCreate, storage.KindOtp
+		op.Data, err = otpData(userID, 0, o.OtpWrite, enc)
+		op.Name = o.OtpWrite.Name
+	case *pb.BatchOperation_OtpUpdate:
+		op.Action, op.Kind = storage.OpUpdate, storage.KindOtp
+		op.Data, err = otpData(userID, o.OtpUpdate.Id, o.OtpUpdate.Write, enc)
+		op.Name = o.OtpUpdate.Write.GetName()
+	case *pb.BatchOperation_OtpDelete:
+		op.Action, op.Kind = storage.OpDelete, storage.KindOtp
+		op.Name = o.OtpDelete.Name
+	case *pb.BatchOperation_SshKeyWrite:
+		op.Action, op.Kind = storage.OpCreate, storage.KindSshKey
+		op.Data, err = sshKeyData(userID, 0, o.SshKeyWrite, enc)
+		op.Name = o.SshKeyWrite.Name
+	case *pb.BatchOperation_SshKeyUpdate:
+		op.Action, op.Kind = storage.OpUpdate, storage.KindSshKey
+		op.Data, err = sshKeyData(userID, o.SshKeyUpdate.Id, o.SshKeyUpdate.Write, enc)
+		op.Name = o.SshKeyUpdate.Write.GetName()
+	case *pb.BatchOperation_SshKeyDelete:
+		op.Action, op.Kind = storage.OpDelete, storage.KindSshKey
+		op.Name = o.SshKeyDelete.Name
+	default:
+		err = fmt.Errorf("unknown operation %T", in.Op)
+	}
+	return
+}
+
+func passwordData(userID string, id int64, in *pb.PasswordWriteRequest, enc crypt.Encryptor) (data storage.PasswordData, err error) {
+	if in == nil {
+		return data, errEmptyData
+	}
+	data = storage.PasswordData{
+		ID:        id,
+		UserID:    userID,
+		Name:      in.Name,
+		URL:       in.Url,
+		ExpiresAt: handler.ExpiresAt(in.ExpiresAt),
+	}
+	if in.Searchable {
+		data.SearchUsername = in.Username
+	}
+	if data.Username, err = enc.Encrypt([]byte(in.Username)); err != nil {
+		return
+	}
+	if data.Password, err = enc.Encrypt([]byte(in.Password)); err != nil {
+		return
+	}
+	data.Notes, err = enc.Encrypt([]byte(in.Notes))
+	return
+}
+
+func cardData(userID string, id int64, in *pb.CardWriteRequest, enc crypt.Encryptor) (data storage.CardData, err error) {
+	if in == nil {
+		return data, errEmptyData
+	}
+	data = storage.CardData{
+		ID:        id,
+		UserID:    userID,
+		Name:      in.Name,
+		ExpiresAt: handler.ExpiresAt(in.ExpiresAt),
+	}
+	if data.Number, err = enc.Encrypt([]byte(in.Number)); err != nil {
+		return
+	}
+	if data.Pin, err = enc.Encrypt([]byte(in.Pin)); err != nil {
+		return
+	}
+	data.Notes, err = enc.Encrypt([]byte(in.Notes))
+	return
+}
+
+func noteData(userID string, id int64, in *pb.NoteWriteRequest, enc crypt.Encryptor) (data storage.NoteData, err error) {
+	if in == nil {
+		return data, errEmptyData
+	}
+	data = storage.NoteData{
+		ID:        id,
+		UserID:    userID,
+		Name:      in.Name,
+		ExpiresAt: handler.ExpiresAt(in.ExpiresAt),
+	}
+	data.Notes, err = enc.Encrypt([]byte(in.Notes))
+	return
+}
+
+func itemData(userID string, id int64, in *pb.ItemWriteRequest, enc crypt.Encryptor) (data storage.ItemData, err error) {
+	if in == nil {
+		return data, errEmptyData
+	}
+	data = storage.ItemData{
+		ID:        id,
+		UserID:    userID,
+		Name:      in.Name,
+		Type:      in.Type,
+		ExpiresAt: handler.ExpiresAt(in.ExpiresAt),
+	}
+	data.Fields, err = handler.ItemFields(in.Fields, enc)
+	return
+}
+
+func otpData(userID string, id int64, in *pb.OtpWriteRequest, enc crypt.Encryptor) (storage.OtpData, error) {
+	if in == nil {
+		return storage.OtpData{}, errEmptyData
+	}
+	return handler.OtpData(userID, id, in, enc)
+}
+
+func sshKeyData(userID string, id int64, in *pb.SshKeyWriteRequest, enc crypt.Encryptor) (storage.SshKeyData, error) {
+	if in == nil {
+		return storage.SshKeyData{}, errEmptyData
+	}
+	return handler.SshKeyData(userID, id, in, enc)
+}
@@ -0,0 +1,188 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCBatchHandler(t *testing.T) {
+
+	operations := []*pb.BatchOperation{
+		{Op: &pb.BatchOperation_PasswordWrite{PasswordWrite: &pb.PasswordWriteRequest{Name: "p", Password: "secret"}}},
+		{Op: &pb.BatchOperation_CardUpdate{CardUpdate: &pb.CardUpdateRequest{Id: 1, Write: &pb.CardWriteRequest{Name: "c"}}}},
+		{Op: &pb.BatchOperation_NoteDelete{NoteDelete: &pb.NoteDelRequest{Name: "n"}}},
+	}
+
+	tests := []struct {
+		name          string
+		operations    []*pb.BatchOperation
+		bestEffort    bool
+		wantStatus    codes.Code
+		wantCodes     []codes.Code
+		wantCommitted bool
+		wantEvents    int
+		userErr       error
+		encErr        error
+		batchErr      error
+		opErrs        []error
+	}{
+		{
+			name:          "ok",
+			operations:    operations,
+			wantCodes:     []codes.Code{codes.OK, codes.OK, codes.OK},
+			wantCommitted: true,
+			wantEvents:    3,
+		},
+		{
+			name:       "unauthenticated",
+			operations: operations,
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "encrypt error",
+			operations: operations,
+			wantStatus: codes.Internal,
+			encErr:     errors.New("encrypt error"),
+		},
+		{
+			name: "empty update",
+			operations: []*pb.BatchOperation{
+				{Op: &pb.BatchOperation_NoteUpdate{NoteUpdate: &pb.NoteUpdateRequest{Id: 1}}},
+			},
+			wantStatus: codes.InvalidArgument,
+		},
+		{
+			name: "invalid item field",
+			operations: []*pb.BatchOperation{
+				{Op: &pb.BatchOperation_ItemWrite{ItemWrite: &pb.ItemWriteRequest{Name: "i", Type: "login",
+					Fields: []*pb.ItemField{{Label: "mail", Type: pb.FieldType_FIELD_TYPE_EMAIL, Value: "nope"}}}}},
+			},
+			wantStatus: codes.InvalidArgument,
+		},
+		{
+			name: "invalid otp secret",
+			operations: []*pb.BatchOperation{
+				{Op: &pb.BatchOperation_OtpWrite{OtpWrite: &pb.OtpWriteRequest{Name: "o", Secret: "not base32!"}}},
+			},
+			wantStatus: codes.InvalidArgument,
+		},
+		{
+			name: "invalid ssh key",
+			operations: []*pb.BatchOperation{
+				{Op: &pb.BatchOperation_SshKeyWrite{SshKeyWrite: &pb.SshKeyWriteRequest{Name: "s", PrivateKey: "not a key"}}},
+			},
+			wantStatus: codes.InvalidArgument,
+		},
+		{
+			name:       "batch error",
+			operations: operations,
+			wantStatus: codes.Internal,
+			batchErr:   errors.New("batch error"),
+		},
+		{
+			name:       "atomic rollback",
+			operations: operations,
+			opErrs:     []error{storage.ErrBatchAborted, storage.ErrNoContent, storage.ErrBatchAborted},
+			wantCodes:  []codes.Code{codes.Aborted, codes.NotFound, codes.Aborted},
+		},
+		{
+			name:          "best effort",
+			operations:    operations,
+			bestEffort:    true,
+			opErrs:        []error{storage.ErrWriteConflict, nil, errors.New("delete error")},
+			wantCodes:     []codes.Code{codes.AlreadyExists, codes.OK, codes.Internal},
+			wantCommitted: true,
+			wantEvents:    1,
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		var gotOps []storage.BatchOp
+		b := BatcherFunc(func(_ context.Context, userID string, ops []storage.BatchOp, bestEffort bool) ([]error, error) {
+			gotOps = ops
+			if tcase.batchErr != nil {
+				return nil, tcase.batchErr
+			}
+			if tcase.opErrs != nil {
+				return tcase.opErrs, nil
+			}
+			return make([]error, len(ops)), nil
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		enc := crypt.EncryptFunc(func(text []byte) ([]byte, error) {
+			if tcase.encErr != nil {
+				return nil, tcase.encErr
+			}
+			return text, nil
+		})
+
+		req := pb.BatchRequest{
+			Operations: tcase.operations,
+			BestEffort: tcase.bestEffort,
+		}
+
+		t.Run(tcase.name, func(t *testing.T) {
+			resp, err := NewGRPCBatchHandler(b, getUserID, enc, pub, nil)(context.Background(), &req)
+			if tcase.wantStatus != 0 {
+				assert.Error(t, err)
+				assert.Empty(t, events)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Results, len(tcase.wantCodes))
+			for i, code := range tcase.wantCodes {
+				assert.Equal(t, int32(code), resp.Results[i].Code)
+			}
+			assert.Equal(t, tcase.wantCommitted, resp.Committed)
+			assert.Len(t, events, tcase.wantEvents)
+
+			require.Len(t, gotOps, 3)
+			assert.Equal(t, storage.BatchOp{
+				Action: storage.OpCreate,
+				Kind:   storage.KindPassword,
+				Name:   "p",
+				Data: storage.PasswordData{
+					UserID:   "user",
+					Name:     "p",
+					Username: []byte{},
+					Password: []byte("secret"),
+					Notes:    []byte{},
+				},
+			}, gotOps[0])
+			assert.Equal(t, storage.OpUpdate, gotOps[1].Action)
+			assert.Equal(t, int64(1), gotOps[1].Data.(storage.CardData).ID)
+			assert.Equal(t, storage.BatchOp{Action: storage.OpDelete, Kind: storage.KindNote, Name: "n"}, gotOps[2])
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
@@ -29,7 +30,7 @@ var _ BinaryDeleter = BinaryDeleteFunc(nil)
 type GRPCDeleteHandler func(ctx context.Context, in *pb.BinaryDelRequest) (*empty.Empty, error)
 
 // NewGRPCDeleteHandler - функция-конструктор ручки удаления бинарника
-func NewGRPCDeleteHandler(d BinaryDeleter, getUserID handler.GetUserIDFunc) GRPCDeleteHandler {
+func NewGRPCDeleteHandler(d BinaryDeleter, getUserID handler.GetUserIDFunc, pub broker.Publisher) GRPCDeleteHandler {
 	return func(ctx context.Context, in *pb.BinaryDelRequest) (*empty.Empty, error) {
 		userID, err := getUserID(ctx)
 		if err != nil {
@@ -45,6 +46,7 @@ func NewGRPCDeleteHandler(d BinaryDeleter, getUserID handler.GetUserIDFunc) GRPC
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		handler.Notify(ctx, pub, userID, storage.KindBinary, in.Name, broker.ActionDelete)
 		return &empty.Empty{}, nil
 	}
 }
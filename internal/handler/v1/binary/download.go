@@ -3,62 +3,85 @@ package binary
 import (
 	"context"
 	"errors"
+	"io"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
 )
 
 type BinaryDownloader interface {
-	BinaryDownload(ctx context.Context, data *storage.BinaryChunk) error
+	BinaryDownload(ctx context.Context, binID int64, fn func(r io.ReadSeeker) error) error
 }
 
-type BinaryDownloadFunc func(ctx context.Context, data *storage.BinaryChunk) error
+type BinaryDownloadFunc func(ctx context.Context, binID int64, fn func(r io.ReadSeeker) error) error
 
-func (f BinaryDownloadFunc) BinaryDownload(ctx context.Context, data *storage.BinaryChunk) error {
-	return f(ctx, data)
+func (f BinaryDownloadFunc) BinaryDownload(ctx context.Context, binID int64, fn func(r io.ReadSeeker) error) error {
+	return f(ctx, binID, fn)
 }
 
 var _ BinaryDownloader = BinaryDownloadFunc(nil)
 
 type GRPCDownloadHandler func(req *pb.BidaryDownloadRequest, ds pb.GophKeeper_BinaryDownloadServer) error
 
-func NewGRPCDownloadHandler(d BinaryDownloader) GRPCDownloadHandler {
+// NewGRPCDownloadHandler - функция-конструктор ручки выгрузки бинарника.
+// Содержимое расшифровывается и распаковывается поблочно, содержимое,
+// загруженное до появления шифрования, выгружается как есть.
+// Выгружается диапазон с offset длиной length, нулевая длина - до конца,
+// одной транзакцией хранилища фрагментами размера, запрошенного клиентом
+// в пределах transfer.
+func NewGRPCDownloadHandler(d BinaryDownloader, s BinaryStatusReader,
+	getUserID handler.GetUserIDFunc, dec crypt.Decryptor, transfer handler.Transfer) GRPCDownloadHandler {
+
 	return func(req *pb.BidaryDownloadRequest, server pb.GophKeeper_BinaryDownloadServer) error {
+		ctx := server.Context()
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return err
+		}
 
-		chSize := 4096
-		var (
-			done     bool
-			err      error
-			download pb.BinaryDownloadStream
-		)
-		data := storage.BinaryChunk{
-			BinID: req.Id,
-			Chunk: make([]byte, 0, chSize),
-		}
-
-		for !done {
-			data.Offset += int64(len(data.Chunk))
-			data.Chunk = data.Chunk[:0]
-			err = d.BinaryDownload(server.Context(), &data)
-			if err != nil || len(data.Chunk) == 0 {
-				break
-			} else if len(data.Chunk) < chSize {
-				done = true
-			}
+		current, err := uploadStatus(ctx, s, userID, req.Id)
+		if err != nil {
+			return err
+		}
+		if req.Offset > current.Size {
+			return status.Errorf(codes.OutOfRange, "offset %d beyond size %d", req.Offset, current.Size)
+		}
+		out := sender{
+			server: server,
+			w:      window{left: req.Length, limited: req.Length > 0},
+			size:   transfer.Chunk(int(req.ChunkSize)),
+		}
 
-			download.Chunk = data.Chunk
-			err = server.Send(&download)
-			if err != nil {
-				break
+		var codec *stream.Codec
+		if len(current.FileKey) != 0 {
+			var key []byte
+			key, err = dec.Decrypt(current.FileKey)
+			if err == nil {
+				codec, err = stream.NewCodec(key, current.Compression)
 			}
 		}
+		if err == nil {
+			err = d.BinaryDownload(ctx, req.Id, func(r io.ReadSeeker) error {
+				if codec == nil {
+					return downloadPlain(r, req.Offset, &out)
+				}
+				out.w.skip = req.Offset % stream.BlockSize
+				return downloadSealed(r, codec, req.Offset/stream.BlockSize, &out)
+			})
+		}
 
 		if errors.Is(err, storage.ErrNoContent) {
 			return status.Error(codes.AlreadyExists, err.Error())
+		} else if errors.Is(err, stream.ErrBlock) {
+			logger.Errorf("download binary error: %w", err, "id", req.Id)
+			return status.Error(codes.DataLoss, err.Error())
 		} else if err != nil {
 			logger.Errorf("download binary error: %w", err)
 			return status.Error(codes.Internal, err.Error())
@@ -66,3 +89,107 @@ func NewGRPCDownloadHandler(d BinaryDownloader) GRPCDownloadHandler {
 		return nil
 	}
 }
+
+// window выгружаемый диапазон содержимого
+type window struct {
+	skip    int64 // пропустить в начале
+	left    int64 // осталось выгрузить
+	limited bool  // длина диапазона задана
+}
+
+// cut часть фрагмента, попадающая в диапазон, done - диапазон исчерпан
+func (w *window) cut(b []byte) (res []byte, done bool) {
+	if w.skip >= int64(len(b)) {
+		w.skip -= int64(len(b))
+		return nil, false
+	}
+	b, w.skip = b[w.skip:], 0
+	if !w.limited {
+		return b, false
+	}
+	if int64(len(b)) >= w.left {
+		b = b[:w.left]
+	}
+	w.left -= int64(len(b))
+	return b, w.left == 0
+}
+
+// sender отправка выгружаемого диапазона фрагментами не больше size
+type sender struct {
+	server pb.GophKeeper_BinaryDownloadServer
+	w      window
+	size   int
+}
+
+// send отправка части данных, попадающей в диапазон, done - диапазон исчерпан
+func (s *sender) send(data []byte) (done bool, err error) {
+	data, done = s.w.cut(data)
+	for len(data) > 0 {
+		n := len(data)
+		if s.size > 0 && n > s.size {
+			n = s.size
+		}
+		if err = s.server.Send(&pb.BinaryDownloadStream{Chunk: data[:n]}); err != nil {
+			return done, err
+		}
+		data = data[n:]
+	}
+	return done, nil
+}
+
+// downloadSealed выгрузка блоков по порядку начиная с first, место
+// последнего блока заканчивается вместе с содержимым
+func downloadSealed(r io.ReadSeeker, codec *stream.Codec, first int64, out *sender) error {
+	if _, err := r.Seek(codec.Offset(first), io.SeekStart); err != nil {
+		return err
+	}
+
+	buf := make([]byte, codec.SlotSize())
+	for index := first; ; index++ {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			return nil
+		}
+		last := err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			return err
+		}
+
+		plain, err := codec.Decode(index, buf[:n])
+		if err != nil {
+			return err
+		}
+		done, err := out.send(plain)
+		if err != nil || done || last {
+			return err
+		}
+	}
+}
+
+// downloadPlain выгрузка содержимого без шифрования
+func downloadPlain(r io.ReadSeeker, offset int64, out *sender) error {
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	size := out.size
+	if size <= 0 {
+		size = stream.BlockSize
+	}
+	buf := make([]byte, size)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			return nil
+		}
+		last := err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			return err
+		}
+
+		done, err := out.send(buf[:n])
+		if err != nil || done || last {
+			return err
+		}
+	}
+}
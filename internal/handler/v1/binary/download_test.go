@@ -0,0 +1,249 @@
+package binary
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// downloadStream исходящий поток фрагментов
+type downloadStream struct {
+	grpc.ServerStream
+	received bytes.Buffer
+	maxChunk int
+}
+
+func (s *downloadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *downloadStream) Send(in *pb.BinaryDownloadStream) error {
+	s.received.Write(in.Chunk)
+	if len(in.Chunk) > s.maxChunk {
+		s.maxChunk = len(in.Chunk)
+	}
+	return nil
+}
+
+// seal запись содержимого блоками, не последние блоки занимают место
+// целиком, как в большом объекте с пропусками
+func seal(t *testing.T, key []byte, compression, plain string) []byte {
+	c, err := stream.NewCodec(key, compression)
+	require.NoError(t, err)
+
+	var res []byte
+	for index := int64(0); len(plain) > 0; index++ {
+		n := stream.BlockSize
+		if n > len(plain) {
+			n = len(plain)
+		}
+		rec, err := c.Encode(index, []byte(plain[:n]))
+		require.NoError(t, err)
+		res = append(res, rec...)
+		plain = plain[n:]
+		if len(plain) > 0 {
+			res = append(res, make([]byte, c.SlotSize()-len(rec))...)
+		}
+	}
+	return res
+}
+
+func TestGRPCDownloadHandler(t *testing.T) {
+	transfer := handler.Transfer{ChunkSize: 5000}
+
+	fileKey := bytes.Repeat([]byte{1}, stream.KeySize)
+	large := strings.Repeat("0123456789", stream.BlockSize/5)
+
+	tampered := seal(t, fileKey, stream.CompressionNone, "abcdef")
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name        string
+		wantStatus  codes.Code
+		want        string
+		userErr     error
+		statusErr   error
+		fileKey     []byte
+		compression string
+		stored      []byte
+		offset      int64
+		length      int64
+		chunkSize   int32 // запрошенный размер фрагмента
+		wantChunk   int   // наибольший отправленный фрагмент
+	}{
+		{
+			name:    "sealed",
+			want:    "abcdef",
+			fileKey: fileKey,
+			stored:  seal(t, fileKey, stream.CompressionNone, "abcdef"),
+		},
+		{
+			name:    "sealed several blocks",
+			want:    large,
+			fileKey: fileKey,
+			stored:  seal(t, fileKey, stream.CompressionNone, large),
+		},
+		{
+			name:        "compressed",
+			want:        large,
+			fileKey:     fileKey,
+			compression: stream.CompressionZstd,
+			stored:      seal(t, fileKey, stream.CompressionZstd, large),
+		},
+		{
+			name:        "compression mismatch",
+			wantStatus:  codes.DataLoss,
+			fileKey:     fileKey,
+			compression: stream.CompressionZstd,
+			stored:      seal(t, fileKey, stream.CompressionNone, "abcdef"),
+		},
+		{
+			name:    "sealed range in block",
+			want:    large[5:15],
+			fileKey: fileKey,
+			stored:  seal(t, fileKey, stream.CompressionNone, large),
+			offset:  5,
+			length:  10,
+		},
+		{
+			name:    "sealed range across blocks",
+			want:    large[stream.BlockSize-3 : stream.BlockSize+3],
+			fileKey: fileKey,
+			stored:  seal(t, fileKey, stream.CompressionNone, large),
+			offset:  stream.BlockSize - 3,
+			length:  6,
+		},
+		{
+			name:    "sealed to end",
+			want:    large[stream.BlockSize+7:],
+			fileKey: fileKey,
+			stored:  seal(t, fileKey, stream.CompressionNone, large),
+			offset:  stream.BlockSize + 7,
+		},
+		{
+			name:    "offset at end",
+			fileKey: fileKey,
+			stored:  seal(t, fileKey, stream.CompressionNone, large),
+			offset:  int64(len(large)),
+		},
+		{
+			name:        "compressed range",
+			want:        large[stream.BlockSize-3 : stream.BlockSize+3],
+			fileKey:     fileKey,
+			compression: stream.CompressionZstd,
+			stored:      seal(t, fileKey, stream.CompressionZstd, large),
+			offset:      stream.BlockSize - 3,
+			length:      6,
+		},
+		{
+			name:   "plain range",
+			want:   large[4095:8193],
+			stored: []byte(large),
+			offset: 4095,
+			length: 4098,
+		},
+		{
+			name:       "offset beyond size",
+			wantStatus: codes.OutOfRange,
+			stored:     []byte("abc"),
+			offset:     4,
+		},
+		{
+			name:   "plain",
+			want:   large,
+			stored: []byte(large),
+		},
+		{
+			name:      "requested chunk size",
+			want:      large,
+			fileKey:   fileKey,
+			stored:    seal(t, fileKey, stream.CompressionNone, large),
+			chunkSize: 32 << 10,
+			wantChunk: 32 << 10,
+		},
+		{
+			name:      "chunk size below minimum",
+			want:      large,
+			stored:    []byte(large),
+			chunkSize: 10,
+			wantChunk: handler.MinChunkSize,
+		},
+		{
+			name:       "tampered",
+			wantStatus: codes.DataLoss,
+			fileKey:    fileKey,
+			stored:     tampered,
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			statusErr:  storage.ErrNoContent,
+		},
+		{
+			name:       "status error",
+			wantStatus: codes.Internal,
+			statusErr:  errors.New("status error"),
+		},
+	}
+
+	for _, tcase := range tests {
+
+		d := BinaryDownloadFunc(func(_ context.Context, binID int64, fn func(io.ReadSeeker) error) error {
+			assert.Equal(t, int64(7), binID)
+			return fn(bytes.NewReader(tcase.stored))
+		})
+
+		s := BinaryStatusFunc(func(_ context.Context, userID string, binID int64) (storage.UploadStatus, error) {
+			assert.Equal(t, "user", userID)
+			// объём хранимого не меньше содержимого
+			return storage.UploadStatus{Size: int64(len(tcase.stored)), FileKey: tcase.fileKey, Compression: tcase.compression}, tcase.statusErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		out := &downloadStream{}
+		req := pb.BidaryDownloadRequest{Id: 7, Offset: tcase.offset, Length: tcase.length, ChunkSize: tcase.chunkSize}
+		if tcase.wantChunk == 0 {
+			tcase.wantChunk = transfer.ChunkSize
+		}
+
+		t.Run(tcase.name, func(t *testing.T) {
+			err := NewGRPCDownloadHandler(d, s, getUserID, plainKeys{}, transfer)(&req, out)
+			if tcase.wantStatus == 0 {
+				require.NoError(t, err)
+				assert.Equal(t, tcase.want, out.received.String())
+				assert.LessOrEqual(t, out.maxChunk, tcase.wantChunk)
+				if tcase.chunkSize > 0 {
+					assert.Equal(t, tcase.wantChunk, out.maxChunk)
+				}
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, tcase.wantStatus, status.Code(err))
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -15,38 +14,45 @@ import (
 )
 
 type BinaryListGetter interface {
-	BinaryList(ctx context.Context, userID string) ([]string, error)
+	BinaryList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
 }
 
-type BinaryListGetterFunc func(ctx context.Context, userID string) ([]string, error)
+type BinaryListGetterFunc func(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
 
-func (f BinaryListGetterFunc) BinaryList(ctx context.Context, userID string) ([]string, error) {
-	return f(ctx, userID)
+func (f BinaryListGetterFunc) BinaryList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return f(ctx, q)
 }
 
 var _ BinaryListGetter = BinaryListGetterFunc(nil)
 
-type GRPCListHandler func(ctx context.Context, in *empty.Empty) (*pb.BinaryListResponse, error)
+type GRPCListHandler func(ctx context.Context, in *pb.ListRequest) (*pb.BinaryListResponse, error)
 
-// NewGRPCListHandler - функция-конструктор ручки получения списка наименований бинарников
+// NewGRPCListHandler - функция-конструктор ручки получения страницы списка бинарников
 func NewGRPCListHandler(g BinaryListGetter, getUserID handler.GetUserIDFunc) GRPCListHandler {
-	return func(ctx context.Context, in *empty.Empty) (*pb.BinaryListResponse, error) {
+	return func(ctx context.Context, in *pb.ListRequest) (*pb.BinaryListResponse, error) {
 
 		userID, err := getUserID(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		var resp pb.BinaryListResponse
-		resp.Names, err = g.BinaryList(ctx, userID)
+		q, err := handler.NewListQuery(userID, in)
+		if err != nil {
+			return nil, err
+		}
+
+		entries, err := g.BinaryList(ctx, q)
 		if err != nil {
 			if errors.Is(err, storage.ErrNoContent) {
 				return nil, status.Error(codes.NotFound, err.Error())
 			}
 
-			logger.Errorf("read notes list error: %w", err)
+			logger.Errorf("read binaries list error: %w", err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		var resp pb.BinaryListResponse
+		resp.Entries, resp.NextCursor = handler.ListPage(q, entries)
 		return &resp, nil
 	}
 }
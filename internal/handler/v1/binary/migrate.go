@@ -0,0 +1,73 @@
+package binary
+
+import (
+	"context"
+	"errors"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// PlainRewriter перенос содержимого, хранящегося без шифрования
+type PlainRewriter interface {
+	PlainBinaries(ctx context.Context) ([]int64, error)
+	BinaryRewrite(ctx context.Context, binID int64, fileKey []byte, blockSize int,
+		fn func(index int64, block []byte) ([]byte, error)) (int64, error)
+}
+
+// EncryptPlain шифрование содержимого файлов, загруженных до появления
+// шифрования. Каждый файл получает свой ключ, ошибка на одном файле
+// не останавливает остальные. Возвращает количество зашифрованных файлов.
+func EncryptPlain(ctx context.Context, r PlainRewriter, enc crypt.Encryptor) (int, error) {
+	ids, err := r.PlainBinaries(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrNoContent) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var (
+		count int
+		errs  []error
+	)
+	for _, binID := range ids {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+
+		if err = encryptBinary(ctx, r, enc, binID); err != nil {
+			// файл удалён или изменён, пока ждал своей очереди
+			if errors.Is(err, storage.ErrNoContent) {
+				continue
+			}
+			logger.Errorf("encrypt binary error: %w", err, "id", binID)
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+	return count, errors.Join(errs...)
+}
+
+// encryptBinary перенос одного файла в зашифрованный большой объект
+func encryptBinary(ctx context.Context, r PlainRewriter, enc crypt.Encryptor, binID int64) error {
+	key, err := stream.NewKey()
+	if err != nil {
+		return err
+	}
+	codec, err := stream.NewCodec(key, stream.CompressionNone)
+	if err != nil {
+		return err
+	}
+	wrapped, err := enc.Encrypt(key)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.BinaryRewrite(ctx, binID, wrapped, stream.BlockSize, codec.Encode)
+	return err
+}
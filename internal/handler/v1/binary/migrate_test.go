@@ -0,0 +1,90 @@
+package binary
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// plainStore содержимое без шифрования по идентификатору бинарника
+type plainStore struct {
+	plain   map[int64]string
+	keys    map[int64][]byte
+	sealed  map[int64][]byte
+	failID  int64
+	goneID  int64
+	listErr error
+}
+
+func (p *plainStore) PlainBinaries(context.Context) ([]int64, error) {
+	return []int64{1, 2, 3, 4}, p.listErr
+}
+
+func (p *plainStore) BinaryRewrite(_ context.Context, binID int64, fileKey []byte, blockSize int,
+	fn func(index int64, block []byte) ([]byte, error)) (int64, error) {
+
+	switch binID {
+	case p.failID:
+		return 0, errors.New("rewrite error")
+	case p.goneID:
+		return 0, storage.ErrNoContent
+	}
+
+	plain := []byte(p.plain[binID])
+	var sealed []byte
+	for index := int64(0); len(plain) > 0; index++ {
+		n := blockSize
+		if n > len(plain) {
+			n = len(plain)
+		}
+		out, err := fn(index, plain[:n])
+		if err != nil {
+			return 0, err
+		}
+		sealed = append(sealed, out...)
+		plain = plain[n:]
+	}
+	p.keys[binID] = fileKey
+	p.sealed[binID] = sealed
+	return binID + 100, nil
+}
+
+func TestEncryptPlain(t *testing.T) {
+
+	t.Run("ok", func(t *testing.T) {
+		p := &plainStore{
+			plain:  map[int64]string{1: "abc", 2: "", 3: "def", 4: "ghi"},
+			keys:   map[int64][]byte{},
+			sealed: map[int64][]byte{},
+			failID: 3,
+			goneID: 4,
+		}
+
+		n, err := EncryptPlain(context.Background(), p, plainKeys{})
+		assert.EqualError(t, err, "rewrite error")
+		assert.Equal(t, 2, n)
+
+		c, err := stream.New(p.keys[1])
+		require.NoError(t, err)
+		plain, err := c.Open(0, p.sealed[1])
+		require.NoError(t, err)
+		assert.Equal(t, "abc", string(plain))
+
+		assert.Len(t, p.keys[2], stream.KeySize)
+		assert.NotEqual(t, p.keys[1], p.keys[2])
+	})
+
+	t.Run("nothing to encrypt", func(t *testing.T) {
+		p := &plainStore{listErr: storage.ErrNoContent}
+
+		n, err := EncryptPlain(context.Background(), p, plainKeys{})
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+}
@@ -0,0 +1,330 @@
+package binary
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type BinaryPartsBeginner interface {
+	BinaryPartsBegin(ctx context.Context, binID int64, progress storage.UploadProgress) error
+}
+
+type BinaryPartsBeginFunc func(ctx context.Context, binID int64, progress storage.UploadProgress) error
+
+func (f BinaryPartsBeginFunc) BinaryPartsBegin(ctx context.Context, binID int64, progress storage.UploadProgress) error {
+	return f(ctx, binID, progress)
+}
+
+var _ BinaryPartsBeginner = BinaryPartsBeginFunc(nil)
+
+type BinaryPartUploader interface {
+	BinaryUploadPart(ctx context.Context, binID, offset int64,
+		fn func(w storage.BlobWriter) (storage.UploadProgress, error)) error
+}
+
+type BinaryUploadPartFunc func(ctx context.Context, binID, offset int64,
+	fn func(w storage.BlobWriter) (storage.UploadProgress, error)) error
+
+func (f BinaryUploadPartFunc) BinaryUploadPart(ctx context.Context, binID, offset int64,
+	fn func(w storage.BlobWriter) (storage.UploadProgress, error)) error {
+	return f(ctx, binID, offset, fn)
+}
+
+var _ BinaryPartUploader = BinaryUploadPartFunc(nil)
+
+type BinaryAssembler interface {
+	BinaryAssemble(ctx context.Context, binID int64,
+		fn func(w storage.BlobWriter, parts []storage.PartReader) (storage.UploadProgress, error)) error
+}
+
+type BinaryAssembleFunc func(ctx context.Context, binID int64,
+	fn func(w storage.BlobWriter, parts []storage.PartReader) (storage.UploadProgress, error)) error
+
+func (f BinaryAssembleFunc) BinaryAssemble(ctx context.Context, binID int64,
+	fn func(w storage.BlobWriter, parts []storage.PartReader) (storage.UploadProgress, error)) error {
+	return f(ctx, binID, fn)
+}
+
+var _ BinaryAssembler = BinaryAssembleFunc(nil)
+
+type GRPCPartsBeginHandler func(ctx context.Context, in *pb.BinaryPartsBeginRequest) (*empty.Empty, error)
+
+// NewGRPCPartsBeginHandler - функция-конструктор ручки начала загрузки
+// частями: для нового содержимого создаётся ключ, прежнее содержимое
+// и незавершённые части отбрасываются
+func NewGRPCPartsBeginHandler(b BinaryPartsBeginner, s BinaryStatusReader,
+	getUserID handler.GetUserIDFunc, keys crypt.Encryptor) GRPCPartsBeginHandler {
+
+	return func(ctx context.Context, in *pb.BinaryPartsBeginRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = uploadStatus(ctx, s, userID, in.Id); err != nil {
+			return nil, err
+		}
+
+		progress := storage.UploadProgress{Compression: in.Compression}
+		key, err := stream.NewKey()
+		if err == nil {
+			progress.FileKey, err = keys.Encrypt(key)
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = b.BinaryPartsBegin(ctx, in.Id, progress)
+		if errors.Is(err, storage.ErrNoContent) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		} else if err != nil {
+			logger.Errorf("begin binary parts error: %w", err, "id", in.Id)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &empty.Empty{}, nil
+	}
+}
+
+type GRPCUploadPartHandler func(ps pb.GophKeeper_BinaryUploadPartServer) error
+
+// NewGRPCUploadPartHandler - функция-конструктор ручки загрузки части.
+// Блоки части шифруются ключом, созданным в начале загрузки частями,
+// и пишутся одной транзакцией в отдельный большой объект: часть
+// сохраняется, только если поток завершился без ошибки.
+func NewGRPCUploadPartHandler(u BinaryPartUploader, s BinaryStatusReader,
+	getUserID handler.GetUserIDFunc, keys crypt.Decryptor) GRPCUploadPartHandler {
+
+	return func(server pb.GophKeeper_BinaryUploadPartServer) error {
+		ctx := server.Context()
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return err
+		}
+
+		in, err := server.Recv()
+		if err == io.EOF {
+			return status.Error(codes.InvalidArgument, "part offset expected")
+		} else if err != nil {
+			return err
+		}
+		if in.Offset%stream.BlockSize != 0 {
+			return status.Errorf(codes.InvalidArgument,
+				"part offset %d is not a multiple of block size %d", in.Offset, stream.BlockSize)
+		}
+
+		current, err := uploadStatus(ctx, s, userID, in.Id)
+		if err != nil {
+			return err
+		}
+		if in.Offset >= current.Size {
+			return status.Errorf(codes.OutOfRange, "offset %d beyond size %d", in.Offset, current.Size)
+		}
+		codec, err := partsCodec(current, keys)
+		if err != nil {
+			return err
+		}
+
+		first := in.Offset / stream.BlockSize
+		up := upload{
+			codec:  codec,
+			buf:    make([]byte, 0, stream.BlockSize),
+			offset: in.Offset,
+			pos:    -1,
+			base:   codec.Offset(first),
+		}
+		err = u.BinaryUploadPart(ctx, in.Id, in.Offset, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+			up.w = w
+			return up.progress, uploadPart(server, &up, in, current.Size)
+		})
+		if _, ok := status.FromError(err); !ok {
+			logger.Errorf("upload binary part error: %w", err, "id", in.Id, "offset", in.Offset)
+			return status.Error(codes.Internal, err.Error())
+		} else if err != nil {
+			return err
+		}
+		return server.SendAndClose(&emptypb.Empty{})
+	}
+}
+
+// uploadPart приём фрагментов части до конца потока, начиная с уже
+// полученного первого сообщения in
+func uploadPart(server pb.GophKeeper_BinaryUploadPartServer, up *upload,
+	in *pb.BinaryUploadPartStream, size int64) error {
+
+	start := up.offset
+	for {
+		if up.offset+int64(len(up.buf)+len(in.Chunk)) > size {
+			return status.Errorf(codes.InvalidArgument, "part exceeds declared size %d", size)
+		}
+		if err := up.write(in.Chunk); err != nil {
+			return err
+		}
+
+		var err error
+		in, err = server.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if err := up.flush(); err != nil {
+		return err
+	}
+	if up.offset == start {
+		return status.Error(codes.InvalidArgument, "empty part")
+	}
+	// следующая часть начинается с границы блока
+	if up.offset%stream.BlockSize != 0 && up.offset != size {
+		return status.Errorf(codes.InvalidArgument,
+			"part ends at %d inside a block before the end of file", up.offset)
+	}
+	return nil
+}
+
+type GRPCPartsCompleteHandler func(ctx context.Context, in *pb.BinaryPartsCompleteRequest) (*empty.Empty, error)
+
+// NewGRPCPartsCompleteHandler - функция-конструктор ручки сборки частей.
+// Части должны покрывать заявленный размер без пропусков. Блоки частей
+// расшифровываются для подсчёта дайджеста и переносятся в содержимое
+// файла без повторного шифрования одной транзакцией. При несовпадении
+// дайджеста с переданным клиентом файл остаётся несобранным.
+func NewGRPCPartsCompleteHandler(a BinaryAssembler, s BinaryStatusReader,
+	getUserID handler.GetUserIDFunc, keys crypt.Decryptor) GRPCPartsCompleteHandler {
+
+	return func(ctx context.Context, in *pb.BinaryPartsCompleteRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		current, err := uploadStatus(ctx, s, userID, in.Id)
+		if err != nil {
+			return nil, err
+		}
+		codec, err := partsCodec(current, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		err = a.BinaryAssemble(ctx, in.Id, func(w storage.BlobWriter, parts []storage.PartReader) (storage.UploadProgress, error) {
+			progress, err := assemble(w, parts, codec, current.Size)
+			if err == nil && len(in.Sha256) > 0 && !bytes.Equal(in.Sha256, progress.SHA256) {
+				err = status.Errorf(codes.DataLoss,
+					"sha256 mismatch: got %x, want %x", progress.SHA256, in.Sha256)
+			}
+			return progress, err
+		})
+
+		if errors.Is(err, storage.ErrNoContent) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		} else if errors.Is(err, stream.ErrBlock) {
+			logger.Errorf("assemble binary parts error: %w", err, "id", in.Id)
+			return nil, status.Error(codes.DataLoss, err.Error())
+		} else if _, ok := status.FromError(err); !ok {
+			logger.Errorf("assemble binary parts error: %w", err, "id", in.Id)
+			return nil, status.Error(codes.Internal, err.Error())
+		} else if err != nil {
+			return nil, err
+		}
+		return &empty.Empty{}, nil
+	}
+}
+
+// partsCodec кодек загрузки частями по ключу, созданному в её начале
+func partsCodec(current storage.UploadStatus, keys crypt.Decryptor) (*stream.Codec, error) {
+	if current.Uploaded || len(current.FileKey) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "upload by parts is not begun")
+	}
+	key, err := keys.Decrypt(current.FileKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	codec, err := stream.NewCodec(key, current.Compression)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return codec, nil
+}
+
+// assemble перенос блоков частей по порядку в содержимое файла размером
+// size со сверкой каждого блока. Тип содержимого определяется по первому блоку.
+func assemble(w storage.BlobWriter, parts []storage.PartReader,
+	codec *stream.Codec, size int64) (storage.UploadProgress, error) {
+
+	var progress storage.UploadProgress
+	if err := w.Truncate(0); err != nil {
+		return progress, err
+	}
+
+	h := sha256.New()
+	buf := make([]byte, codec.SlotSize())
+	pos := int64(0)
+	for _, part := range parts {
+		if part.Offset != progress.Committed {
+			return progress, status.Errorf(codes.FailedPrecondition,
+				"missing part at offset %d", progress.Committed)
+		}
+
+		end := part.Offset + part.Size
+		for index := part.Offset / stream.BlockSize; progress.Committed < end; index++ {
+			n, err := io.ReadFull(part, buf)
+			if err == io.EOF {
+				return progress, fmt.Errorf("part %d is short: %w", part.Offset, stream.ErrBlock)
+			} else if err != nil && err != io.ErrUnexpectedEOF {
+				return progress, err
+			}
+
+			plain, err := codec.Decode(index, buf[:n])
+			if err != nil {
+				return progress, err
+			}
+			rec, err := codec.Record(buf[:n])
+			if err != nil {
+				return progress, err
+			}
+			if index == 0 {
+				progress.ContentType = http.DetectContentType(plain)
+			}
+
+			if offset := codec.Offset(index); offset != pos {
+				if _, err = w.Seek(offset, io.SeekStart); err != nil {
+					return progress, err
+				}
+				pos = offset
+			}
+			if _, err = w.Write(rec); err != nil {
+				return progress, err
+			}
+			pos += int64(len(rec))
+
+			h.Write(plain)
+			progress.Committed += int64(len(plain))
+			progress.StoredSize += int64(len(rec))
+		}
+	}
+
+	if progress.Committed != size {
+		return progress, status.Errorf(codes.FailedPrecondition,
+			"parts cover %d of %d bytes", progress.Committed, size)
+	}
+	progress.SHA256 = h.Sum(nil)
+	return progress, nil
+}
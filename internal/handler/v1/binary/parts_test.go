@@ -0,0 +1,386 @@
+package binary
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// partStream входящий поток фрагментов части
+type partStream struct {
+	grpc.ServerStream
+	chunks []*pb.BinaryUploadPartStream
+	err    error // ошибка после фрагментов, по умолчанию io.EOF
+	closed bool
+}
+
+func (s *partStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *partStream) Recv() (*pb.BinaryUploadPartStream, error) {
+	if len(s.chunks) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func (s *partStream) SendAndClose(*emptypb.Empty) error {
+	s.closed = true
+	return nil
+}
+
+var testUser = handler.GetUserIDFunc(func(context.Context) (string, error) {
+	return "user", nil
+})
+
+// memParts части в памяти, загружаемые ручкой части и собираемые
+// ручкой сборки
+type memParts struct {
+	parts map[int64]*memBlob
+	sizes map[int64]int64
+}
+
+func (m *memParts) upload(_ context.Context, binID, offset int64,
+	fn func(w storage.BlobWriter) (storage.UploadProgress, error)) error {
+
+	blob := &memBlob{}
+	progress, err := fn(blob)
+	if err != nil {
+		return err
+	}
+	m.parts[offset] = blob
+	m.sizes[offset] = progress.Committed - offset
+	return nil
+}
+
+func (m *memParts) readers() []storage.PartReader {
+	var res []storage.PartReader
+	for offset := int64(0); ; {
+		blob, ok := m.parts[offset]
+		if !ok {
+			return res
+		}
+		part := storage.BinaryPart{Offset: offset, Size: m.sizes[offset]}
+		res = append(res, storage.PartReader{BinaryPart: part, ReadSeeker: bytes.NewReader(blob.data)})
+		offset += part.Size
+	}
+}
+
+func TestGRPCPartsBeginHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		userErr    error
+		statusErr  error
+		beginErr   error
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			statusErr:  storage.ErrNoContent,
+		},
+		{
+			name:       "begin error",
+			wantStatus: codes.Internal,
+			beginErr:   errors.New("begin error"),
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			var begun storage.UploadProgress
+			b := BinaryPartsBeginFunc(func(_ context.Context, binID int64, progress storage.UploadProgress) error {
+				assert.Equal(t, int64(7), binID)
+				begun = progress
+				return tcase.beginErr
+			})
+			s := BinaryStatusFunc(func(context.Context, string, int64) (storage.UploadStatus, error) {
+				return storage.UploadStatus{}, tcase.statusErr
+			})
+			getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+				return "user", tcase.userErr
+			})
+
+			in := pb.BinaryPartsBeginRequest{Id: 7, Compression: stream.CompressionZstd}
+			_, err := NewGRPCPartsBeginHandler(b, s, getUserID, plainKeys{})(context.Background(), &in)
+			if tcase.wantStatus != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tcase.wantStatus, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, begun.FileKey, stream.KeySize)
+			assert.Equal(t, stream.CompressionZstd, begun.Compression)
+		})
+	}
+}
+
+func TestGRPCUploadPartHandler(t *testing.T) {
+	fileKey := bytes.Repeat([]byte{1}, stream.KeySize)
+	block := strings.Repeat("x", stream.BlockSize)
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		wantSize   int64
+		size       int64
+		fileKey    []byte
+		uploaded   bool
+		chunks     []*pb.BinaryUploadPartStream
+		streamErr  error
+	}{
+		{
+			name:     "first part",
+			wantSize: stream.BlockSize,
+			size:     stream.BlockSize + 3,
+			chunks: []*pb.BinaryUploadPartStream{
+				{Id: 7, Chunk: []byte(block[:100])},
+				{Id: 7, Chunk: []byte(block[100:])},
+			},
+		},
+		{
+			name:     "last part",
+			wantSize: 3,
+			size:     stream.BlockSize + 3,
+			chunks:   []*pb.BinaryUploadPartStream{{Id: 7, Offset: stream.BlockSize, Chunk: []byte("abc")}},
+		},
+		{
+			name:       "offset inside block",
+			wantStatus: codes.InvalidArgument,
+			size:       stream.BlockSize + 3,
+			chunks:     []*pb.BinaryUploadPartStream{{Id: 7, Offset: 5, Chunk: []byte("abc")}},
+		},
+		{
+			name:       "offset beyond size",
+			wantStatus: codes.OutOfRange,
+			size:       3,
+			chunks:     []*pb.BinaryUploadPartStream{{Id: 7, Offset: stream.BlockSize, Chunk: []byte("abc")}},
+		},
+		{
+			name:       "ends inside block",
+			wantStatus: codes.InvalidArgument,
+			size:       stream.BlockSize + 3,
+			chunks:     []*pb.BinaryUploadPartStream{{Id: 7, Chunk: []byte("abc")}},
+		},
+		{
+			name:       "exceeds size",
+			wantStatus: codes.InvalidArgument,
+			size:       2,
+			chunks:     []*pb.BinaryUploadPartStream{{Id: 7, Chunk: []byte("abc")}},
+		},
+		{
+			name:       "empty part",
+			wantStatus: codes.InvalidArgument,
+			size:       3,
+			chunks:     []*pb.BinaryUploadPartStream{{Id: 7}},
+		},
+		{
+			name:       "no offset",
+			wantStatus: codes.InvalidArgument,
+			size:       3,
+		},
+		{
+			name:       "not begun",
+			wantStatus: codes.FailedPrecondition,
+			size:       3,
+			fileKey:    []byte{},
+			chunks:     []*pb.BinaryUploadPartStream{{Id: 7, Chunk: []byte("abc")}},
+		},
+		{
+			name:       "already uploaded",
+			wantStatus: codes.FailedPrecondition,
+			size:       3,
+			uploaded:   true,
+			chunks:     []*pb.BinaryUploadPartStream{{Id: 7, Chunk: []byte("abc")}},
+		},
+		{
+			name:       "dropped",
+			wantStatus: codes.Unavailable,
+			size:       stream.BlockSize + 3,
+			chunks:     []*pb.BinaryUploadPartStream{{Id: 7, Chunk: []byte(block)}},
+			streamErr:  status.Error(codes.Unavailable, "connection lost"),
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			if tcase.fileKey == nil {
+				tcase.fileKey = fileKey
+			}
+			parts := &memParts{parts: make(map[int64]*memBlob), sizes: make(map[int64]int64)}
+			s := BinaryStatusFunc(func(context.Context, string, int64) (storage.UploadStatus, error) {
+				return storage.UploadStatus{Size: tcase.size, FileKey: tcase.fileKey, Uploaded: tcase.uploaded}, nil
+			})
+
+			server := &partStream{chunks: tcase.chunks, err: tcase.streamErr}
+			err := NewGRPCUploadPartHandler(BinaryUploadPartFunc(parts.upload), s, testUser, plainKeys{})(server)
+			if tcase.wantStatus != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tcase.wantStatus, status.Code(err))
+				assert.Empty(t, parts.parts)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, server.closed)
+			offset := tcase.chunks[0].Offset
+			assert.Equal(t, tcase.wantSize, parts.sizes[offset])
+
+			// часть из одного блока пишется с начала своего объекта
+			var sent []byte
+			for _, in := range tcase.chunks {
+				sent = append(sent, in.Chunk...)
+			}
+			c, err := stream.NewCodec(fileKey, stream.CompressionNone)
+			require.NoError(t, err)
+			plain, err := c.Decode(offset/stream.BlockSize, parts.parts[offset].data)
+			require.NoError(t, err)
+			assert.Equal(t, sent, plain)
+		})
+	}
+}
+
+func TestGRPCPartsCompleteHandler(t *testing.T) {
+	fileKey := bytes.Repeat([]byte{1}, stream.KeySize)
+	content := strings.Repeat("<html>parts</html>", stream.BlockSize/5)
+
+	// upload загрузка содержимого частями по n блоков
+	upload := func(t *testing.T, compression string, n int) *memParts {
+		parts := &memParts{parts: make(map[int64]*memBlob), sizes: make(map[int64]int64)}
+		s := BinaryStatusFunc(func(context.Context, string, int64) (storage.UploadStatus, error) {
+			return storage.UploadStatus{Size: int64(len(content)), FileKey: fileKey, Compression: compression}, nil
+		})
+		h := NewGRPCUploadPartHandler(BinaryUploadPartFunc(parts.upload), s, testUser, plainKeys{})
+		for offset := 0; offset < len(content); offset += n * stream.BlockSize {
+			end := offset + n*stream.BlockSize
+			if end > len(content) {
+				end = len(content)
+			}
+			chunks := []*pb.BinaryUploadPartStream{{Id: 7, Offset: int64(offset), Chunk: []byte(content[offset:end])}}
+			require.NoError(t, h(&partStream{chunks: chunks}))
+		}
+		return parts
+	}
+
+	tests := []struct {
+		name        string
+		wantStatus  codes.Code
+		compression string
+		sha256      []byte
+		alter       func(parts []storage.PartReader) []storage.PartReader
+		uploaded    bool
+	}{
+		{
+			name:   "ok",
+			sha256: digest(content),
+		},
+		{
+			name:        "compressed",
+			compression: stream.CompressionZstd,
+			sha256:      digest(content),
+		},
+		{
+			name:       "digest mismatch",
+			wantStatus: codes.DataLoss,
+			sha256:     digest("other"),
+		},
+		{
+			name:       "missing part",
+			wantStatus: codes.FailedPrecondition,
+			alter: func(parts []storage.PartReader) []storage.PartReader {
+				return append(parts[:1], parts[2:]...)
+			},
+		},
+		{
+			name:       "missing last part",
+			wantStatus: codes.FailedPrecondition,
+			alter: func(parts []storage.PartReader) []storage.PartReader {
+				return parts[:len(parts)-1]
+			},
+		},
+		{
+			name:       "tampered part",
+			wantStatus: codes.DataLoss,
+			alter: func(parts []storage.PartReader) []storage.PartReader {
+				data, _ := io.ReadAll(parts[1])
+				data[10] ^= 1
+				parts[1].ReadSeeker = bytes.NewReader(data)
+				return parts
+			},
+		},
+		{
+			name:       "not begun",
+			wantStatus: codes.FailedPrecondition,
+			uploaded:   true,
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			parts := upload(t, tcase.compression, 1)
+			readers := parts.readers()
+			require.Len(t, readers, 4)
+			if tcase.alter != nil {
+				readers = tcase.alter(readers)
+			}
+
+			s := BinaryStatusFunc(func(context.Context, string, int64) (storage.UploadStatus, error) {
+				return storage.UploadStatus{Size: int64(len(content)), FileKey: fileKey,
+					Compression: tcase.compression, Uploaded: tcase.uploaded}, nil
+			})
+			blob := &memBlob{data: []byte("previous")}
+			var saved storage.UploadProgress
+			a := BinaryAssembleFunc(func(_ context.Context, binID int64,
+				fn func(storage.BlobWriter, []storage.PartReader) (storage.UploadProgress, error)) error {
+				assert.Equal(t, int64(7), binID)
+				var err error
+				saved, err = fn(blob, readers)
+				return err
+			})
+
+			in := pb.BinaryPartsCompleteRequest{Id: 7, Sha256: tcase.sha256}
+			_, err := NewGRPCPartsCompleteHandler(a, s, testUser, plainKeys{})(context.Background(), &in)
+			if tcase.wantStatus != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tcase.wantStatus, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, content, blob.open(t, fileKey, tcase.compression, 0))
+			assert.Equal(t, digest(content), saved.SHA256)
+			assert.Equal(t, int64(len(content)), saved.Committed)
+			if tcase.compression == stream.CompressionNone {
+				assert.Equal(t, int64(len(blob.data)), saved.StoredSize)
+			}
+			assert.Equal(t, "text/html; charset=utf-8", saved.ContentType)
+		})
+	}
+}
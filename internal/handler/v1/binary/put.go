@@ -0,0 +1,155 @@
+package binary
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type BinaryPutter interface {
+	BinaryPut(ctx context.Context, data storage.BinaryData,
+		fn func(w storage.BlobWriter) (storage.UploadProgress, error)) (int64, error)
+}
+
+type BinaryPutFunc func(ctx context.Context, data storage.BinaryData,
+	fn func(w storage.BlobWriter) (storage.UploadProgress, error)) (int64, error)
+
+func (f BinaryPutFunc) BinaryPut(ctx context.Context, data storage.BinaryData,
+	fn func(w storage.BlobWriter) (storage.UploadProgress, error)) (int64, error) {
+	return f(ctx, data, fn)
+}
+
+var _ BinaryPutter = BinaryPutFunc(nil)
+
+type GRPCPutHandler func(ps pb.GophKeeper_BinaryPutServer) error
+
+// NewGRPCPutHandler - функция-конструктор ручки создания файла вместе
+// с содержимым. Первое сообщение потока - заголовок, за ним фрагменты.
+// Содержимое шифруется и сжимается так же, как при BinaryUpload, файл
+// сохраняется одной транзакцией, только если получен ровно заявленный
+// размер и совпал дайджест, когда он передан.
+func NewGRPCPutHandler(p BinaryPutter, getUserID handler.GetUserIDFunc,
+	keys crypt.EncryptDecryptor, pub broker.Publisher, quota *handler.Quota) GRPCPutHandler {
+
+	return func(server pb.GophKeeper_BinaryPutServer) error {
+		ctx := server.Context()
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return err
+		}
+
+		in, err := server.Recv()
+		if err == io.EOF {
+			return status.Error(codes.InvalidArgument, "header expected")
+		} else if err != nil {
+			return err
+		}
+		header := in.GetHeader()
+		if header == nil {
+			return status.Error(codes.InvalidArgument, "first message must be header")
+		}
+
+		if err = quota.CheckItems(ctx, userID, storage.KindBinary, 1); err != nil {
+			return err
+		}
+		if err = quota.CheckFile(ctx, userID, header.Size, 0); err != nil {
+			return err
+		}
+
+		write := storage.BinaryData{
+			UserID:    userID,
+			Name:      header.Name,
+			Size:      header.Size,
+			ExpiresAt: handler.ExpiresAt(header.ExpiresAt),
+		}
+		setFileMeta(&write, header.Meta)
+		write.Notes, err = keys.Encrypt([]byte(header.Notes))
+		if err != nil {
+			logger.Errorf("encrypt notes error: %w", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		up := upload{
+			hash:        sha256.New(),
+			buf:         make([]byte, 0, stream.BlockSize),
+			compression: header.Compression,
+		}
+		if err = up.start(storage.UploadStatus{}, keys); err != nil {
+			return err
+		}
+		// большой объект новый, отбрасывать нечего
+		up.fresh = false
+		up.progress.ContentType = header.ContentType
+
+		id, err := p.BinaryPut(ctx, write, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+			up.w = w
+			return up.progress, put(server, &up, header)
+		})
+
+		if errors.Is(err, storage.ErrWriteConflict) {
+			return status.Error(codes.AlreadyExists, err.Error())
+		} else if _, ok := status.FromError(err); !ok {
+			logger.Errorf("put binary error: %w", err, "name", header.Name)
+			return status.Error(codes.Internal, err.Error())
+		} else if err != nil {
+			return err
+		}
+
+		handler.Notify(ctx, pub, userID, storage.KindBinary, write.Name, broker.ActionCreate)
+		return server.SendAndClose(&pb.BinaryWriteResponse{Id: id})
+	}
+}
+
+// put приём фрагментов до конца потока со сверкой размера и дайджеста,
+// дайджест завершённой загрузки сохраняется в up.progress
+func put(server pb.GophKeeper_BinaryPutServer, up *upload, header *pb.BinaryPutHeader) error {
+	for {
+		in, err := server.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if in.GetHeader() != nil {
+			return status.Error(codes.InvalidArgument, "header already received")
+		}
+		chunk := in.GetChunk()
+		if up.offset+int64(len(up.buf)+len(chunk)) > header.Size {
+			return status.Errorf(codes.InvalidArgument,
+				"content exceeds declared size %d", header.Size)
+		}
+		if err = up.write(chunk); err != nil {
+			return err
+		}
+	}
+
+	if err := up.flush(); err != nil {
+		return err
+	}
+	if up.offset != header.Size {
+		return status.Errorf(codes.InvalidArgument,
+			"received %d bytes, declared size %d", up.offset, header.Size)
+	}
+
+	sum := up.hash.Sum(nil)
+	if len(header.Sha256) > 0 && !bytes.Equal(header.Sha256, sum) {
+		return status.Errorf(codes.DataLoss,
+			"sha256 mismatch: got %x, want %x", sum, header.Sha256)
+	}
+	up.progress.SHA256 = sum
+	return nil
+}
@@ -0,0 +1,251 @@
+package binary
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// putStream входящий поток создания файла
+type putStream struct {
+	grpc.ServerStream
+	msgs []*pb.BinaryPutStream
+	err  error // ошибка после сообщений, по умолчанию io.EOF
+	resp *pb.BinaryWriteResponse
+}
+
+func (s *putStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *putStream) Recv() (*pb.BinaryPutStream, error) {
+	if len(s.msgs) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	msg := s.msgs[0]
+	s.msgs = s.msgs[1:]
+	return msg, nil
+}
+
+func (s *putStream) SendAndClose(resp *pb.BinaryWriteResponse) error {
+	s.resp = resp
+	return nil
+}
+
+// putMsgs заголовок и фрагменты потока
+func putMsgs(header *pb.BinaryPutHeader, chunks ...string) []*pb.BinaryPutStream {
+	msgs := []*pb.BinaryPutStream{{Data: &pb.BinaryPutStream_Header{Header: header}}}
+	for _, chunk := range chunks {
+		msgs = append(msgs, &pb.BinaryPutStream{Data: &pb.BinaryPutStream_Chunk{Chunk: []byte(chunk)}})
+	}
+	return msgs
+}
+
+func TestGRPCPutHandler(t *testing.T) {
+
+	large := strings.Repeat("0123456789", stream.BlockSize/5)
+	png := "\x89PNG\r\n\x1a\n0000"
+	mtime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	header := func(size int64) *pb.BinaryPutHeader {
+		return &pb.BinaryPutHeader{Name: "name", Notes: "notes", Size: size, ContentType: "text/plain",
+			Meta: &pb.FileMeta{Filename: "a.txt", Mtime: timestamppb.New(mtime), Mode: 0640}}
+	}
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		want       string
+		wantType   string
+		msgs       []*pb.BinaryPutStream
+		streamErr  error
+		putErr     error
+		userErr    error
+		quota      *handler.Quota
+	}{
+		{
+			name:     "ok",
+			want:     "abcdef",
+			wantType: "text/plain",
+			msgs:     putMsgs(header(6), "abc", "def"),
+		},
+		{
+			name:     "one byte",
+			want:     "x",
+			wantType: "text/plain",
+			msgs:     putMsgs(header(1), "x"),
+		},
+		{
+			name:     "empty file",
+			wantType: "text/plain",
+			msgs:     putMsgs(header(0)),
+		},
+		{
+			name:     "content type sniffed",
+			want:     png,
+			wantType: "image/png",
+			msgs:     putMsgs(&pb.BinaryPutHeader{Name: "name", Size: int64(len(png))}, png[:3], png[3:]),
+		},
+		{
+			name:     "several blocks compressed",
+			want:     large,
+			wantType: "text/plain; charset=utf-8",
+			msgs: putMsgs(&pb.BinaryPutHeader{Name: "name", Size: int64(len(large)),
+				Compression: stream.CompressionZstd, Sha256: digest(large)}, large[:5000], large[5000:]),
+		},
+		{
+			name:       "short content",
+			wantStatus: codes.InvalidArgument,
+			msgs:       putMsgs(header(10), "abc"),
+		},
+		{
+			name:       "exceeds declared size",
+			wantStatus: codes.InvalidArgument,
+			msgs:       putMsgs(header(2), "abc"),
+		},
+		{
+			name:       "digest mismatch",
+			wantStatus: codes.DataLoss,
+			msgs:       putMsgs(&pb.BinaryPutHeader{Name: "name", Size: 3, Sha256: digest("abX")}, "abc"),
+		},
+		{
+			name:       "no header",
+			wantStatus: codes.InvalidArgument,
+			msgs:       putMsgs(header(3), "abc")[1:],
+		},
+		{
+			name:       "empty stream",
+			wantStatus: codes.InvalidArgument,
+		},
+		{
+			name:       "header twice",
+			wantStatus: codes.InvalidArgument,
+			msgs:       append(putMsgs(header(3)), putMsgs(header(3), "abc")...),
+		},
+		{
+			name:       "dropped",
+			wantStatus: codes.Unavailable,
+			msgs:       putMsgs(header(6), "abc"),
+			streamErr:  status.Error(codes.Unavailable, "connection lost"),
+		},
+		{
+			name:       "already exists",
+			wantStatus: codes.AlreadyExists,
+			msgs:       putMsgs(header(3), "abc"),
+			putErr:     storage.ErrWriteConflict,
+		},
+		{
+			name:       "put error",
+			wantStatus: codes.Internal,
+			msgs:       putMsgs(header(3), "abc"),
+			putErr:     errors.New("put error"),
+		},
+		{
+			name:       "file size limit",
+			wantStatus: codes.ResourceExhausted,
+			msgs:       putMsgs(header(6), "abc", "def"),
+			quota:      handler.NewQuota(handler.Limits{FileSize: 4}, usage{}),
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var (
+			events  []broker.Event
+			blob    = &memBlob{}
+			written storage.BinaryData
+			saved   *storage.UploadProgress
+		)
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		p := BinaryPutFunc(func(_ context.Context, data storage.BinaryData,
+			fn func(storage.BlobWriter) (storage.UploadProgress, error)) (int64, error) {
+
+			if tcase.putErr != nil {
+				return 0, tcase.putErr
+			}
+			written = data
+			progress, err := fn(blob)
+			if err != nil {
+				return 0, err
+			}
+			saved = &progress
+			return 7, nil
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		in := &putStream{msgs: tcase.msgs, err: tcase.streamErr}
+
+		t.Run(tcase.name, func(t *testing.T) {
+			err := NewGRPCPutHandler(p, getUserID, plainKeys{}, pub, tcase.quota)(in)
+			if tcase.wantStatus != 0 {
+				require.Error(t, err)
+				assert.Equal(t, tcase.wantStatus, status.Code(err))
+				assert.Nil(t, saved)
+				assert.Empty(t, events)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, int64(7), in.resp.GetId())
+			require.Len(t, events, 1)
+			assert.Equal(t, broker.ActionCreate, events[0].Action)
+
+			assert.Equal(t, "user", written.UserID)
+			assert.Equal(t, "name", written.Name)
+			assert.Equal(t, int64(len(tcase.want)), written.Size)
+			meta := tcase.msgs[0].GetHeader().GetMeta()
+			assert.Equal(t, meta.GetFilename(), written.Filename)
+			assert.Equal(t, meta.GetMode(), written.Mode)
+			if meta.GetMtime() != nil {
+				require.NotNil(t, written.MTime)
+				assert.Equal(t, mtime, *written.MTime)
+			} else {
+				assert.Nil(t, written.MTime)
+			}
+
+			require.NotNil(t, saved)
+			assert.Equal(t, digest(tcase.want), saved.SHA256)
+			assert.Equal(t, int64(len(tcase.want)), saved.Committed)
+			assert.Equal(t, blob.written, saved.StoredSize)
+			assert.False(t, blob.truncated)
+
+			compression := tcase.msgs[0].GetHeader().Compression
+			assert.Equal(t, compression, saved.Compression)
+			assert.Equal(t, tcase.wantType, saved.ContentType)
+			assert.Equal(t, tcase.want, blob.open(t, saved.FileKey, compression, 0))
+		})
+	}
+}
@@ -53,11 +53,17 @@ func NewGRPCReadHandler(r BinaryReader, getUserID handler.GetUserIDFunc, dec cry
 		}
 
 		resp := pb.BinaryReadResponse{
-			Id:    data.ID,
-			Name:  data.Name,
-			Size:  data.Size,
-			Notes: string(notes),
-			BinId: data.BinID,
+			Id:          data.ID,
+			Name:        data.Name,
+			Size:        data.Size,
+			Notes:       string(notes),
+			BinId:       data.BinID,
+			Sha256:      data.SHA256,
+			StoredSize:  data.StoredSize,
+			Compression: data.Compression,
+			ContentType: data.ContentType,
+			Meta:        fileMeta(data),
+			ExpiresAt:   handler.ExpiresAtPB(data.ExpiresAt),
 		}
 
 		return &resp, nil
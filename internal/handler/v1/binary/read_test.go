@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,7 @@ func TestGRPCreadHandler(t *testing.T) {
 		},
 	}
 
+	mtime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
 	for _, tcase := range tests {
 
 		req := pb.BinaryReadRequest{
@@ -63,11 +65,18 @@ func TestGRPCreadHandler(t *testing.T) {
 			if err == nil {
 				res.ID = 1
 				res.BinID = 2
+				res.SHA256 = []byte("sum")
 				res.UserID = "user_id"
 
 				res.Name = "name"
 				res.Notes = []byte("notes")
 				res.Size = 64
+				res.StoredSize = 40
+				res.Compression = "zstd"
+				res.ContentType = "text/plain"
+				res.Filename = "a.txt"
+				res.MTime = &mtime
+				res.Mode = 0640
 			}
 			return
 		})
@@ -97,6 +106,13 @@ func TestGRPCreadHandler(t *testing.T) {
 				assert.Equal(t, "name", resp.Name)
 				assert.Equal(t, "notes", resp.Notes)
 				assert.Equal(t, int64(64), resp.Size)
+				assert.Equal(t, []byte("sum"), resp.Sha256)
+				assert.Equal(t, int64(40), resp.StoredSize)
+				assert.Equal(t, "zstd", resp.Compression)
+				assert.Equal(t, "text/plain", resp.ContentType)
+				assert.Equal(t, "a.txt", resp.Meta.GetFilename())
+				assert.Equal(t, mtime, resp.Meta.GetMtime().AsTime())
+				assert.Equal(t, uint32(0640), resp.Meta.GetMode())
 
 			} else {
 				assert.Error(t, err)
@@ -0,0 +1,62 @@
+package binary
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type BinaryStatusReader interface {
+	BinaryUploadStatus(ctx context.Context, userID string, binID int64) (storage.UploadStatus, error)
+}
+
+type BinaryStatusFunc func(ctx context.Context, userID string, binID int64) (storage.UploadStatus, error)
+
+func (f BinaryStatusFunc) BinaryUploadStatus(ctx context.Context, userID string, binID int64) (storage.UploadStatus, error) {
+	return f(ctx, userID, binID)
+}
+
+var _ BinaryStatusReader = BinaryStatusFunc(nil)
+
+type GRPCUploadStatusHandler func(ctx context.Context, in *pb.BinaryUploadStatusRequest) (*pb.BinaryUploadStatusResponse, error)
+
+// NewGRPCUploadStatusHandler - функция-конструктор ручки состояния загрузки
+func NewGRPCUploadStatusHandler(s BinaryStatusReader, getUserID handler.GetUserIDFunc) GRPCUploadStatusHandler {
+	return func(ctx context.Context, in *pb.BinaryUploadStatusRequest) (*pb.BinaryUploadStatusResponse, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		st, err := uploadStatus(ctx, s, userID, in.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.BinaryUploadStatusResponse{
+			Committed: st.Committed,
+			Size:      st.Size,
+			Uploaded:  st.Uploaded,
+		}, nil
+	}
+}
+
+// uploadStatus чтение состояния загрузки с ошибкой в виде статуса gRPC
+func uploadStatus(ctx context.Context, s BinaryStatusReader, userID string, binID int64) (storage.UploadStatus, error) {
+	st, err := s.BinaryUploadStatus(ctx, userID, binID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNoContent) {
+			return st, status.Error(codes.NotFound, err.Error())
+		}
+		logger.Errorf("read upload status error: %w", err, "id", binID)
+		return st, status.Error(codes.Internal, err.Error())
+	}
+	return st, nil
+}
@@ -0,0 +1,87 @@
+package binary
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCUploadStatusHandler(t *testing.T) {
+
+	tests := []struct {
+		name          string
+		wantStatus    codes.Code
+		wantCommitted int64
+		userErr       error
+		statusErr     error
+		stored        int64
+	}{
+		{
+			name:          "ok",
+			wantCommitted: 3,
+			stored:        3,
+		},
+
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "status error",
+			wantStatus: codes.Internal,
+			statusErr:  errors.New("status error"),
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			statusErr:  storage.ErrNoContent,
+		},
+	}
+
+	for _, tcase := range tests {
+
+		reader := BinaryStatusFunc(func(ctx context.Context, userID string, binID int64) (storage.UploadStatus, error) {
+			assert.Equal(t, "user", userID)
+			assert.Equal(t, int64(7), binID)
+			return storage.UploadStatus{Committed: tcase.stored, Size: 6}, tcase.statusErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		req := pb.BinaryUploadStatusRequest{
+			Id: 7,
+		}
+		resp, err := NewGRPCUploadStatusHandler(reader, getUserID)(context.Background(), &req)
+
+		t.Run(tcase.name, func(t *testing.T) {
+			if tcase.wantStatus == 0 {
+				require.NoError(t, err)
+				assert.Equal(t, tcase.wantCommitted, resp.Committed)
+				assert.Equal(t, int64(6), resp.Size)
+				assert.False(t, resp.Uploaded)
+
+			} else {
+				assert.Error(t, err)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+
+}
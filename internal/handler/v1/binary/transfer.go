@@ -0,0 +1,25 @@
+package binary
+
+import (
+	"context"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+)
+
+type GRPCTransferHandler func(ctx context.Context, in *pb.BinaryTransferRequest) (*pb.BinaryTransferResponse, error)
+
+// NewGRPCTransferHandler - функция-конструктор ручки согласования
+// параметров передачи: запрошенные размер фрагмента и число частей
+// приводятся к пределам сервера
+func NewGRPCTransferHandler(t handler.Transfer) GRPCTransferHandler {
+	return func(ctx context.Context, in *pb.BinaryTransferRequest) (*pb.BinaryTransferResponse, error) {
+		return &pb.BinaryTransferResponse{
+			ChunkSize:    int32(t.Chunk(int(in.ChunkSize))),
+			Parts:        int32(t.Parts(int(in.Parts))),
+			BlockSize:    stream.BlockSize,
+			MaxChunkSize: int32(t.MaxChunk()),
+		}, nil
+	}
+}
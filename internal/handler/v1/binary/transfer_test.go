@@ -0,0 +1,48 @@
+package binary
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+)
+
+func TestGRPCTransferHandler(t *testing.T) {
+	h := NewGRPCTransferHandler(handler.Transfer{ChunkSize: 256 << 10, MaxRecvMsgSize: 1 << 20, MaxParts: 4})
+	limit := int32(1<<20 - 1<<10)
+
+	tests := []struct {
+		name string
+		in   *pb.BinaryTransferRequest
+		want *pb.BinaryTransferResponse
+	}{
+		{
+			name: "defaults",
+			in:   &pb.BinaryTransferRequest{},
+			want: &pb.BinaryTransferResponse{ChunkSize: 256 << 10, Parts: 4, BlockSize: stream.BlockSize, MaxChunkSize: limit},
+		},
+		{
+			name: "requested",
+			in:   &pb.BinaryTransferRequest{ChunkSize: 64 << 10, Parts: 2},
+			want: &pb.BinaryTransferResponse{ChunkSize: 64 << 10, Parts: 2, BlockSize: stream.BlockSize, MaxChunkSize: limit},
+		},
+		{
+			name: "clamped",
+			in:   &pb.BinaryTransferRequest{ChunkSize: 16 << 20, Parts: 100},
+			want: &pb.BinaryTransferResponse{ChunkSize: limit, Parts: 4, BlockSize: stream.BlockSize, MaxChunkSize: limit},
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			resp, err := h(context.Background(), tcase.in)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, resp)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
@@ -31,22 +32,31 @@ var _ BinaryUpdater = BinaryUpdaterFunc(nil)
 type GRPCUpdateHandler func(context.Context, *pb.BinaryUpdateRequest) (*empty.Empty, error)
 
 // NewGRPCUpdateHandler - функция конструктор ручки для обновления бинарника
-func NewGRPCUpdateHandler(u BinaryUpdater, getUserID handler.GetUserIDFunc, enc crypt.Encryptor) GRPCUpdateHandler {
+func NewGRPCUpdateHandler(u BinaryUpdater, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher, quota *handler.Quota) GRPCUpdateHandler {
 	return func(ctx context.Context, in *pb.BinaryUpdateRequest) (*empty.Empty, error) {
 		var err error
 
 		upd := storage.BinaryData{
-			ID:    in.Id,
-			BinID: in.BinId,
-			Name:  in.Write.Name,
-			Size:  in.Write.Size,
+			ID:        in.Id,
+			BinID:     in.BinId,
+			Name:      in.Write.Name,
+			Size:      in.Write.Size,
+			ExpiresAt: handler.ExpiresAt(in.Write.ExpiresAt),
 		}
+		setFileMeta(&upd, in.Write.Meta)
 
 		upd.UserID, err = getUserID(ctx)
 		if err != nil {
 			return nil, err
 		}
 
+		// содержимое заменяется только при указании бинарника
+		if upd.BinID != 0 {
+			if err = quota.CheckFile(ctx, upd.UserID, upd.Size, upd.BinID); err != nil {
+				return nil, err
+			}
+		}
+
 		upd.Notes, err = enc.Encrypt([]byte(in.Write.Notes))
 		if err != nil {
 			logger.Errorf("encrypt binary error: %w", err)
@@ -65,6 +75,7 @@ func NewGRPCUpdateHandler(u BinaryUpdater, getUserID handler.GetUserIDFunc, enc
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		handler.Notify(ctx, pub, upd.UserID, storage.KindBinary, upd.Name, broker.ActionUpdate)
 		return &empty.Empty{}, nil
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
@@ -59,6 +60,12 @@ func TestGRPCUpdateHandler(t *testing.T) {
 
 	for _, tcase := range tests {
 
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
 		req := pb.BinaryUpdateRequest{
 			Id:    1,
 			BinId: 2,
@@ -88,11 +95,14 @@ func TestGRPCUpdateHandler(t *testing.T) {
 		})
 
 		t.Run(tcase.name, func(t *testing.T) {
-			_, err := NewGRPCUpdateHandler(pu, getUserID, enc)(context.Background(), &req)
+			_, err := NewGRPCUpdateHandler(pu, getUserID, enc, pub, nil)(context.Background(), &req)
 			if tcase.wantStatus == 0 {
 				assert.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, broker.ActionUpdate, events[0].Action)
 			} else {
 				assert.Error(t, err)
+				assert.Empty(t, events)
 				status, ok := status.FromError(err)
 				require.Equal(t, true, ok)
 				assert.Equal(t, tcase.wantStatus, status.Code())
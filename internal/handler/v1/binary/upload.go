@@ -1,9 +1,20 @@
 package binary
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding"
+	"hash"
 	"io"
+	"net/http"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -12,50 +23,261 @@ import (
 )
 
 type BinaryUploader interface {
-	BinaryUpload(ctx context.Context, data storage.BinaryChunk) error
+	BinaryUpload(ctx context.Context, binID int64, fn func(w storage.BlobWriter) (storage.UploadProgress, error)) error
 }
 
-type BinaryUploadFunc func(ctx context.Context, data storage.BinaryChunk) error
+type BinaryUploadFunc func(ctx context.Context, binID int64, fn func(w storage.BlobWriter) (storage.UploadProgress, error)) error
 
-func (f BinaryUploadFunc) BinaryUpload(ctx context.Context, data storage.BinaryChunk) error {
-	return f(ctx, data)
+func (f BinaryUploadFunc) BinaryUpload(ctx context.Context, binID int64,
+	fn func(w storage.BlobWriter) (storage.UploadProgress, error)) error {
+	return f(ctx, binID, fn)
 }
 
 var _ BinaryUploader = BinaryUploadFunc(nil)
 
+type BinaryCompleter interface {
+	BinaryUploaded(ctx context.Context, binID int64, sha256 []byte) error
+}
+
+type BinaryCompleterFunc func(ctx context.Context, binID int64, sha256 []byte) error
+
+func (f BinaryCompleterFunc) BinaryUploaded(ctx context.Context, binID int64, sha256 []byte) error {
+	return f(ctx, binID, sha256)
+}
+
+var _ BinaryCompleter = BinaryCompleterFunc(nil)
+
 type GRPCUploadHandler func(us pb.GophKeeper_BinaryUploadServer) error
 
-func NewGRPCUploaderHandler(u BinaryUploader) GRPCUploadHandler {
+// NewGRPCUploaderHandler - функция-конструктор ручки загрузки бинарника.
+// Содержимое шифруется блоками ключом файла, при запросе клиента блоки
+// предварительно сжимаются (см. пакет stream). Поток с нулевого смещения
+// начинает новое содержимое с новым ключом, иначе
+// загрузка продолжается с уже записанного объёма после обрыва соединения.
+// Загрузка сверх заявленного размера или ограничения на файл прерывается.
+// Весь поток пишется одной транзакцией хранилища: записанные блоки
+// фиксируются вместе с состоянием SHA-256 открытых данных и при обрыве.
+// Дошедшая до заявленного размера загрузка отмечается вместе с дайджестом,
+// если он совпал с переданным клиентом.
+func NewGRPCUploaderHandler(u BinaryUploader, c BinaryCompleter, s BinaryStatusReader,
+	getUserID handler.GetUserIDFunc, keys crypt.EncryptDecryptor, quota *handler.Quota) GRPCUploadHandler {
+
 	return func(server pb.GophKeeper_BinaryUploadServer) error {
-		var (
-			chunk  storage.BinaryChunk
-			offset int64
-		)
+		ctx := server.Context()
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return err
+		}
 
 		var (
-			err    error
-			stream *pb.BinaryUplodStream
+			current storage.UploadStatus
+			limit   int64
+			digest  []byte
+			binID   int64
 		)
-		for err == nil {
-			stream, err = server.Recv()
+		up := upload{
+			hash: sha256.New(),
+			buf:  make([]byte, 0, stream.BlockSize),
+		}
+
+		in, err := server.Recv()
+		if err == io.EOF {
+			// пустой поток ничего не меняет
+			return server.SendAndClose(&emptypb.Empty{})
+		}
+		if err == nil {
+			binID = in.Id
+			up.compression = in.Compression
+			up.offset = in.Offset
+			digest = in.Sha256
+			current, err = uploadStatus(ctx, s, userID, in.Id)
+		}
+		if err == nil {
+			err = up.start(current, keys)
+		}
+		if err == nil {
+			limit, err = quota.UploadLimit(ctx, in.Id)
+		}
+
+		if err == nil {
+			// ошибка потока не отменяет записанного,
+			// откатывается только несостоявшаяся запись
+			var streamErr error
+			err = u.BinaryUpload(ctx, binID, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+				up.w = w
+				for streamErr == nil {
+					if limit > 0 && up.offset+int64(len(up.buf)+len(in.Chunk)) > limit {
+						streamErr = status.Errorf(codes.ResourceExhausted,
+							"upload exceeds file size limit %d", limit)
+						break
+					}
+					if err := up.write(in.Chunk); err != nil {
+						return up.progress, err
+					}
+					in, streamErr = server.Recv()
+				}
+
+				// при обрыве недописанный блок отбрасывается,
+				// продолжение начнётся с границы блока
+				if streamErr == io.EOF {
+					streamErr = nil
+					return up.progress, up.flush()
+				}
+				return up.progress, nil
+			})
 			if err == nil {
-				chunk.BinID = stream.Id
-				chunk.Chunk = stream.Chunk
-				chunk.Offset = offset
-				offset += int64(len(chunk.Chunk))
-				err = u.BinaryUpload(server.Context(), chunk)
-			} else {
-				logger.Errorf("error upload binary: %w", err,
-					"id", stream)
+				err = streamErr
 			}
 		}
-		if err == io.EOF {
-			err = nil
+
+		if err == nil && binID != 0 && up.offset >= current.Size {
+			sum := up.hash.Sum(nil)
+			if len(digest) > 0 && !bytes.Equal(digest, sum) {
+				err = status.Errorf(codes.DataLoss,
+					"sha256 mismatch: got %x, want %x", sum, digest)
+			} else {
+				err = c.BinaryUploaded(ctx, binID, sum)
+			}
 		}
 		if err != nil {
+			logger.Errorf("error upload binary: %w", err,
+				"id", binID, "offset", up.offset)
 			server.SendAndClose(&emptypb.Empty{})
 			return err
 		}
 		return server.SendAndClose(&emptypb.Empty{})
 	}
 }
+
+// upload состояние потока загрузки
+type upload struct {
+	w           storage.BlobWriter
+	progress    storage.UploadProgress
+	compression string
+	codec       *stream.Codec
+	hash        hash.Hash // nil - дайджест не считается
+	buf         []byte    // открытые данные незаполненного блока
+	offset      int64     // записано открытых данных
+	stored      int64     // занято записанными блоками
+	pos         int64     // позиция записи в большом объекте, -1 - неизвестна
+	base        int64     // место первого блока части, пишется с начала объекта
+	fresh       bool      // новое содержимое, прежнее отбрасывается перед первым блоком
+}
+
+// start подготовка кодека и хеша: для нового содержимого создаётся ключ,
+// продолжить можно только с записанного объёма, для которого сохранены
+// ключ и состояние хеша, сжатие при этом остаётся прежним
+func (up *upload) start(current storage.UploadStatus, keys crypt.EncryptDecryptor) error {
+	up.pos = -1
+	if up.offset == 0 {
+		key, err := stream.NewKey()
+		if err == nil {
+			up.progress.FileKey, err = keys.Encrypt(key)
+		}
+		if err == nil {
+			up.codec, err = stream.NewCodec(key, up.compression)
+		}
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		up.progress.Compression = up.compression
+		up.fresh = true
+		return nil
+	}
+
+	if up.offset != current.Committed {
+		return status.Errorf(codes.OutOfRange,
+			"offset %d, resume allowed from committed %d", up.offset, current.Committed)
+	}
+	if len(current.HashState) == 0 || len(current.FileKey) == 0 {
+		return status.Error(codes.FailedPrecondition,
+			"no state to resume, restart upload from zero")
+	}
+	if err := up.hash.(encoding.BinaryUnmarshaler).UnmarshalBinary(current.HashState); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	key, err := keys.Decrypt(current.FileKey)
+	if err == nil {
+		up.codec, err = stream.NewCodec(key, current.Compression)
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	up.stored = current.StoredSize
+	return nil
+}
+
+// write накопление открытых данных и запись заполненных блоков
+func (up *upload) write(data []byte) error {
+	if len(data) > 0 && up.offset%stream.BlockSize != 0 {
+		return status.Error(codes.FailedPrecondition,
+			"last block is already sealed, restart upload from zero")
+	}
+
+	for len(data) > 0 {
+		n := copy(up.buf[len(up.buf):cap(up.buf)], data)
+		up.buf = up.buf[:len(up.buf)+n]
+		data = data[n:]
+
+		if len(up.buf) == cap(up.buf) {
+			if err := up.flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// flush запись накопленного блока, состояние хеша и объёмы
+// запоминаются для фиксации вместе с ним. Тип нового содержимого,
+// если не задан, определяется по первому блоку.
+func (up *upload) flush() error {
+	if len(up.buf) == 0 {
+		return nil
+	}
+
+	index := up.offset / stream.BlockSize
+	if index == 0 && up.progress.ContentType == "" {
+		up.progress.ContentType = http.DetectContentType(up.buf)
+	}
+
+	if up.fresh {
+		if err := up.w.Truncate(0); err != nil {
+			return err
+		}
+		up.fresh = false
+	}
+
+	rec, err := up.codec.Encode(index, up.buf)
+	if err != nil {
+		return err
+	}
+
+	// блоки без сжатия идут подряд, место сжатых начинается с границы
+	if offset := up.codec.Offset(index) - up.base; offset != up.pos {
+		if _, err = up.w.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		up.pos = offset
+	}
+	if _, err = up.w.Write(rec); err != nil {
+		return err
+	}
+	up.pos += int64(len(rec))
+
+	if up.hash != nil {
+		up.hash.Write(up.buf)
+		up.progress.HashState, err = up.hash.(encoding.BinaryMarshaler).MarshalBinary()
+		if err != nil {
+			return err
+		}
+	}
+
+	up.offset += int64(len(up.buf))
+	up.stored += int64(len(rec))
+	up.progress.Committed = up.offset
+	up.progress.StoredSize = up.stored
+	up.buf = up.buf[:0]
+	return nil
+}
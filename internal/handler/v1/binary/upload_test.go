@@ -0,0 +1,421 @@
+package binary
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/crypto/stream"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// uploadStream входящий поток фрагментов
+type uploadStream struct {
+	grpc.ServerStream
+	chunks []*pb.BinaryUplodStream
+	err    error // ошибка после фрагментов, по умолчанию io.EOF
+	closed bool
+}
+
+func (s *uploadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *uploadStream) Recv() (*pb.BinaryUplodStream, error) {
+	if len(s.chunks) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func (s *uploadStream) SendAndClose(*emptypb.Empty) error {
+	s.closed = true
+	return nil
+}
+
+// digest SHA-256 строки
+func digest(s string) []byte {
+	sum := sha256.Sum256([]byte(s))
+	return sum[:]
+}
+
+// hashState сохранённое состояние хеша после строки
+func hashState(s string) []byte {
+	h := sha256.New()
+	h.Write([]byte(s))
+	state, _ := h.(encoding.BinaryMarshaler).MarshalBinary()
+	return state
+}
+
+// plainKeys ключи файлов без шифрования
+type plainKeys struct{}
+
+func (plainKeys) Encrypt(b []byte) ([]byte, error) { return b, nil }
+func (plainKeys) Decrypt(b []byte) ([]byte, error) { return b, nil }
+
+// memBlob большой объект в памяти
+type memBlob struct {
+	data      []byte
+	pos       int64
+	written   int64 // записано байт
+	truncated bool
+	writeErr  error
+}
+
+func (b *memBlob) Write(p []byte) (int, error) {
+	if b.writeErr != nil {
+		return 0, b.writeErr
+	}
+	if end := b.pos + int64(len(p)); end > int64(len(b.data)) {
+		b.data = append(b.data, make([]byte, end-int64(len(b.data)))...)
+	}
+	copy(b.data[b.pos:], p)
+	b.pos += int64(len(p))
+	b.written += int64(len(p))
+	return len(p), nil
+}
+
+func (b *memBlob) Seek(offset int64, whence int) (int64, error) {
+	if whence != io.SeekStart {
+		return 0, errors.New("unsupported whence")
+	}
+	b.pos = offset
+	return offset, nil
+}
+
+func (b *memBlob) Truncate(size int64) error {
+	b.data = b.data[:size]
+	b.truncated = true
+	return nil
+}
+
+// open чтение блоков, записанных с блока first
+func (b *memBlob) open(t *testing.T, key []byte, compression string, first int64) string {
+	c, err := stream.NewCodec(key, compression)
+	require.NoError(t, err)
+
+	var res []byte
+	for index := first; c.Offset(index) < int64(len(b.data)); index++ {
+		end := c.Offset(index) + int64(c.SlotSize())
+		if end > int64(len(b.data)) {
+			end = int64(len(b.data))
+		}
+		plain, err := c.Decode(index, b.data[c.Offset(index):end])
+		require.NoError(t, err)
+		res = append(res, plain...)
+	}
+	return string(res)
+}
+
+func TestGRPCUploadHandler(t *testing.T) {
+
+	fileKey := bytes.Repeat([]byte{1}, stream.KeySize)
+	block := strings.Repeat("x", stream.BlockSize)
+	large := block + "0123456789"
+
+	tests := []struct {
+		name         string
+		wantStatus   codes.Code
+		wantStored   string
+		wantUploaded bool
+		wantSum      []byte
+		uploadErr    error
+		userErr      error
+		quota        *handler.Quota
+		size         int64
+		committed    int64
+		hashState    []byte
+		resumeKey    []byte
+		compression  string
+		chunks       []*pb.BinaryUplodStream
+		streamErr    error
+		writeErr     error
+		wantSaved    bool
+	}{
+		{
+			name:         "ok",
+			wantStored:   "abcdef",
+			wantUploaded: true,
+		},
+		{
+			name:         "within declared size",
+			wantStored:   "abcdef",
+			wantUploaded: true,
+			quota:        handler.NewQuota(handler.Limits{}, usage{size: 6}),
+		},
+		{
+			name:       "exceeds declared size",
+			wantStatus: codes.ResourceExhausted,
+			quota:      handler.NewQuota(handler.Limits{}, usage{size: 5}),
+		},
+		{
+			name:       "exceeds file size limit",
+			wantStatus: codes.ResourceExhausted,
+			quota:      handler.NewQuota(handler.Limits{FileSize: 4}, usage{size: 6}),
+		},
+		{
+			name:      "upload error",
+			uploadErr: errors.New("upload error"),
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "incomplete",
+			wantStored: "abc",
+			chunks:     []*pb.BinaryUplodStream{{Id: 7, Chunk: []byte("abc")}},
+		},
+		{
+			name:         "several blocks",
+			wantStored:   large,
+			wantUploaded: true,
+			wantSum:      digest(large),
+			size:         int64(len(large)),
+			chunks: []*pb.BinaryUplodStream{
+				{Id: 7, Chunk: []byte(large[:4096])},
+				{Id: 7, Chunk: []byte(large[4096:])},
+			},
+		},
+		{
+			name:         "compressed",
+			wantStored:   large,
+			wantUploaded: true,
+			wantSum:      digest(large),
+			size:         int64(len(large)),
+			compression:  stream.CompressionZstd,
+			chunks: []*pb.BinaryUplodStream{
+				{Id: 7, Chunk: []byte(large[:4096]), Compression: stream.CompressionZstd},
+				{Id: 7, Chunk: []byte(large[4096:])},
+			},
+		},
+		{
+			name:         "resume compressed",
+			wantStored:   "def",
+			wantUploaded: true,
+			wantSum:      digest(block + "def"),
+			size:         stream.BlockSize + 3,
+			committed:    stream.BlockSize,
+			hashState:    hashState(block),
+			resumeKey:    fileKey,
+			compression:  stream.CompressionZstd,
+			chunks:       []*pb.BinaryUplodStream{{Id: 7, Chunk: []byte("def"), Offset: stream.BlockSize}},
+		},
+		{
+			name:       "dropped keeps sealed blocks",
+			wantStatus: codes.Unavailable,
+			wantStored: block,
+			wantSaved:  true,
+			size:       int64(len(large)),
+			chunks:     []*pb.BinaryUplodStream{{Id: 7, Chunk: []byte(large[:stream.BlockSize+5])}},
+			streamErr:  status.Error(codes.Unavailable, "connection lost"),
+		},
+		{
+			name:     "write error",
+			writeErr: errors.New("write error"),
+		},
+		{
+			name:         "digest match",
+			wantStored:   "abcdef",
+			wantUploaded: true,
+			chunks: []*pb.BinaryUplodStream{
+				{Id: 7, Chunk: []byte("abc"), Sha256: digest("abcdef")},
+				{Id: 7, Chunk: []byte("def")},
+			},
+		},
+		{
+			name:       "digest mismatch",
+			wantStatus: codes.DataLoss,
+			chunks: []*pb.BinaryUplodStream{
+				{Id: 7, Chunk: []byte("abc"), Sha256: digest("abcdeX")},
+				{Id: 7, Chunk: []byte("def")},
+			},
+		},
+		{
+			name:         "resume",
+			wantStored:   "def",
+			wantUploaded: true,
+			wantSum:      digest(block + "def"),
+			size:         stream.BlockSize + 3,
+			committed:    stream.BlockSize,
+			hashState:    hashState(block),
+			resumeKey:    fileKey,
+			chunks:       []*pb.BinaryUplodStream{{Id: 7, Chunk: []byte("def"), Offset: stream.BlockSize}},
+		},
+		{
+			name:         "complete uploaded",
+			wantUploaded: true,
+			committed:    6,
+			hashState:    hashState("abcdef"),
+			resumeKey:    fileKey,
+			chunks:       []*pb.BinaryUplodStream{{Id: 7, Offset: 6, Sha256: digest("abcdef")}},
+		},
+		{
+			name:       "resume into sealed block",
+			wantStatus: codes.FailedPrecondition,
+			committed:  3,
+			hashState:  hashState("abc"),
+			resumeKey:  fileKey,
+			chunks:     []*pb.BinaryUplodStream{{Id: 7, Chunk: []byte("def"), Offset: 3}},
+		},
+		{
+			name:       "resume without state",
+			wantStatus: codes.FailedPrecondition,
+			committed:  3,
+			chunks:     []*pb.BinaryUplodStream{{Id: 7, Chunk: []byte("def"), Offset: 3}},
+		},
+		{
+			name:       "offset beyond committed",
+			wantStatus: codes.OutOfRange,
+			committed:  2,
+			hashState:  hashState("ab"),
+			resumeKey:  fileKey,
+			chunks:     []*pb.BinaryUplodStream{{Id: 7, Chunk: []byte("def"), Offset: 3}},
+		},
+		{
+			name:       "offset before committed",
+			wantStatus: codes.OutOfRange,
+			committed:  4,
+			hashState:  hashState("abcd"),
+			resumeKey:  fileKey,
+			chunks:     []*pb.BinaryUplodStream{{Id: 7, Chunk: []byte("def"), Offset: 3}},
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var (
+			blob     = &memBlob{writeErr: tcase.writeErr}
+			saved    *storage.UploadProgress
+			uploaded bool
+		)
+
+		u := BinaryUploadFunc(func(_ context.Context, binID int64,
+			fn func(storage.BlobWriter) (storage.UploadProgress, error)) error {
+
+			assert.Equal(t, int64(7), binID)
+			if tcase.uploadErr != nil {
+				return tcase.uploadErr
+			}
+			progress, err := fn(blob)
+			if err == nil {
+				saved = &progress
+			}
+			return err
+		})
+
+		c := BinaryCompleterFunc(func(_ context.Context, binID int64, sum []byte) error {
+			assert.Equal(t, int64(7), binID)
+			if tcase.wantSum != nil {
+				assert.Equal(t, tcase.wantSum, sum)
+			} else {
+				assert.Equal(t, digest("abcdef"), sum)
+			}
+			uploaded = true
+			return nil
+		})
+
+		size := tcase.size
+		if size == 0 {
+			size = 6
+		}
+		s := BinaryStatusFunc(func(_ context.Context, userID string, binID int64) (storage.UploadStatus, error) {
+			assert.Equal(t, "user", userID)
+			return storage.UploadStatus{
+				Committed:   tcase.committed,
+				StoredSize:  tcase.committed,
+				Size:        size,
+				HashState:   tcase.hashState,
+				FileKey:     tcase.resumeKey,
+				Compression: tcase.compression,
+			}, nil
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		chunks := tcase.chunks
+		if chunks == nil {
+			chunks = []*pb.BinaryUplodStream{
+				{Id: 7, Chunk: []byte("abc")},
+				{Id: 7, Chunk: []byte("def")},
+			}
+		}
+		in := &uploadStream{chunks: chunks, err: tcase.streamErr}
+
+		t.Run(tcase.name, func(t *testing.T) {
+			err := NewGRPCUploaderHandler(u, c, s, getUserID, plainKeys{}, tcase.quota)(in)
+			assert.Equal(t, tcase.wantUploaded, uploaded)
+
+			switch {
+			case tcase.uploadErr != nil:
+				assert.True(t, in.closed)
+				assert.ErrorIs(t, err, tcase.uploadErr)
+				return
+			case tcase.writeErr != nil:
+				// несостоявшаяся запись не фиксируется
+				assert.ErrorIs(t, err, tcase.writeErr)
+				assert.Nil(t, saved)
+				return
+			case tcase.wantStatus != 0:
+				require.Error(t, err)
+				assert.Equal(t, tcase.wantStatus, status.Code(err))
+				if !tcase.wantSaved {
+					return
+				}
+			default:
+				require.NoError(t, err)
+				assert.True(t, in.closed)
+			}
+
+			if tcase.wantStored == "" {
+				assert.Empty(t, blob.data)
+				return
+			}
+			require.NotNil(t, saved)
+
+			key := tcase.resumeKey
+			if tcase.committed == 0 {
+				require.Len(t, saved.FileKey, stream.KeySize)
+				assert.Equal(t, tcase.compression, saved.Compression)
+				assert.True(t, blob.truncated)
+				assert.Equal(t, http.DetectContentType([]byte(tcase.wantStored)), saved.ContentType)
+				key = saved.FileKey
+			} else {
+				assert.Nil(t, saved.FileKey)
+				assert.Empty(t, saved.ContentType)
+			}
+
+			first := tcase.committed / stream.BlockSize
+			assert.Equal(t, tcase.wantStored, blob.open(t, key, tcase.compression, first))
+			assert.Equal(t, first*stream.BlockSize+int64(len(tcase.wantStored)), saved.Committed)
+			assert.Equal(t, tcase.committed+blob.written, saved.StoredSize)
+			assert.NotEmpty(t, saved.HashState)
+		})
+	}
+}
@@ -6,8 +6,10 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
@@ -30,20 +32,30 @@ var _ BinaryWritter = BinaryWritterFunc(nil)
 type GRPCWriteHandler func(ctx context.Context, in *pb.BinaryWriteRequest) (*pb.BinaryWriteResponse, error)
 
 // NewGRPCWriteHandler - функция-конструктор ручки записи бинарника
-func NewGRPCWriteHandler(w BinaryWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor) GRPCWriteHandler {
+func NewGRPCWriteHandler(w BinaryWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher, quota *handler.Quota) GRPCWriteHandler {
 	return func(ctx context.Context, in *pb.BinaryWriteRequest) (*pb.BinaryWriteResponse, error) {
 		var err error
 
 		write := storage.BinaryData{
-			Name: in.Name,
-			Size: in.Size,
+			Name:      in.Name,
+			Size:      in.Size,
+			ExpiresAt: handler.ExpiresAt(in.ExpiresAt),
 		}
+		setFileMeta(&write, in.Meta)
 
 		write.UserID, err = getUserID(ctx)
 		if err != nil {
 			return nil, err
 		}
 
+		if err = quota.CheckItems(ctx, write.UserID, storage.KindBinary, 1); err != nil {
+			return nil, err
+		}
+
+		if err = quota.CheckFile(ctx, write.UserID, write.Size, 0); err != nil {
+			return nil, err
+		}
+
 		write.Notes, err = enc.Encrypt([]byte(in.Notes))
 		if err != nil {
 			logger.Errorf("encrypt notes error: %w", err)
@@ -59,6 +71,32 @@ func NewGRPCWriteHandler(w BinaryWritter, getUserID handler.GetUserIDFunc, enc c
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		handler.Notify(ctx, pub, write.UserID, storage.KindBinary, write.Name, broker.ActionCreate)
 		return &pb.BinaryWriteResponse{Id: id}, nil
 	}
 }
+
+// setFileMeta перенос исходных атрибутов файла, переданных клиентом
+func setFileMeta(data *storage.BinaryData, meta *pb.FileMeta) {
+	if meta == nil {
+		return
+	}
+	data.Filename = meta.Filename
+	data.Mode = meta.Mode
+	if meta.Mtime != nil {
+		mtime := meta.Mtime.AsTime()
+		data.MTime = &mtime
+	}
+}
+
+// fileMeta исходные атрибуты файла для ответа клиенту
+func fileMeta(data storage.BinaryData) *pb.FileMeta {
+	meta := pb.FileMeta{
+		Filename: data.Filename,
+		Mode:     data.Mode,
+	}
+	if data.MTime != nil {
+		meta.Mtime = timestamppb.New(*data.MTime)
+	}
+	return &meta
+}
@@ -11,11 +11,26 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
 )
 
+// usage занятое пользователем место
+type usage struct {
+	data storage.ListData
+	size int64
+}
+
+func (u usage) ReadList(context.Context, string) (storage.ListData, error) {
+	return u.data, nil
+}
+
+func (u usage) BinarySize(context.Context, int64) (int64, error) {
+	return u.size, nil
+}
+
 func TestGRPCWriteHandler(t *testing.T) {
 
 	notesEncErr := errors.New("notes encrypt error")
@@ -26,6 +41,7 @@ func TestGRPCWriteHandler(t *testing.T) {
 		userErr    error
 		ecnErr     error
 		writeErr   error
+		quota      *handler.Quota
 	}{
 		{
 			name: "ok",
@@ -50,10 +66,35 @@ func TestGRPCWriteHandler(t *testing.T) {
 			wantStatus: codes.Internal,
 			ecnErr:     notesEncErr,
 		},
+		{
+			name:  "within quota",
+			quota: handler.NewQuota(handler.Limits{Bytes: 128, Items: 2, FileSize: 64}, usage{data: storage.ListData{BinariesCount: 1, BinariesSize: 64}}),
+		},
+		{
+			name:       "file size limit",
+			wantStatus: codes.ResourceExhausted,
+			quota:      handler.NewQuota(handler.Limits{FileSize: 32}, usage{}),
+		},
+		{
+			name:       "bytes quota",
+			wantStatus: codes.ResourceExhausted,
+			quota:      handler.NewQuota(handler.Limits{Bytes: 100}, usage{data: storage.ListData{BinariesSize: 64}}),
+		},
+		{
+			name:       "items quota",
+			wantStatus: codes.ResourceExhausted,
+			quota:      handler.NewQuota(handler.Limits{Items: 1}, usage{data: storage.ListData{BinariesCount: 1}}),
+		},
 	}
 
 	for _, tcase := range tests {
 
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
 		req := pb.BinaryWriteRequest{
 			Name:  "name",
 			Size:  64,
@@ -79,11 +120,14 @@ func TestGRPCWriteHandler(t *testing.T) {
 		})
 
 		t.Run(tcase.name, func(t *testing.T) {
-			_, err := NewGRPCWriteHandler(pw, getUserID, enc)(context.Background(), &req)
+			_, err := NewGRPCWriteHandler(pw, getUserID, enc, pub, tcase.quota)(context.Background(), &req)
 			if tcase.wantStatus == 0 {
 				assert.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, broker.ActionCreate, events[0].Action)
 			} else {
 				assert.Error(t, err)
+				assert.Empty(t, events)
 				status, ok := status.FromError(err)
 				require.Equal(t, true, ok)
 				assert.Equal(t, tcase.wantStatus, status.Code())
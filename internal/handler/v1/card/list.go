@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -15,30 +14,34 @@ import (
 )
 
 type CardListGetter interface {
-	CardList(ctx context.Context, userID string) ([]string, error)
+	CardList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
 }
 
-type CardListGetterFunc func(ctx context.Context, userID string) ([]string, error)
+type CardListGetterFunc func(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
 
-func (f CardListGetterFunc) CardList(ctx context.Context, userID string) ([]string, error) {
-	return f(ctx, userID)
+func (f CardListGetterFunc) CardList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return f(ctx, q)
 }
 
 var _ CardListGetter = CardListGetterFunc(nil)
 
-type GRPCListHandler func(ctx context.Context, in *empty.Empty) (*pb.CardListResponse, error)
+type GRPCListHandler func(ctx context.Context, in *pb.ListRequest) (*pb.CardListResponse, error)
 
-// NewGRPCListHandler - функция-конструктор ручки получения списка наименований карт
+// NewGRPCListHandler - функция-конструктор ручки получения страницы списка карт
 func NewGRPCListHandler(g CardListGetter, getUserID handler.GetUserIDFunc) GRPCListHandler {
-	return func(ctx context.Context, in *empty.Empty) (*pb.CardListResponse, error) {
+	return func(ctx context.Context, in *pb.ListRequest) (*pb.CardListResponse, error) {
 
 		userID, err := getUserID(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		var resp pb.CardListResponse
-		resp.Names, err = g.CardList(ctx, userID)
+		q, err := handler.NewListQuery(userID, in)
+		if err != nil {
+			return nil, err
+		}
+
+		entries, err := g.CardList(ctx, q)
 		if err != nil {
 			if errors.Is(err, storage.ErrNoContent) {
 				return nil, status.Error(codes.NotFound, err.Error())
@@ -47,6 +50,9 @@ func NewGRPCListHandler(g CardListGetter, getUserID handler.GetUserIDFunc) GRPCL
 			logger.Errorf("read card list error: %w", err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		var resp pb.CardListResponse
+		resp.Entries, resp.NextCursor = handler.ListPage(q, entries)
 		return &resp, nil
 	}
 }
@@ -65,11 +65,12 @@ func NewGRPCReadHandler(r CardReader, getUserID handler.GetUserIDFunc, dec crypt
 		}
 
 		resp := pb.CardReadResponse{
-			Id:     data.ID,
-			Name:   data.Name,
-			Number: string(number),
-			Pin:    string(pin),
-			Notes:  string(notes),
+			Id:        data.ID,
+			Name:      data.Name,
+			Number:    string(number),
+			Pin:       string(pin),
+			Notes:     string(notes),
+			ExpiresAt: handler.ExpiresAtPB(data.ExpiresAt),
 		}
 
 		return &resp, nil
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
@@ -32,12 +33,13 @@ var _ CardWritter = CardWritterFunc(nil)
 type GRPCWriteHandler func(ctx context.Context, in *pb.CardWriteRequest) (*empty.Empty, error)
 
 // NewGRPCWriteHandler - функция-конструктор ручки записи карты
-func NewGRPCWriteHandler(w CardWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor) GRPCWriteHandler {
+func NewGRPCWriteHandler(w CardWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher, quota *handler.Quota) GRPCWriteHandler {
 	return func(ctx context.Context, in *pb.CardWriteRequest) (*empty.Empty, error) {
 		var err error
 
 		write := storage.CardData{
-			Name: in.Name,
+			Name:      in.Name,
+			ExpiresAt: handler.ExpiresAt(in.ExpiresAt),
 		}
 
 		write.UserID, err = getUserID(ctx)
@@ -45,6 +47,10 @@ func NewGRPCWriteHandler(w CardWritter, getUserID handler.GetUserIDFunc, enc cry
 			return nil, err
 		}
 
+		if err = quota.CheckItems(ctx, write.UserID, storage.KindCard, 1); err != nil {
+			return nil, err
+		}
+
 		write.Number, err = enc.Encrypt([]byte(in.Number))
 		if err != nil {
 			logger.Errorf("encrypt number error: %w", err)
@@ -72,6 +78,7 @@ func NewGRPCWriteHandler(w CardWritter, getUserID handler.GetUserIDFunc, enc cry
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		handler.Notify(ctx, pub, write.UserID, storage.KindCard, write.Name, broker.ActionCreate)
 		return &empty.Empty{}, nil
 	}
 }
@@ -0,0 +1,54 @@
+package folder
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type FolderDeleter interface {
+	FolderDelete(ctx context.Context, userID, folder string) error
+}
+
+type FolderDeleteFunc func(ctx context.Context, userID, folder string) error
+
+func (f FolderDeleteFunc) FolderDelete(ctx context.Context, userID, folder string) error {
+	return f(ctx, userID, folder)
+}
+
+var _ FolderDeleter = FolderDeleteFunc(nil)
+
+type GRPCDeleteHandler func(ctx context.Context, in *pb.FolderDelRequest) (*empty.Empty, error)
+
+// NewGRPCDeleteHandler - функция-конструктор ручки удаления папки
+func NewGRPCDeleteHandler(d FolderDeleter, getUserID handler.GetUserIDFunc) GRPCDeleteHandler {
+	return func(ctx context.Context, in *pb.FolderDelRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		folder := handler.CleanFolder(in.Folder)
+		if folder == "" {
+			return nil, status.Error(codes.InvalidArgument, "root folder cannot be deleted")
+		}
+
+		err = d.FolderDelete(ctx, userID, folder)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("folder delete error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &empty.Empty{}, nil
+	}
+}
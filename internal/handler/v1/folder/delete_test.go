@@ -0,0 +1,85 @@
+package folder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCDeleteHandler(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		folder     string
+		wantStatus codes.Code
+		userErr    error
+		delErr     error
+	}{
+		{
+			name:   "ok",
+			folder: "work",
+		},
+		{
+			name:       "root",
+			folder:     "/",
+			wantStatus: codes.InvalidArgument,
+		},
+		{
+			name:       "unauthenticated",
+			folder:     "work",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "not found",
+			folder:     "work",
+			wantStatus: codes.NotFound,
+			delErr:     storage.ErrNoContent,
+		},
+		{
+			name:       "delete error",
+			folder:     "work",
+			wantStatus: codes.Internal,
+			delErr:     errors.New("delete error"),
+		},
+	}
+
+	for _, tcase := range tests {
+
+		d := FolderDeleteFunc(func(_ context.Context, userID, folder string) error {
+			return tcase.delErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		req := pb.FolderDelRequest{
+			Folder: tcase.folder,
+		}
+
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := NewGRPCDeleteHandler(d, getUserID)(context.Background(), &req)
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
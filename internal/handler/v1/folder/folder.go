@@ -0,0 +1,2 @@
+// Package folder ручки работы с папками
+package folder
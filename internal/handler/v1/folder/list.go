@@ -0,0 +1,45 @@
+package folder
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+)
+
+type FolderListGetter interface {
+	FolderList(ctx context.Context, userID string) ([]string, error)
+}
+
+type FolderListGetterFunc func(ctx context.Context, userID string) ([]string, error)
+
+func (f FolderListGetterFunc) FolderList(ctx context.Context, userID string) ([]string, error) {
+	return f(ctx, userID)
+}
+
+var _ FolderListGetter = FolderListGetterFunc(nil)
+
+type GRPCListHandler func(ctx context.Context, in *empty.Empty) (*pb.FolderListResponse, error)
+
+// NewGRPCListHandler - функция-конструктор ручки получения списка папок
+func NewGRPCListHandler(g FolderListGetter, getUserID handler.GetUserIDFunc) GRPCListHandler {
+	return func(ctx context.Context, in *empty.Empty) (*pb.FolderListResponse, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp pb.FolderListResponse
+		resp.Folders, err = g.FolderList(ctx, userID)
+		if err != nil {
+			logger.Errorf("folder list error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &resp, nil
+	}
+}
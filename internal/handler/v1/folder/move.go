@@ -0,0 +1,52 @@
+package folder
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type FolderMover interface {
+	FolderMove(ctx context.Context, kind, userID, name, folder string) error
+}
+
+type FolderMoverFunc func(ctx context.Context, kind, userID, name, folder string) error
+
+func (f FolderMoverFunc) FolderMove(ctx context.Context, kind, userID, name, folder string) error {
+	return f(ctx, kind, userID, name, folder)
+}
+
+var _ FolderMover = FolderMoverFunc(nil)
+
+type GRPCMoveHandler func(ctx context.Context, in *pb.FolderMoveRequest) (*empty.Empty, error)
+
+// NewGRPCMoveHandler - функция-конструктор ручки перемещения элемента в папку
+func NewGRPCMoveHandler(m FolderMover, getUserID handler.GetUserIDFunc, pub broker.Publisher) GRPCMoveHandler {
+	return func(ctx context.Context, in *pb.FolderMoveRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.FolderMove(ctx, in.Kind, userID, in.Name, handler.CleanFolder(in.Folder))
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("folder move error: %w", err, "kind", in.Kind)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, userID, in.Kind, in.Name, broker.ActionUpdate)
+		return &empty.Empty{}, nil
+	}
+}
@@ -0,0 +1,98 @@
+package folder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCMoveHandler(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		folder     string
+		wantFolder string
+		wantStatus codes.Code
+		userErr    error
+		moveErr    error
+	}{
+		{
+			name:       "ok",
+			folder:     "/work/servers/",
+			wantFolder: "work/servers",
+		},
+		{
+			name:       "root",
+			folder:     "/",
+			wantFolder: "",
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			moveErr:    storage.ErrNoContent,
+		},
+		{
+			name:       "move error",
+			wantStatus: codes.Internal,
+			moveErr:    errors.New("move error"),
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		var gotFolder string
+		m := FolderMoverFunc(func(_ context.Context, kind, userID, name, folder string) error {
+			gotFolder = folder
+			return tcase.moveErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		req := pb.FolderMoveRequest{
+			Kind:   storage.KindPassword,
+			Name:   "name",
+			Folder: tcase.folder,
+		}
+
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := NewGRPCMoveHandler(m, getUserID, pub)(context.Background(), &req)
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, tcase.wantFolder, gotFolder)
+				require.Len(t, events, 1)
+			} else {
+				assert.Error(t, err)
+				assert.Empty(t, events)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
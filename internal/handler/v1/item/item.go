@@ -0,0 +1,3 @@
+// Package item ручка работы с произвольными элементами: тип задаёт
+// пользователь, значения полей шифруются по отдельности
+package item
@@ -0,0 +1,58 @@
+package item
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type ItemListGetter interface {
+	ItemList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
+}
+
+type ItemListGetterFunc func(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
+
+func (f ItemListGetterFunc) ItemList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return f(ctx, q)
+}
+
+var _ ItemListGetter = ItemListGetterFunc(nil)
+
+type GRPCListHandler func(ctx context.Context, in *pb.ListRequest) (*pb.ItemListResponse, error)
+
+// NewGRPCListHandler - функция-конструктор ручки получения страницы списка произвольных элементов
+func NewGRPCListHandler(g ItemListGetter, getUserID handler.GetUserIDFunc) GRPCListHandler {
+	return func(ctx context.Context, in *pb.ListRequest) (*pb.ItemListResponse, error) {
+
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		q, err := handler.NewListQuery(userID, in)
+		if err != nil {
+			return nil, err
+		}
+
+		entries, err := g.ItemList(ctx, q)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+
+			logger.Errorf("read items list error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		var resp pb.ItemListResponse
+		resp.Entries, resp.NextCursor = handler.ListPage(q, entries)
+		return &resp, nil
+	}
+}
@@ -0,0 +1,108 @@
+package item
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCListHandler(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		req        *pb.ListRequest
+		wantStatus codes.Code
+		wantNames  []string
+		wantNext   bool
+		userErr    error
+		listErr    error
+	}{
+		{
+			name:      "ok",
+			wantNames: []string{"p1", "p2", "p3"},
+		},
+		{
+			name:      "first page",
+			req:       &pb.ListRequest{Limit: 2},
+			wantNames: []string{"p1", "p2"},
+			wantNext:  true,
+		},
+		{
+			name:       "invalid cursor",
+			req:        &pb.ListRequest{Cursor: "!"},
+			wantStatus: codes.InvalidArgument,
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "list error",
+			wantStatus: codes.Internal,
+			listErr:    errors.New("list error"),
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			listErr:    storage.ErrNoContent,
+		},
+	}
+
+	for _, tcase := range tests {
+
+		now := time.Now()
+		list := []storage.ListEntry{
+			{ID: 1, Name: "p1", CtreatAt: now, UpdateAt: now},
+			{ID: 2, Name: "p2", CtreatAt: now, UpdateAt: now},
+			{ID: 3, Name: "p3", CtreatAt: now, UpdateAt: now},
+		}
+
+		getList := ItemListGetterFunc(func(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+			if tcase.listErr != nil {
+				return nil, tcase.listErr
+			}
+			if len(list) > q.Limit {
+				return list[:q.Limit], nil
+			}
+			return list, nil
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		resp, err := NewGRPCListHandler(getList, getUserID)(context.Background(), tcase.req)
+
+		t.Run(tcase.name, func(t *testing.T) {
+			if tcase.wantStatus == 0 {
+				require.NoError(t, err)
+				var names []string
+				for _, e := range resp.Entries {
+					names = append(names, e.Name)
+				}
+				assert.Equal(t, tcase.wantNames, names)
+				assert.Equal(t, tcase.wantNext, resp.NextCursor != "")
+
+			} else {
+				assert.Error(t, err)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package item
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+type ItemReader interface {
+	ItemRead(ctx context.Context, userID, name string) (storage.ItemData, error)
+}
+
+type ItemReaderFunc func(ctx context.Context, userID, name string) (storage.ItemData, error)
+
+func (f ItemReaderFunc) ItemRead(ctx context.Context, userID, name string) (storage.ItemData, error) {
+	return f(ctx, userID, name)
+}
+
+var _ ItemReader = ItemReaderFunc(nil)
+
+type GRPCReadHandler func(context.Context, *pb.ItemReadRequest) (*pb.ItemReadResponse, error)
+
+// NewGRPCReadHandler - функця-конструктор ручки чтения произвольного элемента
+func NewGRPCReadHandler(r ItemReader, getUserID handler.GetUserIDFunc, dec crypt.Decryptor) GRPCReadHandler {
+	return func(ctx context.Context, in *pb.ItemReadRequest) (*pb.ItemReadResponse, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := r.ItemRead(ctx, userID, in.Name)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("read item error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		fields, err := handler.ItemFieldsPB(data.Fields, dec)
+		if err != nil {
+			logger.Errorf("decrypt item fields error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp := pb.ItemReadResponse{
+			Id:        data.ID,
+			Name:      data.Name,
+			Type:      data.Type,
+			Fields:    fields,
+			ExpiresAt: handler.ExpiresAtPB(data.ExpiresAt),
+		}
+
+		return &resp, nil
+	}
+}
@@ -0,0 +1,119 @@
+package item
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCreadHandler(t *testing.T) {
+
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	fieldDecErr := errors.New("field decrypt error")
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		userErr    error
+		decErr     error
+		readErr    error
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "read error",
+			wantStatus: codes.Internal,
+			readErr:    errors.New("read error"),
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			readErr:    storage.ErrNoContent,
+		},
+		{
+			name:       fieldDecErr.Error(),
+			wantStatus: codes.Internal,
+			decErr:     fieldDecErr,
+		},
+	}
+
+	for _, tcase := range tests {
+
+		req := pb.ItemReadRequest{
+			Name: "name",
+		}
+
+		pr := ItemReaderFunc(func(context.Context, string, string) (res storage.ItemData, err error) {
+			err = tcase.readErr
+			if err == nil {
+				res.ID = 1
+				res.UserID = "user_id"
+				res.Name = "name"
+				res.Type = "database"
+				res.Fields = []storage.ItemField{
+					{Label: "host", Type: storage.FieldURL, Value: []byte("https://db.local")},
+					{Label: "password", Type: storage.FieldSecret, Value: []byte("secret")},
+				}
+				res.ExpiresAt = &expiresAt
+			}
+			return
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		dec := crypt.DecryptFunc(func(text []byte) ([]byte, error) {
+			if tcase.decErr == fieldDecErr && string(text) == "secret" {
+				return nil, tcase.decErr
+			}
+			return text, nil
+		})
+
+		resp, err := NewGRPCReadHandler(pr, getUserID, dec)(context.Background(), &req)
+
+		t.Run(tcase.name, func(t *testing.T) {
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+
+				assert.Equal(t, int64(1), resp.Id)
+				assert.Equal(t, "name", resp.Name)
+				assert.Equal(t, "database", resp.Type)
+				require.Len(t, resp.Fields, 2)
+				assert.Equal(t, pb.FieldType_FIELD_TYPE_URL, resp.Fields[0].Type)
+				assert.Equal(t, "password", resp.Fields[1].Label)
+				assert.Equal(t, pb.FieldType_FIELD_TYPE_SECRET, resp.Fields[1].Type)
+				assert.Equal(t, "secret", resp.Fields[1].Value)
+				assert.True(t, expiresAt.Equal(resp.ExpiresAt.AsTime()))
+
+			} else {
+				assert.Error(t, err)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+
+	}
+
+}
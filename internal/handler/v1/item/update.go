@@ -0,0 +1,75 @@
+package item
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+type ItemUpdater interface {
+	ItemUpdate(ctx context.Context, data storage.ItemData) error
+}
+
+type ItemUpdaterFunc func(ctx context.Context, data storage.ItemData) error
+
+func (f ItemUpdaterFunc) ItemUpdate(ctx context.Context, data storage.ItemData) error {
+	return f(ctx, data)
+}
+
+var _ ItemUpdater = ItemUpdaterFunc(nil)
+
+type GRPCUpdateHandler func(context.Context, *pb.ItemUpdateRequest) (*empty.Empty, error)
+
+// NewGRPCUpdateHandler - функция конструктор ручки для обновления произвольного элемента
+func NewGRPCUpdateHandler(u ItemUpdater, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher) GRPCUpdateHandler {
+	return func(ctx context.Context, in *pb.ItemUpdateRequest) (*empty.Empty, error) {
+		var err error
+
+		upd := storage.ItemData{
+			ID:        in.Id,
+			Name:      in.Write.Name,
+			Type:      in.Write.Type,
+			ExpiresAt: handler.ExpiresAt(in.Write.ExpiresAt),
+		}
+
+		upd.UserID, err = getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		upd.Fields, err = handler.ItemFields(in.Write.Fields, enc)
+		if errors.Is(err, handler.ErrFieldValue) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err != nil {
+			logger.Errorf("encrypt item fields error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = u.ItemUpdate(ctx, upd)
+		if err != nil {
+			if errors.Is(err, storage.ErrWriteConflict) {
+				return nil, status.Error(codes.AlreadyExists, err.Error())
+			}
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("item update error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, upd.UserID, storage.KindItem, upd.Name, broker.ActionUpdate)
+		return &empty.Empty{}, nil
+	}
+}
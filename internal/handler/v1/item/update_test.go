@@ -0,0 +1,117 @@
+package item
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCUpdateHandler(t *testing.T) {
+
+	fieldEncErr := errors.New("field encrypt error")
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		userErr    error
+		ecnErr     error
+		updErr     error
+		fieldType  pb.FieldType
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "update error",
+			wantStatus: codes.Internal,
+			updErr:     errors.New("update error"),
+		},
+		{
+			name:       "already exists",
+			wantStatus: codes.AlreadyExists,
+			updErr:     storage.ErrWriteConflict,
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			updErr:     storage.ErrNoContent,
+		},
+		{
+			name:       fieldEncErr.Error(),
+			wantStatus: codes.Internal,
+			ecnErr:     fieldEncErr,
+		},
+		{
+			name:       "invalid field",
+			wantStatus: codes.InvalidArgument,
+			fieldType:  pb.FieldType_FIELD_TYPE_EMAIL,
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		req := pb.ItemUpdateRequest{
+			Id: 1,
+			Write: &pb.ItemWriteRequest{
+				Name:   "name",
+				Type:   "api key",
+				Fields: []*pb.ItemField{{Label: "key", Type: tcase.fieldType, Value: "secret"}},
+			},
+		}
+
+		pu := ItemUpdaterFunc(func(context.Context, storage.ItemData) error {
+			return tcase.updErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		enc := crypt.EncryptFunc(func(text []byte) ([]byte, error) {
+			if tcase.ecnErr == fieldEncErr && string(text) == "secret" {
+				return nil, tcase.ecnErr
+			}
+			return nil, nil
+		})
+
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := NewGRPCUpdateHandler(pu, getUserID, enc, pub)(context.Background(), &req)
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, broker.ActionUpdate, events[0].Action)
+			} else {
+				assert.Error(t, err)
+				assert.Empty(t, events)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
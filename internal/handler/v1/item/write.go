@@ -0,0 +1,75 @@
+package item
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+type ItemWritter interface {
+	ItemWrite(ctx context.Context, data storage.ItemData) error
+}
+
+type ItemWritterFunc func(ctx context.Context, data storage.ItemData) error
+
+func (f ItemWritterFunc) ItemWrite(ctx context.Context, data storage.ItemData) error {
+	return f(ctx, data)
+}
+
+var _ ItemWritter = ItemWritterFunc(nil)
+
+type GRPCWriteHandler func(ctx context.Context, in *pb.ItemWriteRequest) (*empty.Empty, error)
+
+// NewGRPCWriteHandler - функция-конструктор ручки записи произвольного элемента
+func NewGRPCWriteHandler(w ItemWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher, quota *handler.Quota) GRPCWriteHandler {
+	return func(ctx context.Context, in *pb.ItemWriteRequest) (*empty.Empty, error) {
+		var err error
+
+		write := storage.ItemData{
+			Name:      in.Name,
+			Type:      in.Type,
+			ExpiresAt: handler.ExpiresAt(in.ExpiresAt),
+		}
+
+		write.UserID, err = getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = quota.CheckItems(ctx, write.UserID, storage.KindItem, 1); err != nil {
+			return nil, err
+		}
+
+		write.Fields, err = handler.ItemFields(in.Fields, enc)
+		if errors.Is(err, handler.ErrFieldValue) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err != nil {
+			logger.Errorf("encrypt item fields error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = w.ItemWrite(ctx, write)
+		if err != nil {
+			if errors.Is(err, storage.ErrWriteConflict) {
+				return nil, status.Error(codes.AlreadyExists, err.Error())
+			}
+			logger.Errorf("write item error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, write.UserID, storage.KindItem, write.Name, broker.ActionCreate)
+		return &empty.Empty{}, nil
+	}
+}
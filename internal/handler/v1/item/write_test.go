@@ -0,0 +1,124 @@
+package item
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCWriteHandler(t *testing.T) {
+
+	fieldEncErr := errors.New("field encrypt error")
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		userErr    error
+		ecnErr     error
+		writeErr   error
+		fieldType  pb.FieldType
+	}{
+		{
+			name:      "ok",
+			fieldType: pb.FieldType_FIELD_TYPE_SECRET,
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "write error",
+			wantStatus: codes.Internal,
+			writeErr:   errors.New("write error"),
+		},
+		{
+			name:       "already exists",
+			wantStatus: codes.AlreadyExists,
+			writeErr:   storage.ErrWriteConflict,
+		},
+		{
+			name:       fieldEncErr.Error(),
+			wantStatus: codes.Internal,
+			ecnErr:     fieldEncErr,
+		},
+		{
+			name:       "invalid field",
+			wantStatus: codes.InvalidArgument,
+			fieldType:  pb.FieldType_FIELD_TYPE_URL,
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		expiresAt := time.Now().Add(time.Hour).UTC()
+		req := pb.ItemWriteRequest{
+			Name: "name",
+			Type: "api key",
+			Fields: []*pb.ItemField{
+				{Label: "user", Value: "admin"},
+				{Label: "key", Type: tcase.fieldType, Value: "secret"},
+			},
+			ExpiresAt: timestamppb.New(expiresAt),
+		}
+
+		var written storage.ItemData
+		pw := ItemWritterFunc(func(_ context.Context, data storage.ItemData) error {
+			written = data
+			return tcase.writeErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		enc := crypt.EncryptFunc(func(text []byte) ([]byte, error) {
+			if tcase.ecnErr == fieldEncErr && string(text) == "secret" {
+				return nil, tcase.ecnErr
+			}
+			return text, nil
+		})
+
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := NewGRPCWriteHandler(pw, getUserID, enc, pub, nil)(context.Background(), &req)
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, broker.ActionCreate, events[0].Action)
+				assert.Equal(t, "api key", written.Type)
+				require.Len(t, written.Fields, 2)
+				assert.Equal(t, storage.FieldSecret, written.Fields[1].Type)
+				require.NotNil(t, written.ExpiresAt)
+				assert.True(t, expiresAt.Equal(*written.ExpiresAt))
+			} else {
+				assert.Error(t, err)
+				assert.Empty(t, events)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
@@ -29,7 +29,9 @@ var _ ListReader = ListReaderFunc(nil)
 
 type GRPCHandler func(context.Context, *empty.Empty) (*pb.ListResponse, error)
 
-func NewRPCListHandler(list ListReader, getUserID handler.GetUserIDFunc) GRPCHandler {
+// NewRPCListHandler - функция-конструктор ручки количества хранимых данных
+// и ограничений хранилища пользователя
+func NewRPCListHandler(list ListReader, getUserID handler.GetUserIDFunc, limits handler.Limits) GRPCHandler {
 	return func(ctx context.Context, _ *empty.Empty) (*pb.ListResponse, error) {
 
 		userID, err := getUserID(ctx)
@@ -48,6 +50,15 @@ func NewRPCListHandler(list ListReader, getUserID handler.GetUserIDFunc) GRPCHan
 			NotesCount:     int32(data.NotesCount),
 			BinariesCount:  int32(data.BinariesCount),
 			PasswordsCount: int32(data.PasswordsCount),
+			ItemsCount:     int32(data.ItemsCount),
+			OtpsCount:      int32(data.OtpsCount),
+			SshKeysCount:   int32(data.SshKeysCount),
+			BinariesSize:   data.BinariesSize,
+			Limits: &pb.Limits{
+				Bytes:    limits.Bytes,
+				Items:    int32(limits.Items),
+				FileSize: limits.FileSize,
+			},
 		}, nil
 	}
 }
@@ -20,7 +20,7 @@ func TestRPCListHandler(t *testing.T) {
 		data       storage.ListData
 		wantStatus codes.Code
 	}{
-		{name: "ok", wantStatus: 0},
+		{name: "ok", wantStatus: 0, data: storage.ListData{BinariesCount: 2, BinariesSize: 300}},
 		{name: "internal error", wantStatus: codes.Internal},
 		{name: "unauthenticated", wantStatus: codes.Unauthenticated},
 		{name: "invalid argument", wantStatus: codes.InvalidArgument},
@@ -46,10 +46,15 @@ func TestRPCListHandler(t *testing.T) {
 				}
 			})
 
-			resp, err := NewRPCListHandler(list, getter)(context.Background(), nil)
+			limits := handler.Limits{Bytes: 1024, Items: 10, FileSize: 512}
+			resp, err := NewRPCListHandler(list, getter, limits)(context.Background(), nil)
 			if tcase.wantStatus == 0 {
 				assert.NoError(t, err)
 				assert.NotNil(t, resp)
+				assert.Equal(t, tcase.data.BinariesSize, resp.BinariesSize)
+				assert.Equal(t, int64(1024), resp.Limits.Bytes)
+				assert.Equal(t, int32(10), resp.Limits.Items)
+				assert.Equal(t, int64(512), resp.Limits.FileSize)
 			} else {
 				assert.Error(t, err)
 			}
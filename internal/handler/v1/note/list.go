@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -15,30 +14,34 @@ import (
 )
 
 type NoteListGetter interface {
-	NoteList(ctx context.Context, userID string) ([]string, error)
+	NoteList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
 }
 
-type NoteListGetterFunc func(ctx context.Context, userID string) ([]string, error)
+type NoteListGetterFunc func(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
 
-func (f NoteListGetterFunc) NoteList(ctx context.Context, userID string) ([]string, error) {
-	return f(ctx, userID)
+func (f NoteListGetterFunc) NoteList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return f(ctx, q)
 }
 
 var _ NoteListGetter = NoteListGetterFunc(nil)
 
-type GRPCListHandler func(ctx context.Context, in *empty.Empty) (*pb.NoteListResponse, error)
+type GRPCListHandler func(ctx context.Context, in *pb.ListRequest) (*pb.NoteListResponse, error)
 
-// NewGRPCListHandler - функция-конструктор ручки получения списка наименований заметок
+// NewGRPCListHandler - функция-конструктор ручки получения страницы списка заметок
 func NewGRPCListHandler(g NoteListGetter, getUserID handler.GetUserIDFunc) GRPCListHandler {
-	return func(ctx context.Context, in *empty.Empty) (*pb.NoteListResponse, error) {
+	return func(ctx context.Context, in *pb.ListRequest) (*pb.NoteListResponse, error) {
 
 		userID, err := getUserID(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		var resp pb.NoteListResponse
-		resp.Names, err = g.NoteList(ctx, userID)
+		q, err := handler.NewListQuery(userID, in)
+		if err != nil {
+			return nil, err
+		}
+
+		entries, err := g.NoteList(ctx, q)
 		if err != nil {
 			if errors.Is(err, storage.ErrNoContent) {
 				return nil, status.Error(codes.NotFound, err.Error())
@@ -47,6 +50,9 @@ func NewGRPCListHandler(g NoteListGetter, getUserID handler.GetUserIDFunc) GRPCL
 			logger.Errorf("read notes list error: %w", err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		var resp pb.NoteListResponse
+		resp.Entries, resp.NextCursor = handler.ListPage(q, entries)
 		return &resp, nil
 	}
 }
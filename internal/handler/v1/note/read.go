@@ -53,9 +53,10 @@ func NewGRPCReadHandler(r NoteReader, getUserID handler.GetUserIDFunc, dec crypt
 		}
 
 		resp := pb.NoteReadResponse{
-			Id:    data.ID,
-			Name:  data.Name,
-			Notes: string(notes),
+			Id:        data.ID,
+			Name:      data.Name,
+			Notes:     string(notes),
+			ExpiresAt: handler.ExpiresAtPB(data.ExpiresAt),
 		}
 
 		return &resp, nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,6 +19,7 @@ import (
 
 func TestGRPCreadHandler(t *testing.T) {
 
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
 	notesDecErr := errors.New("notes decrypt error")
 
 	tests := []struct {
@@ -65,6 +67,7 @@ func TestGRPCreadHandler(t *testing.T) {
 				res.UserID = "user_id"
 				res.Name = "name"
 				res.Notes = []byte("notes")
+				res.ExpiresAt = &expiresAt
 			}
 			return
 		})
@@ -92,6 +95,7 @@ func TestGRPCreadHandler(t *testing.T) {
 				assert.Equal(t, int64(1), resp.Id)
 				assert.Equal(t, "name", resp.Name)
 				assert.Equal(t, "notes", resp.Notes)
+				assert.True(t, expiresAt.Equal(resp.ExpiresAt.AsTime()))
 
 			} else {
 				assert.Error(t, err)
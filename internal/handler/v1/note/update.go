@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
@@ -31,13 +32,14 @@ var _ NoteUpdater = NoteUpdaterFunc(nil)
 type GRPCUpdateHandler func(context.Context, *pb.NoteUpdateRequest) (*empty.Empty, error)
 
 // NewGRPCUpdateHandler - функция конструктор ручки для обновления заметки
-func NewGRPCUpdateHandler(u NoteUpdater, getUserID handler.GetUserIDFunc, enc crypt.Encryptor) GRPCUpdateHandler {
+func NewGRPCUpdateHandler(u NoteUpdater, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher) GRPCUpdateHandler {
 	return func(ctx context.Context, in *pb.NoteUpdateRequest) (*empty.Empty, error) {
 		var err error
 
 		upd := storage.NoteData{
-			ID:   in.Id,
-			Name: in.Write.Name,
+			ID:        in.Id,
+			Name:      in.Write.Name,
+			ExpiresAt: handler.ExpiresAt(in.Write.ExpiresAt),
 		}
 
 		upd.UserID, err = getUserID(ctx)
@@ -63,6 +65,7 @@ func NewGRPCUpdateHandler(u NoteUpdater, getUserID handler.GetUserIDFunc, enc cr
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		handler.Notify(ctx, pub, upd.UserID, storage.KindNote, upd.Name, broker.ActionUpdate)
 		return &empty.Empty{}, nil
 	}
 }
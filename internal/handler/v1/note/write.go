@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
@@ -31,12 +32,13 @@ var _ NoteWritter = NoteWritterFunc(nil)
 type GRPCWriteHandler func(ctx context.Context, in *pb.NoteWriteRequest) (*empty.Empty, error)
 
 // NewGRPCWriteHandler - функция-конструктор ручки записи заметки
-func NewGRPCWriteHandler(w NoteWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor) GRPCWriteHandler {
+func NewGRPCWriteHandler(w NoteWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher, quota *handler.Quota) GRPCWriteHandler {
 	return func(ctx context.Context, in *pb.NoteWriteRequest) (*empty.Empty, error) {
 		var err error
 
 		write := storage.NoteData{
-			Name: in.Name,
+			Name:      in.Name,
+			ExpiresAt: handler.ExpiresAt(in.ExpiresAt),
 		}
 
 		write.UserID, err = getUserID(ctx)
@@ -44,6 +46,10 @@ func NewGRPCWriteHandler(w NoteWritter, getUserID handler.GetUserIDFunc, enc cry
 			return nil, err
 		}
 
+		if err = quota.CheckItems(ctx, write.UserID, storage.KindNote, 1); err != nil {
+			return nil, err
+		}
+
 		write.Notes, err = enc.Encrypt([]byte(in.Notes))
 		if err != nil {
 			logger.Errorf("encrypt notes error: %w", err)
@@ -59,6 +65,7 @@ func NewGRPCWriteHandler(w NoteWritter, getUserID handler.GetUserIDFunc, enc cry
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		handler.Notify(ctx, pub, write.UserID, storage.KindNote, write.Name, broker.ActionCreate)
 		return &empty.Empty{}, nil
 	}
 }
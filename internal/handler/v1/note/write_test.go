@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
@@ -54,12 +57,22 @@ func TestGRPCWriteHandler(t *testing.T) {
 
 	for _, tcase := range tests {
 
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		expiresAt := time.Now().Add(time.Hour).UTC()
 		req := pb.NoteWriteRequest{
-			Name:  "name",
-			Notes: "notes",
+			Name:      "name",
+			Notes:     "notes",
+			ExpiresAt: timestamppb.New(expiresAt),
 		}
 
-		pw := NoteWritterFunc(func(context.Context, storage.NoteData) error {
+		var written storage.NoteData
+		pw := NoteWritterFunc(func(_ context.Context, data storage.NoteData) error {
+			written = data
 			return tcase.writeErr
 		})
 
@@ -78,11 +91,16 @@ func TestGRPCWriteHandler(t *testing.T) {
 		})
 
 		t.Run(tcase.name, func(t *testing.T) {
-			_, err := NewGRPCWriteHandler(pw, getUserID, enc)(context.Background(), &req)
+			_, err := NewGRPCWriteHandler(pw, getUserID, enc, pub, nil)(context.Background(), &req)
 			if tcase.wantStatus == 0 {
 				assert.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, broker.ActionCreate, events[0].Action)
+				require.NotNil(t, written.ExpiresAt)
+				assert.True(t, expiresAt.Equal(*written.ExpiresAt))
 			} else {
 				assert.Error(t, err)
+				assert.Empty(t, events)
 				status, ok := status.FromError(err)
 				require.Equal(t, true, ok)
 				assert.Equal(t, tcase.wantStatus, status.Code())
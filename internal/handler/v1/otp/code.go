@@ -0,0 +1,71 @@
+package otp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	onetime "github.com/eugene982/yp-gophkeeper/internal/otp"
+)
+
+type OtpCounter interface {
+	OtpNextCounter(ctx context.Context, userID, name string) (storage.OtpData, error)
+}
+
+type OtpCounterFunc func(ctx context.Context, userID, name string) (storage.OtpData, error)
+
+func (f OtpCounterFunc) OtpNextCounter(ctx context.Context, userID, name string) (storage.OtpData, error) {
+	return f(ctx, userID, name)
+}
+
+var _ OtpCounter = OtpCounterFunc(nil)
+
+type GRPCCodeHandler func(context.Context, *pb.OtpCodeRequest) (*pb.OtpCodeResponse, error)
+
+// NewGRPCCodeHandler - функция-конструктор ручки получения текущего
+// одноразового пароля. Для HOTP счётчик увеличивается в хранилище,
+// поэтому каждый вызов выдаёт новый пароль.
+func NewGRPCCodeHandler(r OtpReader, c OtpCounter, getUserID handler.GetUserIDFunc, dec crypt.Decryptor) GRPCCodeHandler {
+	return func(ctx context.Context, in *pb.OtpCodeRequest) (*pb.OtpCodeResponse, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := r.OtpRead(ctx, userID, in.Name)
+		if err == nil && data.Type == onetime.TypeHOTP {
+			data, err = c.OtpNextCounter(ctx, userID, in.Name)
+		}
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("read otp error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		p, err := handler.OtpParamsOf(data, dec)
+		if err != nil {
+			logger.Errorf("decrypt otp error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		var resp pb.OtpCodeResponse
+		if p.Type == onetime.TypeHOTP {
+			resp.Code, resp.Counter = p.HOTP(p.Counter), p.Counter
+		} else {
+			code, left := p.TOTP(time.Now())
+			resp.Code, resp.Remaining = code, uint32(left/time.Second)
+		}
+		return &resp, nil
+	}
+}
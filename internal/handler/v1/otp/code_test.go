@@ -0,0 +1,111 @@
+package otp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCCodeHandler(t *testing.T) {
+
+	// секрет тестовых значений RFC 4226
+	secret := []byte("12345678901234567890")
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		typ        string
+		readErr    error
+		counterErr error
+	}{
+		{
+			name: "totp",
+			typ:  "totp",
+		},
+		{
+			name: "hotp",
+			typ:  "hotp",
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			typ:        "totp",
+			readErr:    storage.ErrNoContent,
+		},
+		{
+			name:       "counter error",
+			wantStatus: codes.Internal,
+			typ:        "hotp",
+			counterErr: errors.New("counter error"),
+		},
+	}
+
+	for _, tcase := range tests {
+
+		data := storage.OtpData{
+			Name:      "bank",
+			Secret:    secret,
+			Type:      tcase.typ,
+			Algorithm: "SHA1",
+			Digits:    6,
+			Period:    30,
+			Counter:   3,
+		}
+
+		var counted bool
+		pr := OtpReaderFunc(func(context.Context, string, string) (storage.OtpData, error) {
+			return data, tcase.readErr
+		})
+		pc := OtpCounterFunc(func(context.Context, string, string) (storage.OtpData, error) {
+			counted = true
+			return data, tcase.counterErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			return "user", nil
+		})
+
+		dec := crypt.DecryptFunc(func(text []byte) ([]byte, error) {
+			return text, nil
+		})
+
+		t.Run(tcase.name, func(t *testing.T) {
+			p, err := handler.OtpParamsOf(data, dec)
+			require.NoError(t, err)
+			before, _ := p.TOTP(time.Now())
+
+			resp, err := NewGRPCCodeHandler(pr, pc, getUserID, dec)(context.Background(), &pb.OtpCodeRequest{Name: "bank"})
+			if tcase.wantStatus != 0 {
+				assert.Error(t, err)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tcase.typ == "hotp", counted)
+			if tcase.typ == "hotp" {
+				assert.Equal(t, "969429", resp.Code)
+				assert.Equal(t, uint64(3), resp.Counter)
+				assert.Zero(t, resp.Remaining)
+				return
+			}
+			// код мог смениться во время вызова
+			after, _ := p.TOTP(time.Now())
+			assert.Contains(t, []string{before, after}, resp.Code)
+			assert.True(t, resp.Remaining >= 1 && resp.Remaining <= 30)
+		})
+	}
+}
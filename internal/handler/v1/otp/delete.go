@@ -0,0 +1,52 @@
+package otp
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type OtpDeleter interface {
+	OtpDelete(ctx context.Context, userID, name string) error
+}
+
+type OtpDeleteFunc func(ctx context.Context, userID, name string) error
+
+func (f OtpDeleteFunc) OtpDelete(ctx context.Context, userID, name string) error {
+	return f(ctx, userID, name)
+}
+
+var _ OtpDeleter = OtpDeleteFunc(nil)
+
+type GRPCDeleteHandler func(ctx context.Context, in *pb.OtpDelRequest) (*empty.Empty, error)
+
+// NewGRPCDeleteHandler - функция-конструктор ручки удаления секрета одноразовых паролей
+func NewGRPCDeleteHandler(d OtpDeleter, getUserID handler.GetUserIDFunc, pub broker.Publisher) GRPCDeleteHandler {
+	return func(ctx context.Context, in *pb.OtpDelRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = d.OtpDelete(ctx, userID, in.Name)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("delete otp error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, userID, storage.KindOtp, in.Name, broker.ActionDelete)
+		return &empty.Empty{}, nil
+	}
+}
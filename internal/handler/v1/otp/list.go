@@ -0,0 +1,58 @@
+package otp
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type OtpListGetter interface {
+	OtpList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
+}
+
+type OtpListGetterFunc func(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
+
+func (f OtpListGetterFunc) OtpList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return f(ctx, q)
+}
+
+var _ OtpListGetter = OtpListGetterFunc(nil)
+
+type GRPCListHandler func(ctx context.Context, in *pb.ListRequest) (*pb.OtpListResponse, error)
+
+// NewGRPCListHandler - функция-конструктор ручки получения страницы списка секретов одноразовых паролей
+func NewGRPCListHandler(g OtpListGetter, getUserID handler.GetUserIDFunc) GRPCListHandler {
+	return func(ctx context.Context, in *pb.ListRequest) (*pb.OtpListResponse, error) {
+
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		q, err := handler.NewListQuery(userID, in)
+		if err != nil {
+			return nil, err
+		}
+
+		entries, err := g.OtpList(ctx, q)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+
+			logger.Errorf("read otps list error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		var resp pb.OtpListResponse
+		resp.Entries, resp.NextCursor = handler.ListPage(q, entries)
+		return &resp, nil
+	}
+}
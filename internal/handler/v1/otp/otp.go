@@ -0,0 +1,3 @@
+// Package otp ручка работы с секретами одноразовых паролей TOTP и HOTP:
+// учётная запись и секрет шифруются, пароли генерирует сервер
+package otp
@@ -0,0 +1,73 @@
+package otp
+
+import (
+	"context"
+	"encoding/base32"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+type OtpReader interface {
+	OtpRead(ctx context.Context, userID, name string) (storage.OtpData, error)
+}
+
+type OtpReaderFunc func(ctx context.Context, userID, name string) (storage.OtpData, error)
+
+func (f OtpReaderFunc) OtpRead(ctx context.Context, userID, name string) (storage.OtpData, error) {
+	return f(ctx, userID, name)
+}
+
+var _ OtpReader = OtpReaderFunc(nil)
+
+type GRPCReadHandler func(context.Context, *pb.OtpReadRequest) (*pb.OtpReadResponse, error)
+
+// NewGRPCReadHandler - функця-конструктор ручки чтения секрета одноразовых паролей
+func NewGRPCReadHandler(r OtpReader, getUserID handler.GetUserIDFunc, dec crypt.Decryptor) GRPCReadHandler {
+	return func(ctx context.Context, in *pb.OtpReadRequest) (*pb.OtpReadResponse, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := r.OtpRead(ctx, userID, in.Name)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("read otp error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		p, err := handler.OtpParamsOf(data, dec)
+		if err != nil {
+			logger.Errorf("decrypt otp error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp := pb.OtpReadResponse{
+			Id:        data.ID,
+			Name:      data.Name,
+			Issuer:    p.Issuer,
+			Account:   p.Account,
+			Secret:    base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(p.Secret),
+			Type:      handler.OtpTypePB(p.Type),
+			Algorithm: handler.OtpAlgorithmPB(p.Algorithm),
+			Digits:    uint32(p.Digits),
+			Period:    uint32(p.Period / time.Second),
+			Counter:   p.Counter,
+			ExpiresAt: handler.ExpiresAtPB(data.ExpiresAt),
+		}
+
+		return &resp, nil
+	}
+}
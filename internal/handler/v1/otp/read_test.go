@@ -0,0 +1,121 @@
+package otp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCreadHandler(t *testing.T) {
+
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	decErr := errors.New("decrypt error")
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		userErr    error
+		decErr     error
+		readErr    error
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "read error",
+			wantStatus: codes.Internal,
+			readErr:    errors.New("read error"),
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			readErr:    storage.ErrNoContent,
+		},
+		{
+			name:       decErr.Error(),
+			wantStatus: codes.Internal,
+			decErr:     decErr,
+		},
+	}
+
+	for _, tcase := range tests {
+
+		req := pb.OtpReadRequest{
+			Name: "github",
+		}
+
+		pr := OtpReaderFunc(func(context.Context, string, string) (res storage.OtpData, err error) {
+			err = tcase.readErr
+			if err == nil {
+				res = storage.OtpData{
+					ID:        1,
+					UserID:    "user_id",
+					Name:      "github",
+					Issuer:    "GitHub",
+					Account:   []byte("alice"),
+					Secret:    []byte("Hello!\xde\xad\xbe\xef"),
+					Type:      "totp",
+					Algorithm: "SHA256",
+					Digits:    8,
+					Period:    60,
+					ExpiresAt: &expiresAt,
+				}
+			}
+			return
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		dec := crypt.DecryptFunc(func(text []byte) ([]byte, error) {
+			return text, tcase.decErr
+		})
+
+		resp, err := NewGRPCReadHandler(pr, getUserID, dec)(context.Background(), &req)
+
+		t.Run(tcase.name, func(t *testing.T) {
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+
+				assert.Equal(t, int64(1), resp.Id)
+				assert.Equal(t, "github", resp.Name)
+				assert.Equal(t, "GitHub", resp.Issuer)
+				assert.Equal(t, "alice", resp.Account)
+				assert.Equal(t, "JBSWY3DPEHPK3PXP", resp.Secret)
+				assert.Equal(t, pb.OtpType_OTP_TYPE_TOTP, resp.Type)
+				assert.Equal(t, pb.OtpAlgorithm_OTP_ALGORITHM_SHA256, resp.Algorithm)
+				assert.Equal(t, uint32(8), resp.Digits)
+				assert.Equal(t, uint32(60), resp.Period)
+				assert.True(t, expiresAt.Equal(resp.ExpiresAt.AsTime()))
+
+			} else {
+				assert.Error(t, err)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+
+	}
+
+}
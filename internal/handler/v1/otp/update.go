@@ -0,0 +1,66 @@
+package otp
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+type OtpUpdater interface {
+	OtpUpdate(ctx context.Context, data storage.OtpData) error
+}
+
+type OtpUpdaterFunc func(ctx context.Context, data storage.OtpData) error
+
+func (f OtpUpdaterFunc) OtpUpdate(ctx context.Context, data storage.OtpData) error {
+	return f(ctx, data)
+}
+
+var _ OtpUpdater = OtpUpdaterFunc(nil)
+
+type GRPCUpdateHandler func(context.Context, *pb.OtpUpdateRequest) (*empty.Empty, error)
+
+// NewGRPCUpdateHandler - функция конструктор ручки для обновления секрета одноразовых паролей
+func NewGRPCUpdateHandler(u OtpUpdater, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher) GRPCUpdateHandler {
+	return func(ctx context.Context, in *pb.OtpUpdateRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		upd, err := handler.OtpData(userID, in.Id, in.Write, enc)
+		if errors.Is(err, handler.ErrOtpParams) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err != nil {
+			logger.Errorf("encrypt otp error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = u.OtpUpdate(ctx, upd)
+		if err != nil {
+			if errors.Is(err, storage.ErrWriteConflict) {
+				return nil, status.Error(codes.AlreadyExists, err.Error())
+			}
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("otp update error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, upd.UserID, storage.KindOtp, upd.Name, broker.ActionUpdate)
+		return &empty.Empty{}, nil
+	}
+}
@@ -0,0 +1,67 @@
+package otp
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+type OtpWritter interface {
+	OtpWrite(ctx context.Context, data storage.OtpData) error
+}
+
+type OtpWritterFunc func(ctx context.Context, data storage.OtpData) error
+
+func (f OtpWritterFunc) OtpWrite(ctx context.Context, data storage.OtpData) error {
+	return f(ctx, data)
+}
+
+var _ OtpWritter = OtpWritterFunc(nil)
+
+type GRPCWriteHandler func(ctx context.Context, in *pb.OtpWriteRequest) (*empty.Empty, error)
+
+// NewGRPCWriteHandler - функция-конструктор ручки записи секрета одноразовых паролей
+func NewGRPCWriteHandler(w OtpWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher, quota *handler.Quota) GRPCWriteHandler {
+	return func(ctx context.Context, in *pb.OtpWriteRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = quota.CheckItems(ctx, userID, storage.KindOtp, 1); err != nil {
+			return nil, err
+		}
+
+		write, err := handler.OtpData(userID, 0, in, enc)
+		if errors.Is(err, handler.ErrOtpParams) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err != nil {
+			logger.Errorf("encrypt otp error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = w.OtpWrite(ctx, write)
+		if err != nil {
+			if errors.Is(err, storage.ErrWriteConflict) {
+				return nil, status.Error(codes.AlreadyExists, err.Error())
+			}
+			logger.Errorf("write otp error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, write.UserID, storage.KindOtp, write.Name, broker.ActionCreate)
+		return &empty.Empty{}, nil
+	}
+}
@@ -0,0 +1,122 @@
+package otp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCWriteHandler(t *testing.T) {
+
+	encErr := errors.New("encrypt error")
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		userErr    error
+		ecnErr     error
+		writeErr   error
+		uri        string
+	}{
+		{
+			name: "ok",
+			uri:  "otpauth://totp/GitHub:alice?secret=JBSWY3DPEHPK3PXP&digits=8",
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "write error",
+			wantStatus: codes.Internal,
+			writeErr:   errors.New("write error"),
+		},
+		{
+			name:       "already exists",
+			wantStatus: codes.AlreadyExists,
+			writeErr:   storage.ErrWriteConflict,
+		},
+		{
+			name:       encErr.Error(),
+			wantStatus: codes.Internal,
+			ecnErr:     encErr,
+		},
+		{
+			name:       "invalid uri",
+			wantStatus: codes.InvalidArgument,
+			uri:        "otpauth://totp/GitHub:alice?secret=JBSWY3DPEHPK3PXP&algorithm=MD5",
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		expiresAt := time.Now().Add(time.Hour).UTC()
+		req := pb.OtpWriteRequest{
+			Name:      "github",
+			Uri:       tcase.uri,
+			ExpiresAt: timestamppb.New(expiresAt),
+		}
+		if req.Uri == "" {
+			req.Secret = "JBSWY3DPEHPK3PXP"
+		}
+
+		var written storage.OtpData
+		pw := OtpWritterFunc(func(_ context.Context, data storage.OtpData) error {
+			written = data
+			return tcase.writeErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		enc := crypt.EncryptFunc(func(text []byte) ([]byte, error) {
+			return text, tcase.ecnErr
+		})
+
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := NewGRPCWriteHandler(pw, getUserID, enc, pub, nil)(context.Background(), &req)
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, broker.ActionCreate, events[0].Action)
+				assert.Equal(t, storage.KindOtp, events[0].Kind)
+				assert.Equal(t, "GitHub", written.Issuer)
+				assert.Equal(t, []byte("alice"), written.Account)
+				assert.Equal(t, 8, written.Digits)
+				assert.Equal(t, 30, written.Period)
+				require.NotNil(t, written.ExpiresAt)
+				assert.True(t, expiresAt.Equal(*written.ExpiresAt))
+			} else {
+				assert.Error(t, err)
+				assert.Empty(t, events)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
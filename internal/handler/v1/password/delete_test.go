@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
 )
@@ -44,6 +45,12 @@ func TestGRPCDeleteHandler(t *testing.T) {
 	}
 
 	for _, tcase := range tests {
+
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
 		delete := PasswordDeleteFunc(func(ctx context.Context, userID, name string) error {
 			return tcase.delErr
 		})
@@ -58,14 +65,17 @@ func TestGRPCDeleteHandler(t *testing.T) {
 		req := pb.PasswordDelRequest{
 			Name: "password",
 		}
-		_, err := NewGRPCDeleteHandler(delete, getUserID)(context.Background(), &req)
+		_, err := NewGRPCDeleteHandler(delete, getUserID, pub)(context.Background(), &req)
 
 		t.Run(tcase.name, func(t *testing.T) {
 			if tcase.wantStatus == 0 {
 				require.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, broker.ActionDelete, events[0].Action)
 
 			} else {
 				assert.Error(t, err)
+				assert.Empty(t, events)
 				status, ok := status.FromError(err)
 				require.Equal(t, true, ok)
 				assert.Equal(t, tcase.wantStatus, status.Code())
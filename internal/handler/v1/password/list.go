@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -15,30 +14,34 @@ import (
 )
 
 type PasswordListGetter interface {
-	PasswordList(ctx context.Context, userID string) ([]string, error)
+	PasswordList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
 }
 
-type PasswordListGetterFunc func(ctx context.Context, userID string) ([]string, error)
+type PasswordListGetterFunc func(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error)
 
-func (f PasswordListGetterFunc) PasswordList(ctx context.Context, userID string) ([]string, error) {
-	return f(ctx, userID)
+func (f PasswordListGetterFunc) PasswordList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return f(ctx, q)
 }
 
 var _ PasswordListGetter = PasswordListGetterFunc(nil)
 
-type GRPCListHandler func(ctx context.Context, in *empty.Empty) (*pb.PasswordListResponse, error)
+type GRPCListHandler func(ctx context.Context, in *pb.ListRequest) (*pb.PasswordListResponse, error)
 
-// NewGRPCListHandler - функция-конструктор ручки получения списка наименований паролей
+// NewGRPCListHandler - функция-конструктор ручки получения страницы списка паролей
 func NewGRPCListHandler(g PasswordListGetter, getUserID handler.GetUserIDFunc) GRPCListHandler {
-	return func(ctx context.Context, in *empty.Empty) (*pb.PasswordListResponse, error) {
+	return func(ctx context.Context, in *pb.ListRequest) (*pb.PasswordListResponse, error) {
 
 		userID, err := getUserID(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		var resp pb.PasswordListResponse
-		resp.Names, err = g.PasswordList(ctx, userID)
+		q, err := handler.NewListQuery(userID, in)
+		if err != nil {
+			return nil, err
+		}
+
+		entries, err := g.PasswordList(ctx, q)
 		if err != nil {
 			if errors.Is(err, storage.ErrNoContent) {
 				return nil, status.Error(codes.NotFound, err.Error())
@@ -47,6 +50,9 @@ func NewGRPCListHandler(g PasswordListGetter, getUserID handler.GetUserIDFunc) G
 			logger.Errorf("read passwords list error: %w", err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		var resp pb.PasswordListResponse
+		resp.Entries, resp.NextCursor = handler.ListPage(q, entries)
 		return &resp, nil
 	}
 }
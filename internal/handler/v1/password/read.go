@@ -65,11 +65,14 @@ func NewGRPCReadHandler(r PasswordReader, getUserID handler.GetUserIDFunc, dec c
 		}
 
 		resp := pb.PasswordReadResponse{
-			Id:       data.ID,
-			Name:     data.Name,
-			Username: string(username),
-			Password: string(password),
-			Notes:    string(notes),
+			Id:         data.ID,
+			Name:       data.Name,
+			Username:   string(username),
+			Password:   string(password),
+			Notes:      string(notes),
+			Url:        data.URL,
+			Searchable: data.SearchUsername != "",
+			ExpiresAt:  handler.ExpiresAtPB(data.ExpiresAt),
 		}
 
 		return &resp, nil
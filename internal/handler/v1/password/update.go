@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
@@ -31,13 +32,18 @@ var _ PasswordUpdater = PasswordUpdaterFunc(nil)
 type GRPCUpdateHandler func(context.Context, *pb.PasswordUpdateRequest) (*empty.Empty, error)
 
 // NewGRPCUpdateHandler - функция конструктор ручки для обновления пароля
-func NewGRPCUpdateHandler(u PasswordUpdater, getUserID handler.GetUserIDFunc, enc crypt.Encryptor) GRPCUpdateHandler {
+func NewGRPCUpdateHandler(u PasswordUpdater, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher) GRPCUpdateHandler {
 	return func(ctx context.Context, in *pb.PasswordUpdateRequest) (*empty.Empty, error) {
 		var err error
 
 		upd := storage.PasswordData{
-			ID:   in.Id,
-			Name: in.Write.Name,
+			ID:        in.Id,
+			Name:      in.Write.Name,
+			URL:       in.Write.Url,
+			ExpiresAt: handler.ExpiresAt(in.Write.ExpiresAt),
+		}
+		if in.Write.Searchable {
+			upd.SearchUsername = in.Write.Username
 		}
 
 		upd.UserID, err = getUserID(ctx)
@@ -75,6 +81,7 @@ func NewGRPCUpdateHandler(u PasswordUpdater, getUserID handler.GetUserIDFunc, en
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		handler.Notify(ctx, pub, upd.UserID, storage.KindPassword, upd.Name, broker.ActionUpdate)
 		return &empty.Empty{}, nil
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
 	"github.com/eugene982/yp-gophkeeper/internal/handler"
 	"github.com/eugene982/yp-gophkeeper/internal/logger"
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
@@ -31,12 +32,17 @@ var _ PasswordWritter = PasswordWritterFunc(nil)
 type GRPCWriteHandler func(ctx context.Context, in *pb.PasswordWriteRequest) (*empty.Empty, error)
 
 // NewGRPCWriteHandler - функция-конструктор ручки записи пароля
-func NewGRPCWriteHandler(w PasswordWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor) GRPCWriteHandler {
+func NewGRPCWriteHandler(w PasswordWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher, quota *handler.Quota) GRPCWriteHandler {
 	return func(ctx context.Context, in *pb.PasswordWriteRequest) (*empty.Empty, error) {
 		var err error
 
 		write := storage.PasswordData{
-			Name: in.Name,
+			Name:      in.Name,
+			URL:       in.Url,
+			ExpiresAt: handler.ExpiresAt(in.ExpiresAt),
+		}
+		if in.Searchable {
+			write.SearchUsername = in.Username
 		}
 
 		write.UserID, err = getUserID(ctx)
@@ -44,6 +50,10 @@ func NewGRPCWriteHandler(w PasswordWritter, getUserID handler.GetUserIDFunc, enc
 			return nil, err
 		}
 
+		if err = quota.CheckItems(ctx, write.UserID, storage.KindPassword, 1); err != nil {
+			return nil, err
+		}
+
 		write.Username, err = enc.Encrypt([]byte(in.Username))
 		if err != nil {
 			logger.Errorf("encrypt username error: %w", err)
@@ -71,6 +81,7 @@ func NewGRPCWriteHandler(w PasswordWritter, getUserID handler.GetUserIDFunc, enc
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
+		handler.Notify(ctx, pub, write.UserID, storage.KindPassword, write.Name, broker.ActionCreate)
 		return &empty.Empty{}, nil
 	}
 }
@@ -0,0 +1,66 @@
+// Package rename ручка переименования элементов хранилища
+package rename
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type Renamer interface {
+	Rename(ctx context.Context, kind, userID, name, newName string) error
+}
+
+type RenamerFunc func(ctx context.Context, kind, userID, name, newName string) error
+
+func (f RenamerFunc) Rename(ctx context.Context, kind, userID, name, newName string) error {
+	return f(ctx, kind, userID, name, newName)
+}
+
+var _ Renamer = RenamerFunc(nil)
+
+type GRPCRenameHandler func(ctx context.Context, in *pb.RenameRequest) (*empty.Empty, error)
+
+// NewGRPCRenameHandler - функция-конструктор ручки переименования
+func NewGRPCRenameHandler(r Renamer, getUserID handler.GetUserIDFunc, pub broker.Publisher) GRPCRenameHandler {
+	return func(ctx context.Context, in *pb.RenameRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if in.Name == in.NewName {
+			return &empty.Empty{}, nil
+		}
+
+		err = r.Rename(ctx, in.Kind, userID, in.Name, in.NewName)
+		if err != nil {
+			if errors.Is(err, storage.ErrWriteConflict) {
+				return nil, status.Error(codes.AlreadyExists, err.Error())
+			}
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("rename error: %w", err, "kind", in.Kind)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.NotifyEvent(ctx, pub, broker.Event{
+			UserID:  userID,
+			Kind:    in.Kind,
+			Name:    in.NewName,
+			Action:  broker.ActionRename,
+			OldName: in.Name,
+		})
+		return &empty.Empty{}, nil
+	}
+}
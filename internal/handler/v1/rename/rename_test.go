@@ -0,0 +1,112 @@
+package rename
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCRenameHandler(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		newName    string
+		wantStatus codes.Code
+		wantEvents int
+		userErr    error
+		renameErr  error
+	}{
+		{
+			name:       "ok",
+			newName:    "new",
+			wantEvents: 1,
+		},
+		{
+			name:    "same name",
+			newName: "old",
+		},
+		{
+			name:       "unauthenticated",
+			newName:    "new",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "already exists",
+			newName:    "new",
+			wantStatus: codes.AlreadyExists,
+			renameErr:  storage.ErrWriteConflict,
+		},
+		{
+			name:       "not found",
+			newName:    "new",
+			wantStatus: codes.NotFound,
+			renameErr:  storage.ErrNoContent,
+		},
+		{
+			name:       "rename error",
+			newName:    "new",
+			wantStatus: codes.Internal,
+			renameErr:  errors.New("rename error"),
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		r := RenamerFunc(func(_ context.Context, kind, userID, name, newName string) error {
+			return tcase.renameErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		req := pb.RenameRequest{
+			Kind:    storage.KindBinary,
+			Name:    "old",
+			NewName: tcase.newName,
+		}
+
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := NewGRPCRenameHandler(r, getUserID, pub)(context.Background(), &req)
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+				require.Len(t, events, tcase.wantEvents)
+				if tcase.wantEvents > 0 {
+					assert.Equal(t, broker.Event{
+						UserID:  "user",
+						Kind:    storage.KindBinary,
+						Name:    "new",
+						Action:  broker.ActionRename,
+						OldName: "old",
+					}, events[0])
+				}
+			} else {
+				assert.Error(t, err)
+				assert.Empty(t, events)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Package search ручка поиска по всем видам хранимых данных
+package search
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// DefaultLimit количество результатов, если в запросе не указано
+const DefaultLimit = 20
+
+type Searcher interface {
+	Search(ctx context.Context, q storage.SearchQuery) ([]storage.SearchResult, error)
+}
+
+type SearcherFunc func(ctx context.Context, q storage.SearchQuery) ([]storage.SearchResult, error)
+
+func (f SearcherFunc) Search(ctx context.Context, q storage.SearchQuery) ([]storage.SearchResult, error) {
+	return f(ctx, q)
+}
+
+var _ Searcher = SearcherFunc(nil)
+
+type GRPCSearchHandler func(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error)
+
+// NewGRPCSearchHandler - функция-конструктор ручки поиска
+func NewGRPCSearchHandler(s Searcher, getUserID handler.GetUserIDFunc) GRPCSearchHandler {
+	return func(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		q := storage.SearchQuery{
+			UserID: userID,
+			Text:   in.Query,
+			Limit:  int(in.Limit),
+			Kinds:  in.Kinds,
+		}
+		if q.Limit == 0 {
+			q.Limit = DefaultLimit
+		}
+
+		found, err := s.Search(ctx, q)
+		if err != nil && !errors.Is(err, storage.ErrNoContent) {
+			logger.Errorf("search error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp := pb.SearchResponse{
+			Results: make([]*pb.SearchResult, len(found)),
+		}
+		for i, r := range found {
+			resp.Results[i] = &pb.SearchResult{
+				Kind:    r.Kind,
+				Name:    r.Name,
+				Score:   float32(r.Score),
+				Matched: r.Matched,
+			}
+		}
+		return &resp, nil
+	}
+}
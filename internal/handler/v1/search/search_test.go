@@ -0,0 +1,107 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCSearchHandler(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		limit      int32
+		wantLimit  int
+		wantCount  int
+		wantStatus codes.Code
+		userErr    error
+		searchErr  error
+	}{
+		{
+			name:      "ok",
+			limit:     5,
+			wantLimit: 5,
+			wantCount: 2,
+		},
+		{
+			name:      "default limit",
+			wantLimit: DefaultLimit,
+			wantCount: 2,
+		},
+		{
+			name:      "not found",
+			wantLimit: DefaultLimit,
+			searchErr: storage.ErrNoContent,
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "search error",
+			wantLimit:  DefaultLimit,
+			wantStatus: codes.Internal,
+			searchErr:  errors.New("search error"),
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var gotQuery storage.SearchQuery
+		s := SearcherFunc(func(ctx context.Context, q storage.SearchQuery) ([]storage.SearchResult, error) {
+			gotQuery = q
+			if tcase.searchErr != nil {
+				return nil, tcase.searchErr
+			}
+			return []storage.SearchResult{
+				{Kind: storage.KindPassword, Name: "mail", Score: 1, Matched: "name"},
+				{Kind: storage.KindNote, Name: "gmail", Score: 0.5, Matched: "tag"},
+			}, nil
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		req := &pb.SearchRequest{
+			Query: "mail",
+			Limit: tcase.limit,
+			Kinds: []string{storage.KindPassword, storage.KindNote},
+		}
+		resp, err := NewGRPCSearchHandler(s, getUserID)(context.Background(), req)
+
+		t.Run(tcase.name, func(t *testing.T) {
+			if tcase.wantStatus == 0 {
+				require.NoError(t, err)
+				assert.Equal(t, "user", gotQuery.UserID)
+				assert.Equal(t, "mail", gotQuery.Text)
+				assert.Equal(t, tcase.wantLimit, gotQuery.Limit)
+				assert.Equal(t, req.Kinds, gotQuery.Kinds)
+				require.Len(t, resp.Results, tcase.wantCount)
+				if tcase.wantCount > 0 {
+					assert.Equal(t, "mail", resp.Results[0].Name)
+					assert.Equal(t, float32(0.5), resp.Results[1].Score)
+					assert.Equal(t, "tag", resp.Results[1].Matched)
+				}
+			} else {
+				assert.Error(t, err)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package sshkey
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type SshKeyDeleter interface {
+	SshKeyDelete(ctx context.Context, userID, name string) error
+}
+
+type SshKeyDeleteFunc func(ctx context.Context, userID, name string) error
+
+func (f SshKeyDeleteFunc) SshKeyDelete(ctx context.Context, userID, name string) error {
+	return f(ctx, userID, name)
+}
+
+var _ SshKeyDeleter = SshKeyDeleteFunc(nil)
+
+type GRPCDeleteHandler func(ctx context.Context, in *pb.SshKeyDelRequest) (*empty.Empty, error)
+
+// NewGRPCDeleteHandler - функция-конструктор ручки удаления ключа SSH
+func NewGRPCDeleteHandler(d SshKeyDeleter, getUserID handler.GetUserIDFunc, pub broker.Publisher) GRPCDeleteHandler {
+	return func(ctx context.Context, in *pb.SshKeyDelRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = d.SshKeyDelete(ctx, userID, in.Name)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("delete ssh key error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, userID, storage.KindSshKey, in.Name, broker.ActionDelete)
+		return &empty.Empty{}, nil
+	}
+}
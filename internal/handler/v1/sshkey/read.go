@@ -0,0 +1,69 @@
+package sshkey
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+type SshKeyReader interface {
+	SshKeyRead(ctx context.Context, userID, name string) (storage.SshKeyData, error)
+}
+
+type SshKeyReaderFunc func(ctx context.Context, userID, name string) (storage.SshKeyData, error)
+
+func (f SshKeyReaderFunc) SshKeyRead(ctx context.Context, userID, name string) (storage.SshKeyData, error) {
+	return f(ctx, userID, name)
+}
+
+var _ SshKeyReader = SshKeyReaderFunc(nil)
+
+type GRPCReadHandler func(context.Context, *pb.SshKeyReadRequest) (*pb.SshKeyReadResponse, error)
+
+// NewGRPCReadHandler - функця-конструктор ручки чтения ключа SSH
+func NewGRPCReadHandler(r SshKeyReader, getUserID handler.GetUserIDFunc, dec crypt.Decryptor) GRPCReadHandler {
+	return func(ctx context.Context, in *pb.SshKeyReadRequest) (*pb.SshKeyReadResponse, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := r.SshKeyRead(ctx, userID, in.Name)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("read ssh key error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		private, err := dec.Decrypt(data.PrivateKey)
+		if err != nil {
+			logger.Errorf("decrypt ssh key error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp := pb.SshKeyReadResponse{
+			Id:          data.ID,
+			Name:        data.Name,
+			Type:        handler.SshKeyTypePB(data.Type),
+			Bits:        uint32(data.Bits),
+			PublicKey:   data.PublicKey,
+			PrivateKey:  string(private),
+			Comment:     data.Comment,
+			Fingerprint: data.Fingerprint,
+			ExpiresAt:   handler.ExpiresAtPB(data.ExpiresAt),
+		}
+
+		return &resp, nil
+	}
+}
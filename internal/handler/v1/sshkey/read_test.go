@@ -0,0 +1,119 @@
+package sshkey
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCreadHandler(t *testing.T) {
+
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	decErr := errors.New("decrypt error")
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		userErr    error
+		decErr     error
+		readErr    error
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "read error",
+			wantStatus: codes.Internal,
+			readErr:    errors.New("read error"),
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			readErr:    storage.ErrNoContent,
+		},
+		{
+			name:       decErr.Error(),
+			wantStatus: codes.Internal,
+			decErr:     decErr,
+		},
+	}
+
+	for _, tcase := range tests {
+
+		req := pb.SshKeyReadRequest{
+			Name: "deploy",
+		}
+
+		pr := SshKeyReaderFunc(func(context.Context, string, string) (res storage.SshKeyData, err error) {
+			err = tcase.readErr
+			if err == nil {
+				res = storage.SshKeyData{
+					ID:          1,
+					UserID:      "user_id",
+					Name:        "deploy",
+					Type:        "rsa",
+					Bits:        3072,
+					PublicKey:   "ssh-rsa AAAA deploy@ci",
+					PrivateKey:  []byte("private"),
+					Comment:     "deploy@ci",
+					Fingerprint: "SHA256:abc",
+					ExpiresAt:   &expiresAt,
+				}
+			}
+			return
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		dec := crypt.DecryptFunc(func(text []byte) ([]byte, error) {
+			return text, tcase.decErr
+		})
+
+		resp, err := NewGRPCReadHandler(pr, getUserID, dec)(context.Background(), &req)
+
+		t.Run(tcase.name, func(t *testing.T) {
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+
+				assert.Equal(t, int64(1), resp.Id)
+				assert.Equal(t, "deploy", resp.Name)
+				assert.Equal(t, pb.SshKeyType_SSH_KEY_TYPE_RSA, resp.Type)
+				assert.Equal(t, uint32(3072), resp.Bits)
+				assert.Equal(t, "ssh-rsa AAAA deploy@ci", resp.PublicKey)
+				assert.Equal(t, "private", resp.PrivateKey)
+				assert.Equal(t, "deploy@ci", resp.Comment)
+				assert.Equal(t, "SHA256:abc", resp.Fingerprint)
+				assert.True(t, expiresAt.Equal(resp.ExpiresAt.AsTime()))
+
+			} else {
+				assert.Error(t, err)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+
+	}
+
+}
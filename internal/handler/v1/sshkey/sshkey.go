@@ -0,0 +1,3 @@
+// Package sshkey ручка работы с ключами SSH: закрытый ключ шифруется,
+// ключи создаются сервером или импортируются
+package sshkey
@@ -0,0 +1,66 @@
+package sshkey
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+type SshKeyUpdater interface {
+	SshKeyUpdate(ctx context.Context, data storage.SshKeyData) error
+}
+
+type SshKeyUpdaterFunc func(ctx context.Context, data storage.SshKeyData) error
+
+func (f SshKeyUpdaterFunc) SshKeyUpdate(ctx context.Context, data storage.SshKeyData) error {
+	return f(ctx, data)
+}
+
+var _ SshKeyUpdater = SshKeyUpdaterFunc(nil)
+
+type GRPCUpdateHandler func(context.Context, *pb.SshKeyUpdateRequest) (*empty.Empty, error)
+
+// NewGRPCUpdateHandler - функция конструктор ручки для обновления ключа SSH
+func NewGRPCUpdateHandler(u SshKeyUpdater, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher) GRPCUpdateHandler {
+	return func(ctx context.Context, in *pb.SshKeyUpdateRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		upd, err := handler.SshKeyData(userID, in.Id, in.Write, enc)
+		if errors.Is(err, handler.ErrSshKeyParams) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err != nil {
+			logger.Errorf("encrypt ssh key error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = u.SshKeyUpdate(ctx, upd)
+		if err != nil {
+			if errors.Is(err, storage.ErrWriteConflict) {
+				return nil, status.Error(codes.AlreadyExists, err.Error())
+			}
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("ssh key update error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, upd.UserID, storage.KindSshKey, upd.Name, broker.ActionUpdate)
+		return &empty.Empty{}, nil
+	}
+}
@@ -0,0 +1,127 @@
+package sshkey
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/sshkey"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCUpdateHandler(t *testing.T) {
+
+	encErr := errors.New("encrypt error")
+	key, err := sshkey.Generate(sshkey.TypeEd25519, 0, "")
+	require.NoError(t, err)
+	private, err := key.MarshalPrivate()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		userErr    error
+		ecnErr     error
+		updErr     error
+		privateKey string
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "update error",
+			wantStatus: codes.Internal,
+			updErr:     errors.New("update error"),
+		},
+		{
+			name:       "already exists",
+			wantStatus: codes.AlreadyExists,
+			updErr:     storage.ErrWriteConflict,
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			updErr:     storage.ErrNoContent,
+		},
+		{
+			name:       encErr.Error(),
+			wantStatus: codes.Internal,
+			ecnErr:     encErr,
+		},
+		{
+			name:       "invalid key",
+			wantStatus: codes.InvalidArgument,
+			privateKey: "not a key",
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		req := pb.SshKeyUpdateRequest{
+			Id: 1,
+			Write: &pb.SshKeyWriteRequest{
+				Name:       "deploy",
+				PrivateKey: string(private),
+				Comment:    "deploy@prod",
+			},
+		}
+		if tcase.privateKey != "" {
+			req.Write.PrivateKey = tcase.privateKey
+		}
+
+		var updated storage.SshKeyData
+		pu := SshKeyUpdaterFunc(func(_ context.Context, data storage.SshKeyData) error {
+			updated = data
+			return tcase.updErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		enc := crypt.EncryptFunc(func(text []byte) ([]byte, error) {
+			return text, tcase.ecnErr
+		})
+
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := NewGRPCUpdateHandler(pu, getUserID, enc, pub)(context.Background(), &req)
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, broker.ActionUpdate, events[0].Action)
+				assert.Equal(t, int64(1), updated.ID)
+				assert.Equal(t, "deploy@prod", updated.Comment)
+				assert.Equal(t, key.Fingerprint(), updated.Fingerprint)
+			} else {
+				assert.Error(t, err)
+				assert.Empty(t, events)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
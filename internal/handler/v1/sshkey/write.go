@@ -0,0 +1,67 @@
+package sshkey
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+)
+
+type SshKeyWritter interface {
+	SshKeyWrite(ctx context.Context, data storage.SshKeyData) error
+}
+
+type SshKeyWritterFunc func(ctx context.Context, data storage.SshKeyData) error
+
+func (f SshKeyWritterFunc) SshKeyWrite(ctx context.Context, data storage.SshKeyData) error {
+	return f(ctx, data)
+}
+
+var _ SshKeyWritter = SshKeyWritterFunc(nil)
+
+type GRPCWriteHandler func(ctx context.Context, in *pb.SshKeyWriteRequest) (*empty.Empty, error)
+
+// NewGRPCWriteHandler - функция-конструктор ручки записи ключа SSH
+func NewGRPCWriteHandler(w SshKeyWritter, getUserID handler.GetUserIDFunc, enc crypt.Encryptor, pub broker.Publisher, quota *handler.Quota) GRPCWriteHandler {
+	return func(ctx context.Context, in *pb.SshKeyWriteRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = quota.CheckItems(ctx, userID, storage.KindSshKey, 1); err != nil {
+			return nil, err
+		}
+
+		write, err := handler.SshKeyData(userID, 0, in, enc)
+		if errors.Is(err, handler.ErrSshKeyParams) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err != nil {
+			logger.Errorf("encrypt ssh key error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = w.SshKeyWrite(ctx, write)
+		if err != nil {
+			if errors.Is(err, storage.ErrWriteConflict) {
+				return nil, status.Error(codes.AlreadyExists, err.Error())
+			}
+			logger.Errorf("write ssh key error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, write.UserID, storage.KindSshKey, write.Name, broker.ActionCreate)
+		return &empty.Empty{}, nil
+	}
+}
@@ -0,0 +1,121 @@
+package sshkey
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	crypt "github.com/eugene982/yp-gophkeeper/internal/crypto"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCWriteHandler(t *testing.T) {
+
+	encErr := errors.New("encrypt error")
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		userErr    error
+		ecnErr     error
+		writeErr   error
+		bits       uint32
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "write error",
+			wantStatus: codes.Internal,
+			writeErr:   errors.New("write error"),
+		},
+		{
+			name:       "already exists",
+			wantStatus: codes.AlreadyExists,
+			writeErr:   storage.ErrWriteConflict,
+		},
+		{
+			name:       encErr.Error(),
+			wantStatus: codes.Internal,
+			ecnErr:     encErr,
+		},
+		{
+			name:       "invalid bits",
+			wantStatus: codes.InvalidArgument,
+			bits:       512,
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		expiresAt := time.Now().Add(time.Hour).UTC()
+		req := pb.SshKeyWriteRequest{
+			Name:      "deploy",
+			Generate:  true,
+			Type:      pb.SshKeyType_SSH_KEY_TYPE_ECDSA,
+			Bits:      tcase.bits,
+			Comment:   "deploy@ci",
+			ExpiresAt: timestamppb.New(expiresAt),
+		}
+
+		var written storage.SshKeyData
+		pw := SshKeyWritterFunc(func(_ context.Context, data storage.SshKeyData) error {
+			written = data
+			return tcase.writeErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		enc := crypt.EncryptFunc(func(text []byte) ([]byte, error) {
+			return text, tcase.ecnErr
+		})
+
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := NewGRPCWriteHandler(pw, getUserID, enc, pub, nil)(context.Background(), &req)
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, broker.ActionCreate, events[0].Action)
+				assert.Equal(t, storage.KindSshKey, events[0].Kind)
+				assert.Equal(t, "ecdsa", written.Type)
+				assert.Equal(t, 256, written.Bits)
+				assert.Regexp(t, `^ecdsa-sha2-nistp256 \S+ deploy@ci$`, written.PublicKey)
+				assert.Contains(t, string(written.PrivateKey), "OPENSSH PRIVATE KEY")
+				require.NotNil(t, written.ExpiresAt)
+				assert.True(t, expiresAt.Equal(*written.ExpiresAt))
+			} else {
+				assert.Error(t, err)
+				assert.Empty(t, events)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
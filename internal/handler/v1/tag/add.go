@@ -0,0 +1,52 @@
+package tag
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type TagAdder interface {
+	TagAdd(ctx context.Context, kind, userID, name string, tags []string) error
+}
+
+type TagAdderFunc func(ctx context.Context, kind, userID, name string, tags []string) error
+
+func (f TagAdderFunc) TagAdd(ctx context.Context, kind, userID, name string, tags []string) error {
+	return f(ctx, kind, userID, name, tags)
+}
+
+var _ TagAdder = TagAdderFunc(nil)
+
+type GRPCAddHandler func(ctx context.Context, in *pb.TagRequest) (*empty.Empty, error)
+
+// NewGRPCAddHandler - функция-конструктор ручки добавления меток
+func NewGRPCAddHandler(t TagAdder, getUserID handler.GetUserIDFunc, pub broker.Publisher) GRPCAddHandler {
+	return func(ctx context.Context, in *pb.TagRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = t.TagAdd(ctx, in.Kind, userID, in.Name, in.Tags)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("tag add error: %w", err, "kind", in.Kind)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, userID, in.Kind, in.Name, broker.ActionUpdate)
+		return &empty.Empty{}, nil
+	}
+}
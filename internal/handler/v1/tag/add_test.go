@@ -0,0 +1,90 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGRPCAddHandler(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		userErr    error
+		tagErr     error
+	}{
+		{
+			name: "ok",
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "not found",
+			wantStatus: codes.NotFound,
+			tagErr:     storage.ErrNoContent,
+		},
+		{
+			name:       "tag error",
+			wantStatus: codes.Internal,
+			tagErr:     errors.New("tag error"),
+		},
+	}
+
+	for _, tcase := range tests {
+
+		var events []broker.Event
+		pub := broker.PublisherFunc(func(_ context.Context, e broker.Event) error {
+			events = append(events, e)
+			return nil
+		})
+
+		var gotTags []string
+		tg := TagAdderFunc(func(_ context.Context, kind, userID, name string, tags []string) error {
+			gotTags = tags
+			return tcase.tagErr
+		})
+
+		getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+			if tcase.userErr != nil {
+				return "", tcase.userErr
+			}
+			return "user", nil
+		})
+
+		req := pb.TagRequest{
+			Kind: storage.KindNote,
+			Name: "name",
+			Tags: []string{"work", "todo"},
+		}
+
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := NewGRPCAddHandler(tg, getUserID, pub)(context.Background(), &req)
+			if tcase.wantStatus == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, req.Tags, gotTags)
+				require.Len(t, events, 1)
+				assert.Equal(t, broker.ActionUpdate, events[0].Action)
+			} else {
+				assert.Error(t, err)
+				assert.Empty(t, events)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
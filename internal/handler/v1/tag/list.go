@@ -0,0 +1,45 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+)
+
+type TagListGetter interface {
+	TagList(ctx context.Context, userID string) ([]string, error)
+}
+
+type TagListGetterFunc func(ctx context.Context, userID string) ([]string, error)
+
+func (f TagListGetterFunc) TagList(ctx context.Context, userID string) ([]string, error) {
+	return f(ctx, userID)
+}
+
+var _ TagListGetter = TagListGetterFunc(nil)
+
+type GRPCListHandler func(ctx context.Context, in *empty.Empty) (*pb.TagListResponse, error)
+
+// NewGRPCListHandler - функция-конструктор ручки получения списка меток
+func NewGRPCListHandler(g TagListGetter, getUserID handler.GetUserIDFunc) GRPCListHandler {
+	return func(ctx context.Context, in *empty.Empty) (*pb.TagListResponse, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp pb.TagListResponse
+		resp.Tags, err = g.TagList(ctx, userID)
+		if err != nil {
+			logger.Errorf("tag list error: %w", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &resp, nil
+	}
+}
@@ -0,0 +1,52 @@
+package tag
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+type TagRemover interface {
+	TagRemove(ctx context.Context, kind, userID, name string, tags []string) error
+}
+
+type TagRemoverFunc func(ctx context.Context, kind, userID, name string, tags []string) error
+
+func (f TagRemoverFunc) TagRemove(ctx context.Context, kind, userID, name string, tags []string) error {
+	return f(ctx, kind, userID, name, tags)
+}
+
+var _ TagRemover = TagRemoverFunc(nil)
+
+type GRPCRemoveHandler func(ctx context.Context, in *pb.TagRequest) (*empty.Empty, error)
+
+// NewGRPCRemoveHandler - функция-конструктор ручки снятия меток
+func NewGRPCRemoveHandler(t TagRemover, getUserID handler.GetUserIDFunc, pub broker.Publisher) GRPCRemoveHandler {
+	return func(ctx context.Context, in *pb.TagRequest) (*empty.Empty, error) {
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = t.TagRemove(ctx, in.Kind, userID, in.Name, in.Tags)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoContent) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			logger.Errorf("tag remove error: %w", err, "kind", in.Kind)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		handler.Notify(ctx, pub, userID, in.Kind, in.Name, broker.ActionUpdate)
+		return &empty.Empty{}, nil
+	}
+}
@@ -0,0 +1,2 @@
+// Package tag ручки работы с метками элементов
+package tag
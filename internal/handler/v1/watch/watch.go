@@ -0,0 +1,48 @@
+// Package watch ручка подписки на изменения хранилища
+package watch
+
+import (
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+	"github.com/eugene982/yp-gophkeeper/internal/logger"
+)
+
+type GRPCWatchHandler func(*empty.Empty, pb.GophKeeper_WatchServer) error
+
+// NewGRPCWatchHandler - функция-конструктор ручки потоковой подписки на события.
+// Поток завершается при отключении клиента.
+func NewGRPCWatchHandler(s broker.Subscriber, getUserID handler.GetUserIDFunc) GRPCWatchHandler {
+	return func(_ *empty.Empty, server pb.GophKeeper_WatchServer) error {
+		ctx := server.Context()
+
+		userID, err := getUserID(ctx)
+		if err != nil {
+			return err
+		}
+
+		events, err := s.Subscribe(ctx, userID)
+		if err != nil {
+			logger.Errorf("watch subscribe error: %w", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		var resp pb.WatchEvent
+		for e := range events {
+			resp.Kind = e.Kind
+			resp.Name = e.Name
+			resp.Action = e.Action
+			resp.Revision = e.Revision
+			resp.OldName = e.OldName
+
+			if err = server.Send(&resp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,111 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/eugene982/yp-gophkeeper/gen/go/proto/v1"
+	"github.com/eugene982/yp-gophkeeper/internal/broker"
+	"github.com/eugene982/yp-gophkeeper/internal/handler"
+)
+
+type watchServer struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sent    []*pb.WatchEvent
+	sendErr error
+}
+
+func (s *watchServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchServer) Send(e *pb.WatchEvent) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, proto.Clone(e).(*pb.WatchEvent))
+	return nil
+}
+
+func TestGRPCWatchHandler(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		wantStatus codes.Code
+		wantSent   int
+		userErr    error
+		subErr     error
+		sendErr    error
+	}{
+		{
+			name:     "ok",
+			wantSent: 2,
+		},
+		{
+			name:       "unauthenticated",
+			wantStatus: codes.Unauthenticated,
+			userErr:    handler.ErrRPCInvalidToken,
+		},
+		{
+			name:       "subscribe error",
+			wantStatus: codes.Internal,
+			subErr:     errors.New("subscribe error"),
+		},
+		{
+			name:       "send error",
+			wantStatus: codes.Unavailable,
+			sendErr:    status.Error(codes.Unavailable, "send error"),
+		},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+
+			sub := broker.SubscriberFunc(func(ctx context.Context, userID string) (<-chan broker.Event, error) {
+				if tcase.subErr != nil {
+					return nil, tcase.subErr
+				}
+				ch := make(chan broker.Event, 2)
+				ch <- broker.Event{UserID: userID, Kind: "note", Name: "n1", Action: broker.ActionCreate, Revision: 1}
+				ch <- broker.Event{UserID: userID, Kind: "note", Name: "n1", Action: broker.ActionDelete, Revision: 2}
+				close(ch)
+				return ch, nil
+			})
+
+			getUserID := handler.GetUserIDFunc(func(context.Context) (string, error) {
+				if tcase.userErr != nil {
+					return "", tcase.userErr
+				}
+				return "user", nil
+			})
+
+			server := &watchServer{
+				ctx:     context.Background(),
+				sendErr: tcase.sendErr,
+			}
+
+			err := NewGRPCWatchHandler(sub, getUserID)(nil, server)
+			if tcase.wantStatus == 0 {
+				require.NoError(t, err)
+				require.Len(t, server.sent, tcase.wantSent)
+				assert.Equal(t, "n1", server.sent[0].Name)
+				assert.Equal(t, broker.ActionDelete, server.sent[1].Action)
+				assert.Equal(t, int64(2), server.sent[1].Revision)
+			} else {
+				assert.Error(t, err)
+				status, ok := status.FromError(err)
+				require.Equal(t, true, ok)
+				assert.Equal(t, tcase.wantStatus, status.Code())
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Package otp одноразовые пароли: по времени (TOTP, RFC 6238)
+// и по счётчику (HOTP, RFC 4226)
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Виды одноразовых паролей
+const (
+	TypeTOTP = "totp"
+	TypeHOTP = "hotp"
+)
+
+// Алгоритмы HMAC
+const (
+	SHA1   = "SHA1"
+	SHA256 = "SHA256"
+	SHA512 = "SHA512"
+)
+
+// Значения параметров по умолчанию
+const (
+	DefaultDigits = 6
+	DefaultPeriod = 30 * time.Second
+)
+
+var algorithms = map[string]func() hash.Hash{
+	SHA1:   sha1.New,
+	SHA256: sha256.New,
+	SHA512: sha512.New,
+}
+
+// Params параметры генерации паролей
+type Params struct {
+	Type      string
+	Issuer    string
+	Account   string
+	Secret    []byte
+	Algorithm string
+	Digits    int
+	Period    time.Duration // шаг TOTP
+	Counter   uint64        // счётчик следующего пароля HOTP
+}
+
+// Parse разбор ссылки otpauth://totp/... или otpauth://hotp/...,
+// строка без схемы считается секретом TOTP с параметрами по умолчанию
+func Parse(s string) (Params, error) {
+	p := Params{
+		Type:      TypeTOTP,
+		Algorithm: SHA1,
+		Digits:    DefaultDigits,
+		Period:    DefaultPeriod,
+	}
+
+	if !strings.HasPrefix(s, "otpauth://") {
+		secret, err := DecodeSecret(s)
+		p.Secret = secret
+		return p, err
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return p, err
+	}
+	p.Type = u.Host
+
+	// метка вида "Issuer:account" или "account"
+	label := strings.TrimPrefix(u.Path, "/")
+	if i := strings.Index(label, ":"); i >= 0 {
+		p.Issuer, label = label[:i], label[i+1:]
+	}
+	p.Account = strings.TrimSpace(label)
+
+	q := u.Query()
+	if v := q.Get("issuer"); v != "" {
+		p.Issuer = v
+	}
+	if v := q.Get("algorithm"); v != "" {
+		p.Algorithm = strings.ToUpper(v)
+	}
+	if v := q.Get("digits"); v != "" {
+		if p.Digits, err = strconv.Atoi(v); err != nil {
+			return p, fmt.Errorf("invalid digits %q", v)
+		}
+	}
+	if v := q.Get("period"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return p, fmt.Errorf("invalid period %q", v)
+		}
+		p.Period = time.Duration(n) * time.Second
+	}
+	if v := q.Get("counter"); v != "" {
+		if p.Counter, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return p, fmt.Errorf("invalid counter %q", v)
+		}
+	}
+
+	if p.Secret, err = DecodeSecret(q.Get("secret")); err != nil {
+		return p, err
+	}
+	return p, p.Validate()
+}
+
+// DecodeSecret секрет в base32, пробелы, регистр и выравнивание
+// не учитываются
+func DecodeSecret(s string) ([]byte, error) {
+	s = strings.TrimRight(strings.ToUpper(strings.ReplaceAll(s, " ", "")), "=")
+	if s == "" {
+		return nil, errors.New("empty otp secret")
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(s)
+}
+
+// Validate проверка параметров генерации
+func (p Params) Validate() error {
+	switch {
+	case p.Type != TypeTOTP && p.Type != TypeHOTP:
+		return fmt.Errorf("unsupported otp type %q", p.Type)
+	case algorithms[p.Algorithm] == nil:
+		return fmt.Errorf("unsupported algorithm %q", p.Algorithm)
+	case p.Digits < 6 || p.Digits > 10:
+		return fmt.Errorf("invalid digits %d", p.Digits)
+	case p.Type == TypeTOTP && p.Period < time.Second:
+		return fmt.Errorf("invalid period %s", p.Period)
+	case len(p.Secret) == 0:
+		return errors.New("empty otp secret")
+	}
+	return nil
+}
+
+// HOTP пароль для значения счётчика
+func (p Params) HOTP(counter uint64) string {
+	alg := algorithms[p.Algorithm]
+	if alg == nil {
+		alg = sha1.New
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(alg, p.Secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// динамическое усечение, RFC 4226 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	code := int64(binary.BigEndian.Uint32(sum[offset:]) & 0x7fffffff)
+
+	mod := int64(1)
+	for i := 0; i < p.Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", p.Digits, code%mod)
+}
+
+// TOTP пароль на момент t и время, оставшееся до его смены
+func (p Params) TOTP(t time.Time) (string, time.Duration) {
+	period := int64(p.Period / time.Second)
+	if period <= 0 {
+		period = int64(DefaultPeriod / time.Second)
+	}
+	counter := t.Unix() / period
+	left := time.Duration((counter+1)*period-t.Unix()) * time.Second
+	return p.HOTP(uint64(counter)), left
+}
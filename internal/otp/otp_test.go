@@ -0,0 +1,80 @@
+package otp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTP(t *testing.T) {
+	// тестовые значения RFC 6238, приложение B
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "94287082"},
+		{unix: 1111111109, want: "07081804"},
+		{unix: 1234567890, want: "89005924"},
+		{unix: 2000000000, want: "69279037"},
+	}
+
+	p, err := Parse("otpauth://totp/test?digits=8&secret=" + secret)
+	require.NoError(t, err)
+	for _, tcase := range tests {
+		code, _ := p.TOTP(time.Unix(tcase.unix, 0))
+		assert.Equal(t, tcase.want, code)
+	}
+
+	_, left := p.TOTP(time.Unix(59, 0))
+	assert.Equal(t, time.Second, left)
+}
+
+func TestHOTP(t *testing.T) {
+	// тестовые значения RFC 4226, приложение D
+	p := Params{Secret: []byte("12345678901234567890"), Algorithm: SHA1, Digits: 6}
+	want := []string{"755224", "287082", "359152", "969429", "338314"}
+	for i, code := range want {
+		assert.Equal(t, code, p.HOTP(uint64(i)))
+	}
+}
+
+func TestParse(t *testing.T) {
+	p, err := Parse("jbsw y3dp ehpk 3pxp")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("Hello!\xde\xad\xbe\xef"), p.Secret)
+	assert.Equal(t, TypeTOTP, p.Type)
+	assert.Equal(t, DefaultDigits, p.Digits)
+	assert.Equal(t, DefaultPeriod, p.Period)
+
+	p, err = Parse("otpauth://totp/Example:alice%40example.com?secret=JBSWY3DPEHPK3PXP&period=60&algorithm=sha256")
+	require.NoError(t, err)
+	assert.Equal(t, "Example", p.Issuer)
+	assert.Equal(t, "alice@example.com", p.Account)
+	assert.Equal(t, SHA256, p.Algorithm)
+	assert.Equal(t, time.Minute, p.Period)
+
+	p, err = Parse("otpauth://hotp/alice?secret=JBSWY3DPEHPK3PXP&issuer=ACME&counter=42")
+	require.NoError(t, err)
+	assert.Equal(t, TypeHOTP, p.Type)
+	assert.Equal(t, "ACME", p.Issuer)
+	assert.Equal(t, "alice", p.Account)
+	assert.Equal(t, uint64(42), p.Counter)
+
+	for _, s := range []string{
+		"",
+		"not base32!",
+		"otpauth://totp/Example",
+		"otpauth://motp/Example?secret=JBSWY3DPEHPK3PXP",
+		"otpauth://totp/Example?secret=JBSWY3DPEHPK3PXP&algorithm=MD5",
+		"otpauth://totp/Example?secret=JBSWY3DPEHPK3PXP&digits=3",
+		"otpauth://totp/Example?secret=JBSWY3DPEHPK3PXP&period=0",
+		"otpauth://hotp/Example?secret=JBSWY3DPEHPK3PXP&counter=-1",
+	} {
+		_, err = Parse(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -0,0 +1,154 @@
+// Package sshkey ключи SSH: создание, импорт, открытый ключ и отпечаток
+package sshkey
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Виды ключей
+const (
+	TypeEd25519 = "ed25519"
+	TypeRSA     = "rsa"
+	TypeECDSA   = "ecdsa"
+)
+
+// Длины ключей по умолчанию и допустимые пределы RSA
+const (
+	DefaultRSABits   = 3072
+	DefaultECDSABits = 256
+	MinRSABits       = 2048
+	MaxRSABits       = 8192
+)
+
+// ErrPassphrase ключ зашифрован, а фраза-пароль не задана или неверна
+var ErrPassphrase = errors.New("private key passphrase required")
+
+var curves = map[int]elliptic.Curve{
+	256: elliptic.P256(),
+	384: elliptic.P384(),
+	521: elliptic.P521(),
+}
+
+// Key закрытый ключ с открытым ключом и комментарием
+type Key struct {
+	Type    string
+	Bits    int
+	Private crypto.Signer
+	Public  ssh.PublicKey
+	Comment string
+}
+
+// Generate создание ключа, bits 0 - длина по умолчанию,
+// для ed25519 не задаётся
+func Generate(typ string, bits int, comment string) (k Key, err error) {
+	var priv crypto.Signer
+
+	switch typ {
+	case TypeEd25519:
+		if bits != 0 && bits != 256 {
+			return k, fmt.Errorf("ed25519 key size is fixed")
+		}
+		_, priv, err = ed25519.GenerateKey(rand.Reader)
+	case TypeRSA:
+		if bits == 0 {
+			bits = DefaultRSABits
+		}
+		if bits < MinRSABits || bits > MaxRSABits {
+			return k, fmt.Errorf("rsa key size must be between %d and %d", MinRSABits, MaxRSABits)
+		}
+		priv, err = rsa.GenerateKey(rand.Reader, bits)
+	case TypeECDSA:
+		if bits == 0 {
+			bits = DefaultECDSABits
+		}
+		curve, ok := curves[bits]
+		if !ok {
+			return k, fmt.Errorf("ecdsa key size must be 256, 384 or 521")
+		}
+		priv, err = ecdsa.GenerateKey(curve, rand.Reader)
+	default:
+		return k, fmt.Errorf("unknown key type: %s", typ)
+	}
+	if err != nil {
+		return
+	}
+	return New(priv, comment)
+}
+
+// Parse импорт закрытого ключа в формате OpenSSH, PKCS#1, PKCS#8 или SEC 1.
+// Зашифрованный ключ расшифровывается фразой-паролем.
+func Parse(pemBytes, passphrase []byte) (k Key, err error) {
+	var raw any
+	if len(passphrase) == 0 {
+		raw, err = ssh.ParseRawPrivateKey(pemBytes)
+	} else {
+		raw, err = ssh.ParseRawPrivateKeyWithPassphrase(pemBytes, passphrase)
+	}
+
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) || errors.Is(err, x509.IncorrectPasswordError) {
+		return k, ErrPassphrase
+	} else if err != nil {
+		return
+	}
+
+	switch priv := raw.(type) {
+	case *ed25519.PrivateKey:
+		return New(*priv, "")
+	case crypto.Signer:
+		return New(priv, "")
+	}
+	return k, fmt.Errorf("unsupported private key %T", raw)
+}
+
+// New ключ по закрытому ключу ed25519, RSA или ECDSA
+func New(priv crypto.Signer, comment string) (k Key, err error) {
+	k = Key{Private: priv, Comment: comment}
+	switch p := priv.(type) {
+	case ed25519.PrivateKey:
+		k.Type, k.Bits = TypeEd25519, 256
+	case *rsa.PrivateKey:
+		k.Type, k.Bits = TypeRSA, p.N.BitLen()
+	case *ecdsa.PrivateKey:
+		k.Type, k.Bits = TypeECDSA, p.Curve.Params().BitSize
+	default:
+		return k, fmt.Errorf("unsupported private key %T", priv)
+	}
+	k.Public, err = ssh.NewPublicKey(priv.Public())
+	return
+}
+
+// MarshalPrivate закрытый ключ в формате OpenSSH без шифрования
+func (k Key) MarshalPrivate() ([]byte, error) {
+	block, err := ssh.MarshalPrivateKey(k.Private, k.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(block), nil
+}
+
+// AuthorizedKey открытый ключ строкой authorized_keys с комментарием
+func (k Key) AuthorizedKey() string {
+	line := strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(k.Public)), "\n")
+	if k.Comment != "" {
+		line += " " + k.Comment
+	}
+	return line
+}
+
+// Fingerprint отпечаток SHA256 открытого ключа, как у ssh-keygen -l
+func (k Key) Fingerprint() string {
+	return ssh.FingerprintSHA256(k.Public)
+}
@@ -0,0 +1,75 @@
+package sshkey
+
+import (
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		typ      string
+		bits     int
+		wantBits int
+		wantAlgo string
+		wantErr  bool
+	}{
+		{name: "ed25519", typ: TypeEd25519, wantBits: 256, wantAlgo: ssh.KeyAlgoED25519},
+		{name: "rsa", typ: TypeRSA, bits: MinRSABits, wantBits: MinRSABits, wantAlgo: ssh.KeyAlgoRSA},
+		{name: "ecdsa default", typ: TypeECDSA, wantBits: 256, wantAlgo: ssh.KeyAlgoECDSA256},
+		{name: "ecdsa 384", typ: TypeECDSA, bits: 384, wantBits: 384, wantAlgo: ssh.KeyAlgoECDSA384},
+		{name: "short rsa", typ: TypeRSA, bits: 1024, wantErr: true},
+		{name: "ecdsa wrong curve", typ: TypeECDSA, bits: 512, wantErr: true},
+		{name: "ed25519 bits", typ: TypeEd25519, bits: 4096, wantErr: true},
+		{name: "unknown type", typ: "dsa", wantErr: true},
+	}
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			k, err := Generate(tcase.typ, tcase.bits, "user@host")
+			if tcase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.typ, k.Type)
+			assert.Equal(t, tcase.wantBits, k.Bits)
+			assert.Equal(t, tcase.wantAlgo, k.Public.Type())
+			assert.True(t, strings.HasPrefix(k.AuthorizedKey(), tcase.wantAlgo+" "))
+			assert.True(t, strings.HasSuffix(k.AuthorizedKey(), " user@host"))
+			assert.True(t, strings.HasPrefix(k.Fingerprint(), "SHA256:"))
+
+			// закрытый ключ разбирается обратно в тот же ключ
+			data, err := k.MarshalPrivate()
+			require.NoError(t, err)
+			parsed, err := Parse(data, nil)
+			require.NoError(t, err)
+			assert.Equal(t, k.Type, parsed.Type)
+			assert.Equal(t, k.Fingerprint(), parsed.Fingerprint())
+		})
+	}
+}
+
+func TestParsePassphrase(t *testing.T) {
+	k, err := Generate(TypeEd25519, 0, "")
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKeyWithPassphrase(k.Private, "", []byte("secret"))
+	require.NoError(t, err)
+	data := pem.EncodeToMemory(block)
+
+	_, err = Parse(data, nil)
+	assert.ErrorIs(t, err, ErrPassphrase)
+	_, err = Parse(data, []byte("wrong"))
+	assert.ErrorIs(t, err, ErrPassphrase)
+
+	parsed, err := Parse(data, []byte("secret"))
+	require.NoError(t, err)
+	assert.Equal(t, k.Fingerprint(), parsed.Fingerprint())
+
+	_, err = Parse([]byte("not a key"), nil)
+	assert.Error(t, err)
+}
@@ -1,11 +1,128 @@
 package storage
 
-import "time"
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Виды хранимых данных
+const (
+	KindPassword = "password"
+	KindCard     = "card"
+	KindNote     = "note"
+	KindBinary   = "binary"
+	KindItem     = "item"
+	KindOtp      = "otp"
+	KindSshKey   = "ssh"
+)
+
+// Типы полей произвольных элементов
+const (
+	FieldText   = "text"
+	FieldSecret = "secret"
+	FieldURL    = "url"
+	FieldEmail  = "email"
+	FieldDate   = "date"
+	FieldOTP    = "otp"
+)
+
+// Операции пакетной записи
+const (
+	OpCreate = "create"
+	OpUpdate = "update"
+	OpDelete = "delete"
+)
+
+// BatchOp операция пакетной записи. Для создания и обновления в Data
+// передаётся PasswordData, CardData, NoteData, ItemData, OtpData или
+// SshKeyData, для удаления достаточно вида и наименования.
+type BatchOp struct {
+	Action string
+	Kind   string
+	Name   string
+	Data   any
+}
+
+// Поля сортировки списков
+const (
+	SortName    = "name"
+	SortCreated = "created"
+	SortUpdated = "updated"
+)
+
+// ListQuery параметры выборки списка. After - последняя запись
+// предыдущей страницы, выборка продолжается после неё.
+type ListQuery struct {
+	UserID    string
+	Limit     int
+	Sort      string
+	Desc      bool
+	Prefix    string
+	Glob      string
+	Tag       string
+	Folder    string // путь папки, "/" - корень, пусто - без фильтра
+	Recursive bool   // вместе с вложенными папками
+	After     *ListEntry
+}
+
+// ListEntry элемент списка
+type ListEntry struct {
+	ID        int64      `db:"id"`
+	Name      string     `db:"name"`
+	Size      int64      `db:"size"`
+	Folder    string     `db:"folder"`
+	Tags      Tags       `db:"tags"`
+	ExpiresAt *time.Time `db:"expires_at"` // время удаления, nil - бессрочно
+	CtreatAt  time.Time  `db:"create_at"`
+	UpdateAt  time.Time  `db:"update_at"`
+}
+
+// Tags метки элемента, в базе хранятся строкой через запятую
+type Tags []string
+
+// Scan реализация sql.Scanner
+func (t *Tags) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("unsupported tags type %T", src)
+	}
+	if s == "" {
+		*t = nil
+		return nil
+	}
+	*t = strings.Split(s, ",")
+	return nil
+}
+
+// SearchQuery параметры поиска, пустой Kinds - все виды данных
+type SearchQuery struct {
+	UserID string
+	Text   string
+	Limit  int
+	Kinds  []string
+}
+
+// SearchResult найденный элемент
+type SearchResult struct {
+	Kind    string  `db:"kind"`
+	Name    string  `db:"name"`
+	Score   float64 `db:"score"`
+	Matched string  `db:"matched"`
+}
 
 // UserData структура пользователя
 type UserData struct {
 	UserID       string    `db:"user_id"`
 	PasswordHash string    `db:"passwd_hash"`
+	Revision     int64     `db:"revision"`
 	CtreatAt     time.Time `db:"create_at"`
 	UpdateAt     time.Time `db:"update_at"`
 }
@@ -18,58 +135,209 @@ type ListData struct {
 	CardsCount     int       `db:"cards_count"`
 	BinariesCount  int       `db:"binaries_count"`
 	PasswordsCount int       `db:"passwords_count"`
+	ItemsCount     int       `db:"items_count"`
+	OtpsCount      int       `db:"otps_count"`
+	SshKeysCount   int       `db:"ssh_keys_count"`
+	BinariesSize   int64     `db:"binaries_size"` // объём файлов в байтах
 	CtreatAt       time.Time `db:"create_at"`
 	UpdateAt       time.Time `db:"update_at"`
 }
 
+// Count количество элементов вида kind
+func (d ListData) Count(kind string) int {
+	switch kind {
+	case KindPassword:
+		return d.PasswordsCount
+	case KindCard:
+		return d.CardsCount
+	case KindNote:
+		return d.NotesCount
+	case KindBinary:
+		return d.BinariesCount
+	case KindItem:
+		return d.ItemsCount
+	case KindOtp:
+		return d.OtpsCount
+	case KindSshKey:
+		return d.SshKeysCount
+	}
+	return 0
+}
+
 // PasswordData хранимая информация о паролях
 type PasswordData struct {
-	ID       int64     `db:"id"`
-	UserID   string    `db:"user_id"`
-	Name     string    `db:"name"`
-	Username []byte    `db:"username"`
-	Password []byte    `db:"password"`
-	Notes    []byte    `db:"notes"`
-	CtreatAt time.Time `db:"create_at"`
-	UpdateAt time.Time `db:"update_at"`
+	ID             int64      `db:"id"`
+	UserID         string     `db:"user_id"`
+	Name           string     `db:"name"`
+	Username       []byte     `db:"username"`
+	Password       []byte     `db:"password"`
+	Notes          []byte     `db:"notes"`
+	URL            string     `db:"url"`
+	SearchUsername string     `db:"search_username"` // открытое имя пользователя для поиска
+	FolderID       *int64     `db:"folder_id"`
+	ExpiresAt      *time.Time `db:"expires_at"` // время удаления, nil - бессрочно
+	CtreatAt       time.Time  `db:"create_at"`
+	UpdateAt       time.Time  `db:"update_at"`
 }
 
 // CardData информация о различных картах
 type CardData struct {
-	ID       int64     `db:"id"`
-	UserID   string    `db:"user_id"`
-	Name     string    `db:"name"`
-	Number   []byte    `db:"number"`
-	Pin      []byte    `db:"pin"`
-	Notes    []byte    `db:"notes"`
-	CtreatAt time.Time `db:"create_at"`
-	UpdateAt time.Time `db:"update_at"`
+	ID        int64      `db:"id"`
+	UserID    string     `db:"user_id"`
+	Name      string     `db:"name"`
+	Number    []byte     `db:"number"`
+	Pin       []byte     `db:"pin"`
+	Notes     []byte     `db:"notes"`
+	FolderID  *int64     `db:"folder_id"`
+	ExpiresAt *time.Time `db:"expires_at"` // время удаления, nil - бессрочно
+	CtreatAt  time.Time  `db:"create_at"`
+	UpdateAt  time.Time  `db:"update_at"`
 }
 
 // NoteData различные заметки, текст
 type NoteData struct {
-	ID       int64     `db:"id"`
-	UserID   string    `db:"user_id"`
-	Name     string    `db:"name"`
-	Notes    []byte    `db:"notes"`
-	CtreatAt time.Time `db:"create_at"`
-	UpdateAt time.Time `db:"update_at"`
+	ID        int64      `db:"id"`
+	UserID    string     `db:"user_id"`
+	Name      string     `db:"name"`
+	Notes     []byte     `db:"notes"`
+	FolderID  *int64     `db:"folder_id"`
+	ExpiresAt *time.Time `db:"expires_at"` // время удаления, nil - бессрочно
+	CtreatAt  time.Time  `db:"create_at"`
+	UpdateAt  time.Time  `db:"update_at"`
+}
+
+// ItemData произвольный элемент с типом, заданным пользователем
+type ItemData struct {
+	ID        int64       `db:"id"`
+	UserID    string      `db:"user_id"`
+	Name      string      `db:"name"`
+	Type      string      `db:"item_type"`
+	Fields    []ItemField `db:"-"` // хранятся в item_fields по порядку
+	FolderID  *int64      `db:"folder_id"`
+	ExpiresAt *time.Time  `db:"expires_at"` // время удаления, nil - бессрочно
+	CtreatAt  time.Time   `db:"create_at"`
+	UpdateAt  time.Time   `db:"update_at"`
+}
+
+// ItemField поле произвольного элемента
+type ItemField struct {
+	Label string `db:"label"`
+	Type  string `db:"field_type"`
+	Value []byte `db:"value"` // зашифровано отдельно от других полей
+}
+
+// OtpData секрет одноразовых паролей. Параметры генерации хранятся
+// открыто, счётчик HOTP увеличивается при каждом получении пароля.
+type OtpData struct {
+	ID        int64      `db:"id"`
+	UserID    string     `db:"user_id"`
+	Name      string     `db:"name"`
+	Issuer    string     `db:"issuer"`
+	Account   []byte     `db:"account"`
+	Secret    []byte     `db:"secret"`
+	Type      string     `db:"otp_type"` // totp или hotp
+	Algorithm string     `db:"algorithm"`
+	Digits    int        `db:"digits"`
+	Period    int        `db:"period"`  // шаг TOTP в секундах
+	Counter   int64      `db:"counter"` // счётчик следующего пароля HOTP
+	FolderID  *int64     `db:"folder_id"`
+	ExpiresAt *time.Time `db:"expires_at"` // время удаления, nil - бессрочно
+	CtreatAt  time.Time  `db:"create_at"`
+	UpdateAt  time.Time  `db:"update_at"`
+}
+
+// SshKeyData ключ SSH. Зашифрован только закрытый ключ, открытый ключ,
+// комментарий и отпечаток хранятся открыто и участвуют в поиске.
+type SshKeyData struct {
+	ID          int64      `db:"id"`
+	UserID      string     `db:"user_id"`
+	Name        string     `db:"name"`
+	Type        string     `db:"key_type"` // ed25519, rsa или ecdsa
+	Bits        int        `db:"bits"`
+	PublicKey   string     `db:"public_key"` // строка authorized_keys
+	PrivateKey  []byte     `db:"private_key"`
+	Comment     string     `db:"comment"`
+	Fingerprint string     `db:"fingerprint"` // SHA256 открытого ключа
+	FolderID    *int64     `db:"folder_id"`
+	ExpiresAt   *time.Time `db:"expires_at"` // время удаления, nil - бессрочно
+	CtreatAt    time.Time  `db:"create_at"`
+	UpdateAt    time.Time  `db:"update_at"`
 }
 
 // BinaryData двоичные данные, файлы
 type BinaryData struct {
-	ID       int64     `db:"id"`
-	UserID   string    `db:"user_id"`
-	Name     string    `db:"name"`
-	Size     int64     `db:"size"`
-	Notes    []byte    `db:"notes"`
-	BinID    int64     `db:"bin_id"`
-	CtreatAt time.Time `db:"create_at"`
-	UpdateAt time.Time `db:"update_at"`
-}
-
-type BinaryChunk struct {
-	BinID  int64  `db:"bin_id"`
-	Offset int64  `db:"offset"`
-	Chunk  []byte `db:"chunk"`
+	ID          int64      `db:"id"`
+	UserID      string     `db:"user_id"`
+	Name        string     `db:"name"`
+	Size        int64      `db:"size"`
+	Notes       []byte     `db:"notes"`
+	BinID       int64      `db:"bin_id"`
+	Uploaded    bool       `db:"uploaded"`     // загрузка содержимого завершена
+	SHA256      []byte     `db:"sha256"`       // дайджест загруженного содержимого
+	HashState   []byte     `db:"hash_state"`   // состояние хеша незавершённой загрузки
+	FileKey     []byte     `db:"file_key"`     // зашифрованный ключ содержимого, пусто - без шифрования
+	Compression string     `db:"compression"`  // сжатие содержимого, пусто - без сжатия
+	Committed   int64      `db:"committed"`    // записано открытых данных
+	StoredSize  int64      `db:"stored_size"`  // занимаемый содержимым объём
+	ContentType string     `db:"content_type"` // MIME тип содержимого
+	Filename    string     `db:"filename"`     // исходное имя файла у клиента
+	MTime       *time.Time `db:"mtime"`        // исходное время изменения файла
+	Mode        uint32     `db:"mode"`         // исходные права доступа к файлу
+	FolderID    *int64     `db:"folder_id"`
+	ExpiresAt   *time.Time `db:"expires_at"` // время удаления, nil - бессрочно
+	CtreatAt    time.Time  `db:"create_at"`
+	UpdateAt    time.Time  `db:"update_at"`
+}
+
+// GCReport результат сборки мусора
+type GCReport struct {
+	Objects  int   // большие объекты без ссылок из binaries
+	Binaries int   // файлы, загрузка которых не завершилась
+	Parts    int   // части несобранных параллельных загрузок
+	Expired  int   // элементы с истёкшим сроком хранения
+	Bytes    int64 // освобождаемый объём
+}
+
+// UploadStatus состояние загрузки содержимого файла
+type UploadStatus struct {
+	Committed   int64  `db:"committed"`   // записано открытых данных
+	StoredSize  int64  `db:"stored_size"` // занимаемый записанным объём
+	Size        int64  `db:"size"`        // заявленный размер
+	Uploaded    bool   `db:"uploaded"`    // загрузка завершена
+	HashState   []byte `db:"hash_state"`  // состояние хеша на записанном объёме
+	FileKey     []byte `db:"file_key"`    // зашифрованный ключ содержимого
+	Compression string `db:"compression"` // сжатие содержимого
+}
+
+// UploadProgress состояние загрузки, фиксируемое вместе с записанным содержимым
+type UploadProgress struct {
+	HashState   []byte // состояние хеша записанного, nil - ничего не записано
+	Committed   int64  // записано открытых данных
+	StoredSize  int64  // занимаемый объём
+	FileKey     []byte // ключ нового содержимого, nil - продолжение загрузки
+	Compression string // сжатие нового содержимого
+	ContentType string // MIME тип нового содержимого
+	SHA256      []byte // дайджест завершённой загрузки
+}
+
+// BinaryPart часть содержимого, загруженная отдельным потоком
+type BinaryPart struct {
+	BinID      int64     `db:"bin_id"`
+	Offset     int64     `db:"part_offset"` // смещение открытых данных, кратно размеру блока
+	Size       int64     `db:"size"`        // открытых данных
+	StoredSize int64     `db:"stored_size"` // занимаемый объём
+	OID        int64     `db:"oid"`         // большой объект с блоками части
+	CreateAt   time.Time `db:"create_at"`
+}
+
+// PartReader часть, открытая на чтение в транзакции сборки
+type PartReader struct {
+	BinaryPart
+	io.ReadSeeker
+}
+
+// BlobWriter большой объект, открытый на запись в транзакции передачи
+type BlobWriter interface {
+	io.WriteSeeker
+	Truncate(size int64) error
 }
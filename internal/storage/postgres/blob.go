@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+var errNotPgx = errors.New("large objects require pgx connection")
+
+// BinaryUpload запись содержимого бинарника одной транзакцией. fn пишет
+// в открытый большой объект и возвращает достигнутое состояние загрузки,
+// оно фиксируется вместе с содержимым. Ошибка fn откатывает всю передачу.
+func (p *PgxStore) BinaryUpload(ctx context.Context, binID int64,
+	fn func(w storage.BlobWriter) (storage.UploadProgress, error)) error {
+
+	return p.withLargeObjects(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		lo := tx.LargeObjects()
+		obj, err := lo.Open(ctx, uint32(binID), pgx.LargeObjectModeWrite)
+		if err != nil {
+			return errNoObject(err)
+		}
+
+		progress, err := fn(obj)
+		if err != nil {
+			return err
+		}
+		if err = obj.Close(); err != nil || progress.HashState == nil {
+			return err
+		}
+
+		// ключ и сжатие меняются только с новым содержимым
+		query := `UPDATE binaries SET hash_state = $2, committed = $3, stored_size = $4,
+			file_key = COALESCE($5, file_key),
+			compression = CASE WHEN $5::BYTEA IS NULL THEN compression ELSE $6 END,
+			content_type = CASE WHEN $5::BYTEA IS NULL THEN content_type ELSE $7 END
+			WHERE bin_id = $1`
+		_, err = tx.Exec(ctx, query, binID, progress.HashState, progress.Committed,
+			progress.StoredSize, progress.FileKey, progress.Compression, progress.ContentType)
+		return err
+	})
+}
+
+// BinaryPut создание файла вместе с содержимым одной транзакцией: запись
+// появляется, только если fn записал всё содержимое в новый большой объект
+// и вернул состояние завершённой загрузки. Возвращает идентификатор бинарника.
+func (p *PgxStore) BinaryPut(ctx context.Context, data storage.BinaryData,
+	fn func(w storage.BlobWriter) (storage.UploadProgress, error)) (int64, error) {
+
+	err := p.withLargeObjects(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		lo := tx.LargeObjects()
+		oid, err := lo.Create(ctx, 0)
+		if err != nil {
+			return err
+		}
+		data.BinID = int64(oid)
+
+		if _, err = tx.Exec(ctx, purgeQuery("binaries"), data.UserID, data.Name); err != nil {
+			return err
+		}
+
+		// запись создаётся сразу, чтобы занятое имя отклонялось до передачи
+		query := `INSERT INTO binaries (user_id, name, size, notes, bin_id, filename, mtime, mode, expires_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+		_, err = tx.Exec(ctx, query, data.UserID, data.Name, data.Size,
+			data.Notes, data.BinID, data.Filename, data.MTime, data.Mode, data.ExpiresAt)
+		if err != nil {
+			return errWriteConflict(err)
+		}
+
+		obj, err := lo.Open(ctx, oid, pgx.LargeObjectModeWrite)
+		if err != nil {
+			return err
+		}
+		progress, err := fn(obj)
+		if err != nil {
+			return err
+		}
+		if err = obj.Close(); err != nil {
+			return err
+		}
+
+		query = `UPDATE binaries SET uploaded = TRUE, sha256 = $2, committed = $3,
+			stored_size = $4, file_key = $5, compression = $6, content_type = $7
+			WHERE bin_id = $1`
+		_, err = tx.Exec(ctx, query, data.BinID, progress.SHA256, progress.Committed,
+			progress.StoredSize, progress.FileKey, progress.Compression, progress.ContentType)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return data.BinID, nil
+}
+
+// BinaryDownload чтение содержимого бинарника одной транзакцией
+// только для чтения, fn читает из открытого большого объекта
+func (p *PgxStore) BinaryDownload(ctx context.Context, binID int64, fn func(r io.ReadSeeker) error) error {
+	opts := pgx.TxOptions{AccessMode: pgx.ReadOnly}
+
+	return p.withLargeObjects(ctx, opts, func(tx pgx.Tx) error {
+		lo := tx.LargeObjects()
+		obj, err := lo.Open(ctx, uint32(binID), pgx.LargeObjectModeRead)
+		if err != nil {
+			return errNoObject(err)
+		}
+		return fn(obj)
+	})
+}
+
+// withLargeObjects выполнение fn в транзакции на собственном соединении
+// pgx: большие объекты читаются и пишутся через дескриптор без отдельной
+// транзакции на каждый фрагмент
+func (p *PgxStore) withLargeObjects(ctx context.Context, opts pgx.TxOptions, fn func(tx pgx.Tx) error) error {
+	conn, err := p.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errNotPgx
+		}
+		return pgx.BeginTxFunc(ctx, c.Conn(), opts, fn)
+	})
+}
+
+// errNoObject отсутствующий большой объект
+func errNoObject(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UndefinedObject {
+		return storage.ErrNoContent
+	}
+	return err
+}
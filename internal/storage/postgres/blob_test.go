@@ -0,0 +1,273 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// testStore хранилище на базе из TEST_DATABASE_DSN, без неё тест пропускается
+func testStore(tb testing.TB) *PgxStore {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		tb.Skip("TEST_DATABASE_DSN is not set")
+	}
+
+	db, err := sqlx.Open("pgx", dsn)
+	require.NoError(tb, err)
+	p := new(PgxStore)
+	require.NoError(tb, p.Open(db, "../../../db/migrations"))
+	tb.Cleanup(func() { p.Close() })
+	return p
+}
+
+// testBinary файл пользователя с пустым большим объектом
+func testBinary(tb testing.TB, p *PgxStore) int64 {
+	ctx := context.Background()
+	user := fmt.Sprintf("blob-test-%s", tb.Name())
+
+	_, err := p.db.ExecContext(ctx, `INSERT INTO users (user_id, passwd_hash)
+		VALUES ($1, '') ON CONFLICT DO NOTHING`, user)
+	require.NoError(tb, err)
+
+	binID, err := p.BinaryWrite(ctx, storage.BinaryData{UserID: user, Name: "blob", Notes: []byte{}})
+	require.NoError(tb, err)
+	tb.Cleanup(func() { p.BinaryDelete(ctx, user, "blob") })
+	return binID
+}
+
+// writeChunks запись содержимого фрагментами по size байт
+func writeChunks(w io.Writer, content []byte, size int) error {
+	for len(content) > 0 {
+		n := size
+		if n > len(content) {
+			n = len(content)
+		}
+		if _, err := w.Write(content[:n]); err != nil {
+			return err
+		}
+		content = content[n:]
+	}
+	return nil
+}
+
+func TestBinaryTransfer(t *testing.T) {
+	p := testStore(t)
+	ctx := context.Background()
+	binID := testBinary(t, p)
+
+	content := make([]byte, 300<<10)
+	_, err := rand.Read(content)
+	require.NoError(t, err)
+
+	progress := storage.UploadProgress{HashState: []byte("state"), Committed: 7,
+		StoredSize: int64(len(content)), FileKey: []byte("key"), Compression: "zstd"}
+	err = p.BinaryUpload(ctx, binID, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+		if err := w.Truncate(0); err != nil {
+			return progress, err
+		}
+		return progress, writeChunks(w, content, 64<<10)
+	})
+	require.NoError(t, err)
+
+	var got bytes.Buffer
+	require.NoError(t, p.BinaryDownload(ctx, binID, func(r io.ReadSeeker) error {
+		_, err := io.Copy(&got, r)
+		return err
+	}))
+	assert.Equal(t, content, got.Bytes())
+
+	var row storage.BinaryData
+	require.NoError(t, p.db.GetContext(ctx, &row, `SELECT * FROM binaries WHERE bin_id = $1`, binID))
+	assert.Equal(t, progress.HashState, row.HashState)
+	assert.Equal(t, progress.Committed, row.Committed)
+	assert.Equal(t, progress.StoredSize, row.StoredSize)
+	assert.Equal(t, progress.FileKey, row.FileKey)
+	assert.Equal(t, progress.Compression, row.Compression)
+
+	t.Run("rollback", func(t *testing.T) {
+		failed := errors.New("transfer failed")
+		err := p.BinaryUpload(ctx, binID, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+			w.Truncate(0)
+			return storage.UploadProgress{HashState: []byte("other")}, failed
+		})
+		assert.ErrorIs(t, err, failed)
+
+		got.Reset()
+		require.NoError(t, p.BinaryDownload(ctx, binID, func(r io.ReadSeeker) error {
+			_, err := io.Copy(&got, r)
+			return err
+		}))
+		assert.Equal(t, content, got.Bytes())
+	})
+
+	t.Run("no object", func(t *testing.T) {
+		err := p.BinaryDownload(ctx, 1<<31, func(io.ReadSeeker) error { return nil })
+		assert.ErrorIs(t, err, storage.ErrNoContent)
+	})
+}
+
+func TestBinaryPut(t *testing.T) {
+	p := testStore(t)
+	ctx := context.Background()
+	user := "blob-test-put"
+	_, err := p.db.ExecContext(ctx, `INSERT INTO users (user_id, passwd_hash)
+		VALUES ($1, '') ON CONFLICT DO NOTHING`, user)
+	require.NoError(t, err)
+
+	mtime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	data := storage.BinaryData{UserID: user, Name: "put", Notes: []byte{}, Size: 3,
+		Filename: "a.txt", MTime: &mtime, Mode: 0640}
+	t.Cleanup(func() { p.BinaryDelete(ctx, user, data.Name) })
+
+	t.Run("rollback", func(t *testing.T) {
+		failed := errors.New("transfer failed")
+		_, err := p.BinaryPut(ctx, data, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+			_, err := w.Write([]byte("ab"))
+			require.NoError(t, err)
+			return storage.UploadProgress{}, failed
+		})
+		assert.ErrorIs(t, err, failed)
+
+		_, err = p.BinaryRead(ctx, user, data.Name)
+		assert.ErrorIs(t, err, storage.ErrNoContent)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		binID, err := p.BinaryPut(ctx, data, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+			_, err := w.Write([]byte("abc"))
+			return storage.UploadProgress{SHA256: []byte("sum"), Committed: 3, StoredSize: 3,
+				ContentType: "text/plain"}, err
+		})
+		require.NoError(t, err)
+
+		row, err := p.BinaryRead(ctx, user, data.Name)
+		require.NoError(t, err)
+		assert.Equal(t, binID, row.BinID)
+		assert.True(t, row.Uploaded)
+		assert.Equal(t, []byte("sum"), row.SHA256)
+		assert.Equal(t, "text/plain", row.ContentType)
+		assert.Equal(t, "a.txt", row.Filename)
+		require.NotNil(t, row.MTime)
+		assert.True(t, mtime.Equal(*row.MTime))
+		assert.Equal(t, uint32(0640), row.Mode)
+	})
+
+	t.Run("name taken", func(t *testing.T) {
+		_, err := p.BinaryPut(ctx, data, func(storage.BlobWriter) (storage.UploadProgress, error) {
+			t.Fatal("content of taken name must not be transferred")
+			return storage.UploadProgress{}, nil
+		})
+		assert.ErrorIs(t, err, storage.ErrWriteConflict)
+	})
+}
+
+// Сравнение прежней записи и чтения фрагментами по 4 КиБ, каждый
+// своей транзакцией, с передачей одной транзакцией через дескриптор:
+//
+//	TEST_DATABASE_DSN=postgres://... go test -run - -bench Binary ./internal/storage/postgres
+
+const benchSize = 16 << 20
+
+func benchContent(b *testing.B) []byte {
+	content := make([]byte, benchSize)
+	_, err := rand.Read(content)
+	require.NoError(b, err)
+	b.SetBytes(benchSize)
+	return content
+}
+
+func BenchmarkBinaryUploadPerChunkTx(b *testing.B) {
+	p := testStore(b)
+	ctx := context.Background()
+	binID := testBinary(b, p)
+	content := benchContent(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for offset := 0; offset < len(content); offset += 4096 {
+			err := p.WithTx(ctx, func(tx *sqlx.Tx) error {
+				_, err := tx.ExecContext(ctx, `SELECT lo_put($1, $2, $3)`,
+					binID, offset, content[offset:offset+4096])
+				return err
+			})
+			require.NoError(b, err)
+		}
+	}
+}
+
+func BenchmarkBinaryUploadStream(b *testing.B) {
+	for _, size := range []int{4 << 10, 64 << 10, 1 << 20} {
+		b.Run(fmt.Sprintf("chunk=%dK", size>>10), func(b *testing.B) {
+			p := testStore(b)
+			ctx := context.Background()
+			binID := testBinary(b, p)
+			content := benchContent(b)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				err := p.BinaryUpload(ctx, binID, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+					return storage.UploadProgress{}, writeChunks(w, content, size)
+				})
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+func BenchmarkBinaryDownloadPerChunkTx(b *testing.B) {
+	p := testStore(b)
+	ctx := context.Background()
+	binID := testBinary(b, p)
+	content := benchContent(b)
+	require.NoError(b, p.BinaryUpload(ctx, binID, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+		return storage.UploadProgress{}, writeChunks(w, content, 1<<20)
+	}))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for offset := 0; offset < len(content); offset += 4096 {
+			var chunk []byte
+			err := p.WithTx(ctx, func(tx *sqlx.Tx) error {
+				return tx.GetContext(ctx, &chunk, `SELECT lo_get($1, $2, $3)`, binID, offset, 4096)
+			})
+			require.NoError(b, err)
+		}
+	}
+}
+
+func BenchmarkBinaryDownloadStream(b *testing.B) {
+	for _, size := range []int{4 << 10, 64 << 10, 1 << 20} {
+		b.Run(fmt.Sprintf("chunk=%dK", size>>10), func(b *testing.B) {
+			p := testStore(b)
+			ctx := context.Background()
+			binID := testBinary(b, p)
+			content := benchContent(b)
+			require.NoError(b, p.BinaryUpload(ctx, binID, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+				return storage.UploadProgress{}, writeChunks(w, content, 1<<20)
+			}))
+
+			buf := make([]byte, size)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				err := p.BinaryDownload(ctx, binID, func(r io.ReadSeeker) error {
+					_, err := io.CopyBuffer(io.Discard, struct{ io.Reader }{r}, buf)
+					return err
+				})
+				require.NoError(b, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// FolderMove перемещение элемента в папку. Папка и все её родители
+// создаются при отсутствии, пустой путь - перемещение в корень.
+func (p *PgxStore) FolderMove(ctx context.Context, kind, userID, name, folder string) error {
+	tabname, ok := kindTables[kind]
+	if !ok {
+		return errUnkmownDataType
+	}
+
+	return p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		var folderID *int64
+		if folder != "" {
+			parts := strings.Split(folder, "/")
+			for i := range parts {
+				var id int64
+				err := tx.GetContext(ctx, &id, `INSERT INTO folders (user_id, path)
+					VALUES ($1, $2)
+					ON CONFLICT (user_id, path) DO UPDATE SET path = EXCLUDED.path
+					RETURNING id`, userID, strings.Join(parts[:i+1], "/"))
+				if err != nil {
+					return err
+				}
+				folderID = &id
+			}
+		}
+
+		query := `UPDATE ` + tabname + ` SET folder_id = $3
+			WHERE user_id = $1 AND name = $2 AND ` + notExpired
+
+		res, err := tx.ExecContext(ctx, query, userID, name, folderID)
+		if err != nil {
+			return err
+		}
+		if n, e := res.RowsAffected(); n == 0 && e == nil {
+			return storage.ErrNoContent
+		}
+		return nil
+	})
+}
+
+// FolderList пути папок пользователя
+func (p *PgxStore) FolderList(ctx context.Context, userID string) ([]string, error) {
+	query := `SELECT path FROM folders WHERE user_id = $1 ORDER BY path`
+
+	res := make([]string, 0)
+	if err := p.db.SelectContext(ctx, &res, query, userID); err != nil {
+		return nil, errNoContent(err)
+	}
+	return res, nil
+}
+
+// FolderDelete удаление папки вместе с вложенными,
+// элементы из них переносятся в корень
+func (p *PgxStore) FolderDelete(ctx context.Context, userID, folder string) error {
+	query := `DELETE FROM folders
+		WHERE user_id = $1 AND (path = $2 OR path LIKE $3)`
+
+	res, err := p.db.ExecContext(ctx, query, userID, folder, likeEscape(folder)+"/%")
+	if err != nil {
+		return err
+	}
+	if n, e := res.RowsAffected(); n == 0 && e == nil {
+		return storage.ErrNoContent
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// loModeRead режим открытия большого объекта на чтение
+const loModeRead = 0x40000
+
+// CollectGarbage удаление больших объектов, на которые не ссылается
+// ни один файл или часть, файлов, загрузка которых не завершилась за время
+// grace, частей, не собранных за это время или оставшихся без файла,
+// и элементов всех видов с истёкшим сроком хранения вместе с содержимым.
+// При dryRun только подсчитывается освобождаемый объём.
+func (p *PgxStore) CollectGarbage(ctx context.Context, grace time.Duration, dryRun bool) (storage.GCReport, error) {
+	var report storage.GCReport
+
+	err := p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		var orphans []int64
+		err := tx.SelectContext(ctx, &orphans, `SELECT m.oid FROM pg_largeobject_metadata m
+			WHERE NOT EXISTS (SELECT 1 FROM binaries b WHERE b.bin_id = m.oid)
+			AND NOT EXISTS (SELECT 1 FROM binary_parts p WHERE p.oid = m.oid)`)
+		if err != nil {
+			return err
+		}
+
+		var stale []storage.BinaryData
+		err = tx.SelectContext(ctx, &stale, `SELECT * FROM binaries
+			WHERE NOT uploaded AND update_at < now() - make_interval(secs => $1)
+				AND `+notExpired+`
+			FOR UPDATE SKIP LOCKED`, grace.Seconds())
+		if err != nil {
+			return err
+		}
+
+		var parts []storage.BinaryPart
+		err = tx.SelectContext(ctx, &parts, `SELECT * FROM binary_parts p
+			WHERE create_at < now() - make_interval(secs => $1)
+			OR NOT EXISTS (SELECT 1 FROM binaries b WHERE b.bin_id = p.bin_id)
+			FOR UPDATE SKIP LOCKED`, grace.Seconds())
+		if err != nil {
+			return err
+		}
+
+		var expired []storage.BinaryData
+		err = tx.SelectContext(ctx, &expired, `SELECT * FROM binaries
+			WHERE expires_at <= now()
+			FOR UPDATE SKIP LOCKED`)
+		if err != nil {
+			return err
+		}
+
+		for _, oid := range orphans {
+			size, err := loSize(ctx, tx, oid)
+			if err != nil {
+				return err
+			}
+			if !dryRun {
+				if _, err = tx.ExecContext(ctx, `SELECT lo_unlink($1)`, oid); err != nil {
+					return err
+				}
+			}
+			report.Objects++
+			report.Bytes += size
+		}
+
+		for _, b := range stale {
+			size, err := loSize(ctx, tx, b.BinID)
+			if err != nil {
+				return err
+			}
+			if !dryRun {
+				if _, err = tx.ExecContext(ctx, `SELECT lo_unlink($1)`, b.BinID); err != nil {
+					return err
+				}
+				if _, err = tx.ExecContext(ctx, `DELETE FROM binaries WHERE id = $1`, b.ID); err != nil {
+					return err
+				}
+			}
+			report.Binaries++
+			report.Bytes += size
+		}
+
+		for _, part := range parts {
+			if !dryRun {
+				if _, err = tx.ExecContext(ctx, `SELECT lo_unlink($1)`, part.OID); err != nil {
+					return err
+				}
+				_, err = tx.ExecContext(ctx, `DELETE FROM binary_parts WHERE bin_id = $1 AND part_offset = $2`,
+					part.BinID, part.Offset)
+				if err != nil {
+					return err
+				}
+			}
+			report.Parts++
+			report.Bytes += part.StoredSize
+		}
+
+		for _, b := range expired {
+			size, err := loSize(ctx, tx, b.BinID)
+			if err != nil {
+				return err
+			}
+			if !dryRun {
+				if _, err = tx.ExecContext(ctx, `SELECT lo_unlink($1)`, b.BinID); err != nil {
+					return err
+				}
+				if _, err = tx.ExecContext(ctx, `DELETE FROM binaries WHERE id = $1`, b.ID); err != nil {
+					return err
+				}
+			}
+			report.Expired++
+			report.Bytes += size
+		}
+
+		for _, tabname := range []string{"passwords", "cards", "notes", "items", "otps", "ssh_keys"} {
+			query := `SELECT count(*) FROM ` + tabname + ` WHERE expires_at <= now()`
+			if !dryRun {
+				query = `WITH d AS (DELETE FROM ` + tabname + ` WHERE expires_at <= now() RETURNING id)
+					SELECT count(*) FROM d`
+			}
+			var n int
+			if err = tx.GetContext(ctx, &n, query); err != nil {
+				return err
+			}
+			report.Expired += n
+		}
+		return nil
+	})
+	return report, err
+}
+
+// loSize размер большого объекта, открытый дескриптор закрывается
+// вместе с транзакцией
+func loSize(ctx context.Context, tx *sqlx.Tx, oid int64) (size int64, err error) {
+	query := `SELECT lo_lseek64(lo_open($1, $2), 0, 2)`
+
+	err = tx.GetContext(ctx, &size, query, oid, loModeRead)
+	return
+}
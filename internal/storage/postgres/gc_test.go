@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestCollectGarbage(t *testing.T) {
+	ctx := context.Background()
+
+	for _, dryRun := range []bool{true, false} {
+		name := "collect"
+		if dryRun {
+			name = "dry run"
+		}
+		t.Run(name, func(t *testing.T) {
+			p, mock := newMockStore(t)
+			mock.ExpectBegin()
+			mock.ExpectQuery(`FROM pg_largeobject_metadata`).
+				WillReturnRows(sqlmock.NewRows([]string{"oid"}).AddRow(11))
+			mock.ExpectQuery(`SELECT \* FROM binaries`).
+				WithArgs(time.Hour.Seconds()).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "bin_id"}).AddRow(3, 12))
+			mock.ExpectQuery(`SELECT \* FROM binary_parts`).
+				WithArgs(time.Hour.Seconds()).
+				WillReturnRows(sqlmock.NewRows([]string{"bin_id", "part_offset", "stored_size", "oid"}).
+					AddRow(13, 65536, 7, 14))
+			mock.ExpectQuery(`SELECT \* FROM binaries\s+WHERE expires_at`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "bin_id"}).AddRow(5, 15))
+
+			mock.ExpectQuery(`lo_lseek64`).WithArgs(int64(11), loModeRead).
+				WillReturnRows(sqlmock.NewRows([]string{"size"}).AddRow(100))
+			if !dryRun {
+				mock.ExpectExec(`SELECT lo_unlink`).WithArgs(int64(11)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			mock.ExpectQuery(`lo_lseek64`).WithArgs(int64(12), loModeRead).
+				WillReturnRows(sqlmock.NewRows([]string{"size"}).AddRow(20))
+			if !dryRun {
+				mock.ExpectExec(`SELECT lo_unlink`).WithArgs(int64(12)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM binaries`).WithArgs(int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`SELECT lo_unlink`).WithArgs(int64(14)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM binary_parts`).WithArgs(int64(13), int64(65536)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			mock.ExpectQuery(`lo_lseek64`).WithArgs(int64(15), loModeRead).
+				WillReturnRows(sqlmock.NewRows([]string{"size"}).AddRow(300))
+			if !dryRun {
+				mock.ExpectExec(`SELECT lo_unlink`).WithArgs(int64(15)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM binaries`).WithArgs(int64(5)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			for _, tabname := range []string{"passwords", "cards", "notes", "items", "otps", "ssh_keys"} {
+				query := `SELECT count\(\*\) FROM ` + tabname
+				if !dryRun {
+					query = `DELETE FROM ` + tabname + ` WHERE expires_at`
+				}
+				mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			}
+			mock.ExpectCommit()
+
+			report, err := p.CollectGarbage(ctx, time.Hour, dryRun)
+			require.NoError(t, err)
+			assert.Equal(t, storage.GCReport{Objects: 1, Binaries: 1, Parts: 1, Expired: 7, Bytes: 427}, report)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// ItemList список наименований произвольных элементов
+func (p *PgxStore) ItemList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return p.entriesList(ctx, "items", q)
+}
+
+// ItemWrite запись нового элемента вместе с полями
+func (p *PgxStore) ItemWrite(ctx context.Context, data storage.ItemData) error {
+	return p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		return execWrite(ctx, tx, data)
+	})
+}
+
+// ItemRead чтение элемента, поля возвращаются в порядке записи
+func (p *PgxStore) ItemRead(ctx context.Context, userID, name string) (res storage.ItemData, err error) {
+	if err = p.readFirstByName(ctx, &res, userID, name); err != nil {
+		return
+	}
+
+	query := `SELECT label, field_type, value FROM item_fields
+		WHERE item_id = $1 ORDER BY position`
+
+	res.Fields = make([]storage.ItemField, 0)
+	err = p.db.SelectContext(ctx, &res.Fields, query, res.ID)
+	return
+}
+
+// ItemDelete удаление элемента, поля удаляются каскадно
+func (p *PgxStore) ItemDelete(ctx context.Context, userID, name string) error {
+	return p.deleteByName(ctx, "items", userID, name)
+}
+
+// ItemUpdate обновление элемента, поля заменяются целиком
+func (p *PgxStore) ItemUpdate(ctx context.Context, data storage.ItemData) error {
+	return p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		return execUpdate(ctx, tx, data)
+	})
+}
+
+// writeItem вставка элемента и его полей, выполняется в транзакции
+func writeItem(ctx context.Context, e sqlx.ExtContext, data storage.ItemData) error {
+	query := `INSERT INTO items (user_id, name, item_type, expires_at)
+		VALUES ($1, $2, $3, $4) RETURNING id`
+
+	var id int64
+	err := sqlx.GetContext(ctx, e, &id, query, data.UserID, data.Name, data.Type, data.ExpiresAt)
+	if err != nil {
+		return errWriteConflict(err)
+	}
+	return writeItemFields(ctx, e, id, data.Fields)
+}
+
+// updateItem обновление элемента с заменой полей, выполняется в транзакции
+func updateItem(ctx context.Context, e sqlx.ExtContext, data storage.ItemData) error {
+	query := `UPDATE items
+		SET name=$3, item_type=$4, expires_at=$5, update_at=now()
+		WHERE id=$1 AND user_id=$2 AND ` + notExpired + `;`
+
+	res, err := e.ExecContext(ctx, query, data.ID, data.UserID, data.Name, data.Type, data.ExpiresAt)
+	if err != nil {
+		return errWriteConflict(err)
+	}
+	if n, e := res.RowsAffected(); n == 0 && e == nil {
+		return storage.ErrNoContent
+	}
+
+	if _, err = e.ExecContext(ctx, `DELETE FROM item_fields WHERE item_id = $1`, data.ID); err != nil {
+		return err
+	}
+	return writeItemFields(ctx, e, data.ID, data.Fields)
+}
+
+func writeItemFields(ctx context.Context, e sqlx.ExecerContext, itemID int64, fields []storage.ItemField) error {
+	query := `INSERT INTO item_fields (item_id, position, label, field_type, value)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	for i, f := range fields {
+		if _, err := e.ExecContext(ctx, query, itemID, i, f.Label, f.Type, f.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
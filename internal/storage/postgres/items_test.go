@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestItemWriteAtomic(t *testing.T) {
+	ctx := context.Background()
+	data := storage.ItemData{
+		UserID: "user",
+		Name:   "db",
+		Type:   "database",
+		Fields: []storage.ItemField{
+			{Label: "host", Type: storage.FieldText, Value: []byte("localhost")},
+			{Label: "password", Type: storage.FieldSecret, Value: []byte("secret")},
+		},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		expectPurge(mock, "items", data.UserID, data.Name)
+		mock.ExpectQuery(`INSERT INTO items`).WithArgs("user", "db", "database", nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+		for i, f := range data.Fields {
+			mock.ExpectExec(`INSERT INTO item_fields`).WithArgs(int64(7), i, f.Label, f.Type, f.Value).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
+
+		require.NoError(t, p.ItemWrite(ctx, data))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("field error", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		expectPurge(mock, "items", data.UserID, data.Name)
+		mock.ExpectQuery(`INSERT INTO items`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+		mock.ExpectExec(`INSERT INTO item_fields`).WillReturnError(errors.New("field error"))
+		// элемент без полей не остаётся
+		mock.ExpectRollback()
+
+		assert.EqualError(t, p.ItemWrite(ctx, data), "field error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemUpdateAtomic(t *testing.T) {
+	ctx := context.Background()
+	data := storage.ItemData{
+		ID:     7,
+		UserID: "user",
+		Name:   "db",
+		Type:   "database",
+		Fields: []storage.ItemField{{Label: "host", Type: storage.FieldText, Value: []byte("db.local")}},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		expectPurge(mock, "items", data.UserID, data.Name)
+		mock.ExpectExec(`UPDATE items`).WithArgs(int64(7), "user", "db", "database", nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM item_fields`).WithArgs(int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`INSERT INTO item_fields`).WithArgs(int64(7), 0, "host", storage.FieldText, []byte("db.local")).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, p.ItemUpdate(ctx, data))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		expectPurge(mock, "items", data.UserID, data.Name)
+		mock.ExpectExec(`UPDATE items`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, p.ItemUpdate(ctx, data), storage.ErrNoContent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestItemFields(t *testing.T) {
+	p := testStore(t)
+	ctx := context.Background()
+	user := "items-test"
+
+	_, err := p.db.ExecContext(ctx, `INSERT INTO users (user_id, passwd_hash)
+		VALUES ($1, '') ON CONFLICT DO NOTHING`, user)
+	require.NoError(t, err)
+	t.Cleanup(func() { p.ItemDelete(ctx, user, "wifi") })
+
+	fields := []storage.ItemField{
+		{Label: "ssid", Type: storage.FieldText, Value: []byte("home")},
+		{Label: "password", Type: storage.FieldSecret, Value: []byte("secret")},
+		{Label: "admin", Type: storage.FieldURL, Value: []byte("http://192.168.0.1")},
+	}
+	require.NoError(t, p.ItemWrite(ctx, storage.ItemData{UserID: user, Name: "wifi", Type: "wifi network", Fields: fields}))
+
+	item, err := p.ItemRead(ctx, user, "wifi")
+	require.NoError(t, err)
+	assert.Equal(t, "wifi network", item.Type)
+	assert.Equal(t, fields, item.Fields)
+
+	require.NoError(t, p.TagAdd(ctx, storage.KindItem, user, "wifi", []string{"home"}))
+	res, err := p.Search(ctx, storage.SearchQuery{UserID: user, Text: "network", Limit: 10, Kinds: []string{storage.KindItem}})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "type", res[0].Matched)
+
+	// поля заменяются целиком
+	item.Fields = fields[:1]
+	require.NoError(t, p.ItemUpdate(ctx, item))
+	item, err = p.ItemRead(ctx, user, "wifi")
+	require.NoError(t, err)
+	assert.Equal(t, fields[:1], item.Fields)
+
+	list, err := p.ReadList(ctx, user)
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.ItemsCount)
+}
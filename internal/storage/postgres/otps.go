@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// OtpList список наименований секретов одноразовых паролей
+func (p *PgxStore) OtpList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return p.entriesList(ctx, "otps", q)
+}
+
+// OtpWrite запись нового секрета
+func (p *PgxStore) OtpWrite(ctx context.Context, data storage.OtpData) error {
+	return p.Write(ctx, data)
+}
+
+// OtpRead чтение секрета
+func (p *PgxStore) OtpRead(ctx context.Context, userID, name string) (res storage.OtpData, err error) {
+	err = p.readFirstByName(ctx, &res, userID, name)
+	return
+}
+
+// OtpDelete удаление секрета
+func (p *PgxStore) OtpDelete(ctx context.Context, userID, name string) error {
+	return p.deleteByName(ctx, "otps", userID, name)
+}
+
+// OtpUpdate обновление секрета и параметров генерации
+func (p *PgxStore) OtpUpdate(ctx context.Context, data storage.OtpData) error {
+	return p.Update(ctx, data)
+}
+
+// OtpNextCounter получение секрета HOTP с увеличением счётчика одним
+// запросом, поэтому одно значение не выдаётся дважды. Возвращается
+// значение счётчика до увеличения. Время изменения не обновляется.
+func (p *PgxStore) OtpNextCounter(ctx context.Context, userID, name string) (res storage.OtpData, err error) {
+	query := `UPDATE otps SET counter = counter + 1
+		WHERE user_id = $1 AND name = $2 AND otp_type = 'hotp' AND ` + notExpired + `
+		RETURNING *`
+
+	if err = p.db.GetContext(ctx, &res, query, userID, name); err != nil {
+		return res, errNoContent(err)
+	}
+	res.Counter--
+	return
+}
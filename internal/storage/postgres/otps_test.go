@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestOtpNextCounter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ok", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectQuery(`UPDATE otps SET counter = counter \+ 1`).WithArgs("user", "bank").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "otp_type", "counter"}).
+				AddRow(7, "bank", "hotp", 5))
+
+		data, err := p.OtpNextCounter(ctx, "user", "bank")
+		require.NoError(t, err)
+		// возвращается значение до увеличения
+		assert.Equal(t, int64(4), data.Counter)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectQuery(`UPDATE otps`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := p.OtpNextCounter(ctx, "user", "bank")
+		assert.ErrorIs(t, err, storage.ErrNoContent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestOtpCounter(t *testing.T) {
+	p := testStore(t)
+	ctx := context.Background()
+	user := "otps-test"
+
+	_, err := p.db.ExecContext(ctx, `INSERT INTO users (user_id, passwd_hash)
+		VALUES ($1, '') ON CONFLICT DO NOTHING`, user)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		p.OtpDelete(ctx, user, "bank")
+		p.OtpDelete(ctx, user, "github")
+	})
+
+	hotp := storage.OtpData{UserID: user, Name: "bank", Issuer: "Bank", Account: []byte("alice"),
+		Secret: []byte("secret"), Type: "hotp", Algorithm: "SHA1", Digits: 6, Period: 30, Counter: 10}
+	require.NoError(t, p.OtpWrite(ctx, hotp))
+	totp := hotp
+	totp.Name, totp.Issuer, totp.Type = "github", "GitHub", "totp"
+	require.NoError(t, p.OtpWrite(ctx, totp))
+
+	for want := int64(10); want < 13; want++ {
+		data, err := p.OtpNextCounter(ctx, user, "bank")
+		require.NoError(t, err)
+		assert.Equal(t, want, data.Counter)
+	}
+	data, err := p.OtpRead(ctx, user, "bank")
+	require.NoError(t, err)
+	assert.Equal(t, int64(13), data.Counter)
+
+	// счётчик есть только у HOTP
+	_, err = p.OtpNextCounter(ctx, user, "github")
+	assert.ErrorIs(t, err, storage.ErrNoContent)
+
+	res, err := p.Search(ctx, storage.SearchQuery{UserID: user, Text: "GitHub", Limit: 10, Kinds: []string{storage.KindOtp}})
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+	assert.Equal(t, "github", res[0].Name)
+
+	list, err := p.ReadList(ctx, user)
+	require.NoError(t, err)
+	assert.Equal(t, 2, list.OtpsCount)
+}
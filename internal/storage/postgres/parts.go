@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// BinaryPartsBegin начало загрузки нового содержимого частями: прежнее
+// содержимое и части отбрасываются, сохраняются ключ и сжатие из progress
+func (p *PgxStore) BinaryPartsBegin(ctx context.Context, binID int64, progress storage.UploadProgress) error {
+	return p.withLargeObjects(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		lo := tx.LargeObjects()
+		obj, err := lo.Open(ctx, uint32(binID), pgx.LargeObjectModeWrite)
+		if err != nil {
+			return errNoObject(err)
+		}
+		if err = obj.Truncate(0); err != nil {
+			return err
+		}
+		if err = obj.Close(); err != nil {
+			return err
+		}
+		if err = dropParts(ctx, tx, binID); err != nil {
+			return err
+		}
+
+		query := `UPDATE binaries SET uploaded = FALSE, sha256 = NULL, hash_state = NULL,
+			committed = 0, stored_size = 0, file_key = $2, compression = $3, update_at = now()
+			WHERE bin_id = $1`
+		_, err = tx.Exec(ctx, query, binID, progress.FileKey, progress.Compression)
+		return err
+	})
+}
+
+// BinaryUploadPart запись части со смещения offset одной транзакцией
+// в новый большой объект. Часть появляется, только если fn завершился
+// без ошибки, и заменяет прежнюю с тем же смещением.
+func (p *PgxStore) BinaryUploadPart(ctx context.Context, binID, offset int64,
+	fn func(w storage.BlobWriter) (storage.UploadProgress, error)) error {
+
+	return p.withLargeObjects(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		lo := tx.LargeObjects()
+		oid, err := lo.Create(ctx, 0)
+		if err != nil {
+			return err
+		}
+		obj, err := lo.Open(ctx, oid, pgx.LargeObjectModeWrite)
+		if err != nil {
+			return err
+		}
+		progress, err := fn(obj)
+		if err != nil {
+			return err
+		}
+		if err = obj.Close(); err != nil {
+			return err
+		}
+
+		query := `SELECT lo_unlink(oid) FROM binary_parts WHERE bin_id = $1 AND part_offset = $2`
+		if _, err = tx.Exec(ctx, query, binID, offset); err != nil {
+			return err
+		}
+		query = `INSERT INTO binary_parts (bin_id, part_offset, size, stored_size, oid)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (bin_id, part_offset) DO UPDATE SET size = EXCLUDED.size,
+				stored_size = EXCLUDED.stored_size, oid = EXCLUDED.oid, create_at = now()`
+		_, err = tx.Exec(ctx, query, binID, offset, progress.Committed-offset, progress.StoredSize, oid)
+		return err
+	})
+}
+
+// BinaryAssemble сборка содержимого из частей одной транзакцией: fn
+// получает части по возрастанию смещения, открытые на чтение, и пишет
+// содержимое в большой объект файла. Загрузка отмечается завершённой
+// с состоянием, которое вернул fn, части удаляются.
+func (p *PgxStore) BinaryAssemble(ctx context.Context, binID int64,
+	fn func(w storage.BlobWriter, parts []storage.PartReader) (storage.UploadProgress, error)) error {
+
+	return p.withLargeObjects(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		query := `SELECT bin_id, part_offset, size, stored_size, oid, create_at
+			FROM binary_parts WHERE bin_id = $1 ORDER BY part_offset FOR UPDATE`
+		rows, err := tx.Query(ctx, query, binID)
+		if err != nil {
+			return err
+		}
+		parts, err := pgx.CollectRows(rows, pgx.RowToStructByName[storage.BinaryPart])
+		if err != nil {
+			return err
+		}
+
+		lo := tx.LargeObjects()
+		readers := make([]storage.PartReader, 0, len(parts))
+		for _, part := range parts {
+			obj, err := lo.Open(ctx, uint32(part.OID), pgx.LargeObjectModeRead)
+			if err != nil {
+				return errNoObject(err)
+			}
+			readers = append(readers, storage.PartReader{BinaryPart: part, ReadSeeker: obj})
+		}
+
+		obj, err := lo.Open(ctx, uint32(binID), pgx.LargeObjectModeWrite)
+		if err != nil {
+			return errNoObject(err)
+		}
+		progress, err := fn(obj, readers)
+		if err != nil {
+			return err
+		}
+		if err = obj.Close(); err != nil {
+			return err
+		}
+
+		query = `UPDATE binaries SET uploaded = TRUE, sha256 = $2, hash_state = NULL,
+			committed = $3, stored_size = $4, content_type = $5
+			WHERE bin_id = $1`
+		_, err = tx.Exec(ctx, query, binID, progress.SHA256, progress.Committed,
+			progress.StoredSize, progress.ContentType)
+		if err != nil {
+			return err
+		}
+		return dropParts(ctx, tx, binID)
+	})
+}
+
+// dropParts удаление частей файла вместе с их большими объектами
+func dropParts(ctx context.Context, tx pgx.Tx, binID int64) error {
+	query := `SELECT lo_unlink(oid) FROM binary_parts WHERE bin_id = $1`
+	if _, err := tx.Exec(ctx, query, binID); err != nil {
+		return err
+	}
+	_, err := tx.Exec(ctx, `DELETE FROM binary_parts WHERE bin_id = $1`, binID)
+	return err
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestBinaryParts(t *testing.T) {
+	p := testStore(t)
+	ctx := context.Background()
+	binID := testBinary(t, p)
+
+	// прежнее содержимое отбрасывается в начале загрузки частями
+	err := p.BinaryUpload(ctx, binID, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+		_, err := w.Write([]byte("previous"))
+		return storage.UploadProgress{HashState: []byte("state"), Committed: 8}, err
+	})
+	require.NoError(t, err)
+	require.NoError(t, p.BinaryPartsBegin(ctx, binID, storage.UploadProgress{FileKey: []byte("key"), Compression: "zstd"}))
+
+	var row storage.BinaryData
+	require.NoError(t, p.db.GetContext(ctx, &row, `SELECT * FROM binaries WHERE bin_id = $1`, binID))
+	assert.Equal(t, []byte("key"), row.FileKey)
+	assert.Equal(t, "zstd", row.Compression)
+	assert.Zero(t, row.Committed)
+	assert.Nil(t, row.HashState)
+
+	uploadPart := func(offset int64, content string, fail error) error {
+		return p.BinaryUploadPart(ctx, binID, offset, func(w storage.BlobWriter) (storage.UploadProgress, error) {
+			_, err := w.Write([]byte(content))
+			require.NoError(t, err)
+			return storage.UploadProgress{Committed: offset + int64(len(content)), StoredSize: int64(len(content))}, fail
+		})
+	}
+	require.NoError(t, uploadPart(5, "second", nil))
+	require.NoError(t, uploadPart(0, "stale", nil))
+	// повторная часть заменяет прежнюю, неудавшаяся не сохраняется
+	require.NoError(t, uploadPart(0, "first", nil))
+	failed := errors.New("transfer failed")
+	assert.ErrorIs(t, uploadPart(11, "third", failed), failed)
+
+	err = p.BinaryAssemble(ctx, binID, func(w storage.BlobWriter, parts []storage.PartReader) (storage.UploadProgress, error) {
+		require.Len(t, parts, 2)
+		var committed int64
+		for i, want := range []string{"first", "second"} {
+			assert.Equal(t, committed, parts[i].Offset)
+			assert.Equal(t, int64(len(want)), parts[i].Size)
+			data, err := io.ReadAll(parts[i])
+			require.NoError(t, err)
+			assert.Equal(t, want, string(data))
+			if _, err = w.Write(data); err != nil {
+				return storage.UploadProgress{}, err
+			}
+			committed += parts[i].Size
+		}
+		return storage.UploadProgress{SHA256: []byte("sum"), Committed: committed,
+			StoredSize: committed, ContentType: "text/plain"}, nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, p.db.GetContext(ctx, &row, `SELECT * FROM binaries WHERE bin_id = $1`, binID))
+	assert.True(t, row.Uploaded)
+	assert.Equal(t, []byte("sum"), row.SHA256)
+	assert.Equal(t, int64(11), row.Committed)
+	assert.Equal(t, "text/plain", row.ContentType)
+
+	var got bytes.Buffer
+	require.NoError(t, p.BinaryDownload(ctx, binID, func(r io.ReadSeeker) error {
+		_, err := io.Copy(&got, r)
+		return err
+	}))
+	assert.Equal(t, "firstsecond", got.String())
+
+	var left int
+	require.NoError(t, p.db.GetContext(ctx, &left, `SELECT count(*) FROM binary_parts WHERE bin_id = $1`, binID))
+	assert.Zero(t, left)
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -21,8 +22,12 @@ import (
 	"github.com/eugene982/yp-gophkeeper/internal/storage"
 )
 
+// notExpired условие отбора элементов, срок хранения которых не истёк
+const notExpired = `(expires_at IS NULL OR expires_at > now())`
+
 var (
 	errUnkmownDataType = errors.New("unknown data type")
+	errBatchRollback   = errors.New("batch rollback") // откат пакета без фиксации
 
 	writeQuery = map[string]string{ // запросы записи в бд
 		// Пользователь
@@ -32,45 +37,108 @@ var (
 
 		// Пароль
 		"passwords": `INSERT INTO passwords
-			(user_id, name, username, password, notes)
-		VALUES(:user_id, :name, :username, :password, :notes);`,
+			(user_id, name, username, password, notes, url, search_username, expires_at)
+		VALUES(:user_id, :name, :username, :password, :notes, :url, :search_username, :expires_at);`,
 
 		// Карточки
 		"cards": `INSERT INTO cards
-			(user_id, name, number, pin, notes)
-		VALUES(:user_id, :name, :number, :pin, :notes);`,
+			(user_id, name, number, pin, notes, expires_at)
+		VALUES(:user_id, :name, :number, :pin, :notes, :expires_at);`,
 
 		// Заметки
 		"notes": `INSERT INTO notes
-			(user_id, name, notes)
-		VALUES(:user_id, :name, :notes);`,
+			(user_id, name, notes, expires_at)
+		VALUES(:user_id, :name, :notes, :expires_at);`,
+
+		// Одноразовые пароли
+		"otps": `INSERT INTO otps
+			(user_id, name, issuer, account, secret, otp_type, algorithm, digits, period, counter, expires_at)
+		VALUES(:user_id, :name, :issuer, :account, :secret, :otp_type, :algorithm, :digits, :period, :counter, :expires_at);`,
+
+		// Ключи SSH
+		"ssh_keys": `INSERT INTO ssh_keys
+			(user_id, name, key_type, bits, public_key, private_key, comment, fingerprint, expires_at)
+		VALUES(:user_id, :name, :key_type, :bits, :public_key, :private_key, :comment, :fingerprint, :expires_at);`,
 
 		// бинарники
 		"binaries": `INSERT INTO binaries
-			(user_id, name, size, notes, bin_id)
-		VALUES(:user_id, :name, :size, :notes, :bin_id);`,
+			(user_id, name, size, notes, bin_id, content_type, filename, mtime, mode, expires_at)
+		VALUES(:user_id, :name, :size, :notes, :bin_id, :content_type, :filename, :mtime, :mode, :expires_at);`,
+	}
+
+	kindTables = map[string]string{ // таблицы видов хранимых данных
+		storage.KindPassword: "passwords",
+		storage.KindCard:     "cards",
+		storage.KindNote:     "notes",
+		storage.KindBinary:   "binaries",
+		storage.KindItem:     "items",
+		storage.KindOtp:      "otps",
+		storage.KindSshKey:   "ssh_keys",
+	}
+
+	tagColumns = map[string]string{ // ссылки на элементы в таблице меток
+		"passwords": "password_id",
+		"cards":     "card_id",
+		"notes":     "note_id",
+		"binaries":  "binary_id",
+		"items":     "item_id",
+		"otps":      "otp_id",
+		"ssh_keys":  "ssh_key_id",
 	}
 
+	sortColumns = map[string]string{ // поля сортировки списков
+		storage.SortName:    "name",
+		storage.SortCreated: "create_at",
+		storage.SortUpdated: "update_at",
+	}
+
+	likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 	updateQuery = map[string]string{ // запросы на обновление данных
 		"users": `UPDATE users 
 		SET user_id=:user_id, passwd_hash=:passwd_hash, update_at=now()   
 		WHERE user_id=:user_id;`,
 
 		"passwords": `UPDATE passwords 
-		SET user_id=:user_id, name=:name, username=:username, password=:password, notes=:notes, update_at=now()   
-		WHERE id=:id;`,
+		SET user_id=:user_id, name=:name, username=:username, password=:password, notes=:notes,
+			url=:url, search_username=:search_username, expires_at=:expires_at, update_at=now()   
+		WHERE id=:id AND user_id=:user_id AND ` + notExpired + `;`,
 
 		"cards": `UPDATE cards 
-		SET user_id=:user_id, name=:name, number=:number, notes=:notes, update_at=now()   
-		WHERE id=:id;`,
+		SET user_id=:user_id, name=:name, number=:number, notes=:notes, expires_at=:expires_at, update_at=now()   
+		WHERE id=:id AND user_id=:user_id AND ` + notExpired + `;`,
 
 		"notes": `UPDATE notes 
-		SET user_id=:user_id, name=:name, notes=:notes, update_at=now()  
-		WHERE id=:id;`,
+		SET user_id=:user_id, name=:name, notes=:notes, expires_at=:expires_at, update_at=now()  
+		WHERE id=:id AND user_id=:user_id AND ` + notExpired + `;`,
+
+		"otps": `UPDATE otps
+		SET user_id=:user_id, name=:name, issuer=:issuer, account=:account, secret=:secret,
+			otp_type=:otp_type, algorithm=:algorithm, digits=:digits, period=:period,
+			counter=:counter, expires_at=:expires_at, update_at=now()
+		WHERE id=:id AND user_id=:user_id AND ` + notExpired + `;`,
+
+		"ssh_keys": `UPDATE ssh_keys
+		SET user_id=:user_id, name=:name, key_type=:key_type, bits=:bits, public_key=:public_key,
+			private_key=:private_key, comment=:comment, fingerprint=:fingerprint,
+			expires_at=:expires_at, update_at=now()
+		WHERE id=:id AND user_id=:user_id AND ` + notExpired + `;`,
 
 		"binaries": `UPDATE binaries 
-		SET user_id=:user_id, name=:name, size=:size, notes=:notes, update_at=now()   
-		WHERE id=:id;`,
+		SET user_id=:user_id, name=:name, size=:size, notes=:notes,
+			uploaded=(uploaded AND :bin_id = 0),
+			sha256=CASE WHEN :bin_id = 0 THEN sha256 END,
+			hash_state=CASE WHEN :bin_id = 0 THEN hash_state END,
+			file_key=CASE WHEN :bin_id = 0 THEN file_key END,
+			compression=CASE WHEN :bin_id = 0 THEN compression ELSE '' END,
+			committed=CASE WHEN :bin_id = 0 THEN committed ELSE 0 END,
+			stored_size=CASE WHEN :bin_id = 0 THEN stored_size ELSE 0 END,
+			content_type=CASE WHEN :bin_id = 0 THEN content_type ELSE '' END,
+			filename=CASE WHEN :bin_id = 0 THEN filename ELSE :filename END,
+			mtime=CASE WHEN :bin_id = 0 THEN mtime ELSE :mtime END,
+			mode=CASE WHEN :bin_id = 0 THEN mode ELSE :mode END,
+			expires_at=:expires_at, update_at=now()
+		WHERE id=:id AND user_id=:user_id AND ` + notExpired + `;`,
 	}
 )
 
@@ -139,24 +207,23 @@ func (p *PgxStore) ReadUser(ctx context.Context, userID string) (res storage.Use
 	return
 }
 
-// ReadList читаем баланс пользователя
+// ReadList читаем баланс пользователя, элементы с истёкшим сроком не учитываются
 func (p *PgxStore) ReadList(ctx context.Context, userID string) (res storage.ListData, err error) {
 	query := `
 	SELECT
 		users.user_id AS user_id,
-		COUNT(DISTINCT notes.id) AS notes_count,
-		COUNT(DISTINCT cards.id) AS cards_count,
-		COUNT(DISTINCT binaries.id) AS binaries_count,
-		COUNT(DISTINCT passwords.id) AS passwords_count
+		(SELECT COUNT(*) FROM notes WHERE notes.user_id = users.user_id AND ` + notExpired + `) AS notes_count,
+		(SELECT COUNT(*) FROM cards WHERE cards.user_id = users.user_id AND ` + notExpired + `) AS cards_count,
+		(SELECT COUNT(*) FROM binaries WHERE binaries.user_id = users.user_id AND ` + notExpired + `) AS binaries_count,
+		(SELECT COUNT(*) FROM passwords WHERE passwords.user_id = users.user_id AND ` + notExpired + `) AS passwords_count,
+		(SELECT COUNT(*) FROM items WHERE items.user_id = users.user_id AND ` + notExpired + `) AS items_count,
+		(SELECT COUNT(*) FROM otps WHERE otps.user_id = users.user_id AND ` + notExpired + `) AS otps_count,
+		(SELECT COUNT(*) FROM ssh_keys WHERE ssh_keys.user_id = users.user_id AND ` + notExpired + `) AS ssh_keys_count,
+		(SELECT COALESCE(SUM(size), 0) FROM binaries
+			WHERE binaries.user_id = users.user_id AND ` + notExpired + `) AS binaries_size
 	FROM users
-		LEFT JOIN notes ON users.user_id = notes.user_id
-		LEFT JOIN cards ON users.user_id = cards.user_id
-		LEFT JOIN binaries ON users.user_id = binaries.user_id
-		LEFT JOIN passwords ON users.user_id = passwords.user_id
-	WHERE 
-		users.user_id = $1
-	GROUP BY 
-		users.user_id;`
+	WHERE
+		users.user_id = $1;`
 
 	if err = p.db.GetContext(ctx, &res, query, userID); err != nil {
 		err = errNoContent(err)
@@ -167,8 +234,8 @@ func (p *PgxStore) ReadList(ctx context.Context, userID string) (res storage.Lis
 // Passwords //
 
 // PasswordList список наименований паролей
-func (p *PgxStore) PasswordList(ctx context.Context, userID string) ([]string, error) {
-	return p.namesList(ctx, "passwords", userID)
+func (p *PgxStore) PasswordList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return p.entriesList(ctx, "passwords", q)
 }
 
 // PasswordWrite запись нового пароля
@@ -195,8 +262,8 @@ func (p *PgxStore) PasswordUpdate(ctx context.Context, data storage.PasswordData
 // Cards //
 
 // CardList список наименований карт
-func (p *PgxStore) CardList(ctx context.Context, userID string) ([]string, error) {
-	return p.namesList(ctx, "cards", userID)
+func (p *PgxStore) CardList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return p.entriesList(ctx, "cards", q)
 }
 
 // CardWrite запись новой карты
@@ -224,8 +291,8 @@ func (p *PgxStore) CardUpdate(ctx context.Context, data storage.CardData) error
 // Notes //
 
 // NoteList список наименовайний заметок
-func (p *PgxStore) NoteList(ctx context.Context, userID string) ([]string, error) {
-	return p.namesList(ctx, "notes", userID)
+func (p *PgxStore) NoteList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return p.entriesList(ctx, "notes", q)
 }
 
 // NoteWrite запись новой заметки в базу
@@ -253,125 +320,143 @@ func (p *PgxStore) NoteUpdate(ctx context.Context, data storage.NoteData) error
 // Binary //
 
 // BinaryList список наименований двоичных данных
-func (p *PgxStore) BinaryList(ctx context.Context, userID string) ([]string, error) {
-	return p.namesList(ctx, "binaries", userID)
+func (p *PgxStore) BinaryList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return p.entriesList(ctx, "binaries", q)
 }
 
 // BinaryWrite запись нового бинарника, возвращаем идентификатор бинарника.
+// Большой объект создаётся в той же транзакции, что и запись.
 func (p *PgxStore) BinaryWrite(ctx context.Context, data storage.BinaryData) (int64, error) {
-	var err error
-
-	// Запросим новый идентификатор большого объекта
-	if data.BinID == 0 {
-		query := `SELECT lo_creat(-1)`
-		err = p.db.GetContext(ctx, &data.BinID, query)
-		if err != nil {
-			return 0, err
+	err := p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		// Запросим новый идентификатор большого объекта
+		if data.BinID == 0 {
+			query := `SELECT lo_creat(-1)`
+			if err := tx.GetContext(ctx, &data.BinID, query); err != nil {
+				return err
+			}
 		}
-	}
-
-	err = p.Write(ctx, data)
+		return execWrite(ctx, tx, data)
+	})
 	if err != nil {
 		return 0, errNoContent(err)
 	}
-
-	return data.BinID, errNoContent(err)
+	return data.BinID, nil
 }
 
-// BinaryRead чтение бинарных данных
-func (p *PgxStore) BinaryRead(ctx context.Context, userID, name string) (res storage.BinaryData, err error) {
-	err = p.readFirstByName(ctx, &res, userID, name)
+// BinarySize заявленный размер файла по идентификатору бинарника
+func (p *PgxStore) BinarySize(ctx context.Context, binID int64) (size int64, err error) {
+	query := `SELECT size FROM binaries WHERE bin_id = $1`
+
+	if err = p.db.GetContext(ctx, &size, query, binID); err != nil {
+		err = errNoContent(err)
+	}
 	return
 }
 
-// BinaryDelete удаление бинарника
-func (p *PgxStore) BinaryDelete(ctx context.Context, userID, name string) (err error) {
-	tx, err := p.db.BeginTxx(ctx, nil)
+// BinaryUploaded отметка о завершении загрузки содержимого с его дайджестом
+func (p *PgxStore) BinaryUploaded(ctx context.Context, binID int64, sha256 []byte) error {
+	query := `UPDATE binaries SET uploaded = TRUE, sha256 = $2, hash_state = NULL
+		WHERE bin_id = $1`
+
+	res, err := p.db.ExecContext(ctx, query, binID, sha256)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
-			logger.Error(fmt.Errorf("psql rollbacck error: %w", err))
-		}
-	}()
+	if n, e := res.RowsAffected(); n == 0 && e == nil {
+		return storage.ErrNoContent
+	}
+	return nil
+}
 
-	query := `SELECT lo_unlink(bin_id) 
-		FROM binaries WHERE user_id=$1 AND name=$2`
+// BinaryUploadStatus состояние загрузки файла пользователя
+func (p *PgxStore) BinaryUploadStatus(ctx context.Context, userID string, binID int64) (res storage.UploadStatus, err error) {
+	query := `SELECT size, uploaded, hash_state, file_key, compression, committed, stored_size
+		FROM binaries WHERE user_id = $1 AND bin_id = $2 AND ` + notExpired
 
-	_, err = tx.ExecContext(ctx, query, userID, name)
-	if err != nil {
-		return err
-	}
-	err = p.deleteByName(ctx, "binaries", userID, name)
-	if err != nil {
-		return err
+	if err = p.db.GetContext(ctx, &res, query, userID, binID); err != nil {
+		err = errNoContent(err)
 	}
+	return
+}
 
-	return tx.Commit()
+// BinaryRead чтение бинарных данных
+func (p *PgxStore) BinaryRead(ctx context.Context, userID, name string) (res storage.BinaryData, err error) {
+	err = p.readFirstByName(ctx, &res, userID, name)
+	return
 }
 
-// BinaryUpdate обновление бинарника
-func (p *PgxStore) BinaryUpdate(ctx context.Context, data storage.BinaryData) error {
-	var (
-		err error
-		tx  *sqlx.Tx
-	)
+// BinaryDelete удаление бинарника
+func (p *PgxStore) BinaryDelete(ctx context.Context, userID, name string) error {
+	return p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		query := `SELECT lo_unlink(bin_id)
+			FROM binaries WHERE user_id=$1 AND name=$2 AND ` + notExpired
 
-	if data.BinID != 0 {
-		tx, err = p.db.BeginTxx(ctx, nil)
-		if err != nil {
+		if _, err := tx.ExecContext(ctx, query, userID, name); err != nil {
 			return err
 		}
-		defer func() {
-			if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
-				logger.Error(fmt.Errorf("psql rollbacck error: %w", err))
+		return execDelete(ctx, tx, "binaries", userID, name)
+	})
+}
+
+// BinaryUpdate обновление бинарника
+// При указании бинарника его содержимое очищается в той же транзакции.
+func (p *PgxStore) BinaryUpdate(ctx context.Context, data storage.BinaryData) error {
+	return p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		if data.BinID != 0 {
+			if _, err := tx.ExecContext(ctx, "SELECT lo_unlink($1)", data.BinID); err != nil {
+				return err
 			}
-		}()
+			if _, err := tx.ExecContext(ctx, "SELECT lo_create($1)", data.BinID); err != nil {
+				return err
+			}
+		}
+		return execUpdate(ctx, tx, data)
+	})
+}
 
-		_, err = tx.ExecContext(ctx, "SELECT lo_unlink($1)", data.BinID)
-		if err != nil {
+// Rename переименование элемента, идентификатор и содержимое сохраняются.
+// Элемент с истёкшим сроком под новым наименованием удаляется.
+func (p *PgxStore) Rename(ctx context.Context, kind, userID, name, newName string) error {
+	tabname, ok := kindTables[kind]
+	if !ok {
+		return errUnkmownDataType
+	}
+
+	return p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		if err := purgeExpired(ctx, tx, tabname, userID, newName); err != nil {
 			return err
 		}
-		_, err = tx.ExecContext(ctx, "SELECT lo_create($1)", data.BinID)
+
+		query := `UPDATE ` + tabname + `
+			SET name=$3, update_at=now()
+			WHERE user_id=$1 AND name=$2 AND ` + notExpired + `;`
+
+		res, err := tx.ExecContext(ctx, query, userID, name, newName)
 		if err != nil {
-			return err
+			return errWriteConflict(err)
 		}
-	}
-
-	err = p.Update(ctx, data)
-	if err != nil {
-		return err
-	}
-	if tx != nil {
-		return tx.Commit()
-	}
-	return nil
+		if n, e := res.RowsAffected(); n == 0 && e == nil {
+			return storage.ErrNoContent
+		}
+		return nil
+	})
 }
 
-// BinaryUpload запись фрагмента бинарника в хранилище бинарника
-func (p *PgxStore) BinaryUpload(ctx context.Context, data storage.BinaryChunk) error {
-	tx, err := p.db.BeginTxx(ctx, nil)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
-			logger.Error(fmt.Errorf("psql rollbacck error: %w", err))
-		}
-	}()
+//
 
-	query := `SELECT lo_put(:bin_id, :offset, :chunk);`
+func (p *PgxStore) Write(ctx context.Context, data any) error {
+	return execWrite(ctx, p.db, data)
+}
 
-	_, err = tx.NamedExecContext(ctx, query, data)
-	if err != nil {
-		return errNoContent(err)
-	}
-	return tx.Commit()
+func (p *PgxStore) Update(ctx context.Context, data any) error {
+	return execUpdate(ctx, p.db, data)
 }
 
-// BinaryDownload обновление бинарника
-func (p *PgxStore) BinaryDownload(ctx context.Context, data *storage.BinaryChunk) error {
+// WithTx единица работы: fn выполняется в транзакции, которая
+// фиксируется при успешном завершении и откатывается при ошибке или панике.
+// Многошаговые операции над таблицами и большими объектами выполняются
+// только через неё, чтобы не оставлять ссылок на удалённые объекты.
+func (p *PgxStore) WithTx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
 	tx, err := p.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
@@ -382,123 +467,300 @@ func (p *PgxStore) BinaryDownload(ctx context.Context, data *storage.BinaryChunk
 		}
 	}()
 
-	query := `SELECT lo_get($1, $2, $3);`
-
-	err = tx.GetContext(ctx, &data.Chunk, query, data.BinID, data.Offset, cap(data.Chunk))
-	if err != nil {
-		return errNoContent(err)
+	if err = fn(tx); err != nil {
+		return err
 	}
 	return tx.Commit()
 }
 
-//
+// Batch выполнение пакета операций в одной транзакции.
+// Возвращает ошибки операций в порядке их следования. Без bestEffort
+// первая ошибка откатывает весь пакет, остальные операции получают
+// storage.ErrBatchAborted. С bestEffort каждая операция выполняется
+// в точке сохранения, ошибочные откатываются не затрагивая остальные.
+func (p *PgxStore) Batch(ctx context.Context, userID string, ops []storage.BatchOp, bestEffort bool) ([]error, error) {
+	results := make([]error, len(ops))
+
+	err := p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		for i, op := range ops {
+			if bestEffort {
+				if _, err := tx.ExecContext(ctx, "SAVEPOINT batch_op"); err != nil {
+					return err
+				}
+			}
 
-func (p *PgxStore) Write(ctx context.Context, data any) error {
-	tx, err := p.db.BeginTxx(ctx, nil)
+			results[i] = execBatchOp(ctx, tx, userID, op)
+			if results[i] == nil {
+				if bestEffort {
+					if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT batch_op"); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			if !bestEffort {
+				for j := range results {
+					if j != i {
+						results[j] = storage.ErrBatchAborted
+					}
+				}
+				return errBatchRollback
+			}
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_op"); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchRollback) {
+		return results, nil
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer func() {
-		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
-			logger.Error(fmt.Errorf("psql rollbacck error: %w", err))
-		}
-	}()
+	return results, nil
+}
 
-	var query string
-	switch data.(type) {
-	case storage.UserData:
-		query = writeQuery["users"]
-	case storage.PasswordData:
-		query = writeQuery["passwords"]
-	case storage.CardData:
-		query = writeQuery["cards"]
-	case storage.NoteData:
-		query = writeQuery["notes"]
-	case storage.BinaryData:
-		query = writeQuery["binaries"]
+func (p *PgxStore) deleteByName(ctx context.Context, tabname, userID, name string) error {
+	return execDelete(ctx, p.db, tabname, userID, name)
+}
+
+func execBatchOp(ctx context.Context, e sqlx.ExtContext, userID string, op storage.BatchOp) error {
+	switch op.Action {
+	case storage.OpCreate:
+		return execWrite(ctx, e, op.Data)
+	case storage.OpUpdate:
+		return execUpdate(ctx, e, op.Data)
+	case storage.OpDelete:
+		tabname, ok := kindTables[op.Kind]
+		if !ok {
+			return errUnkmownDataType
+		}
+		return execDelete(ctx, e, tabname, userID, op.Name)
 	default:
-		return errUnkmownDataType
+		return fmt.Errorf("unknown batch action %q", op.Action)
 	}
+}
 
-	_, err = tx.NamedExecContext(ctx, query, data)
+func execWrite(ctx context.Context, e sqlx.ExtContext, data any) error {
+	tabname, err := tableOf(data)
 	if err != nil {
-		return errWriteConflict(err)
+		return err
+	}
+	if err = purgeExpiredOf(ctx, e, tabname, data); err != nil {
+		return err
+	}
+	if item, ok := data.(storage.ItemData); ok {
+		return writeItem(ctx, e, item)
 	}
 
-	return tx.Commit()
+	_, err = sqlx.NamedExecContext(ctx, e, writeQuery[tabname], data)
+	return errWriteConflict(err)
 }
 
-func (p *PgxStore) Update(ctx context.Context, data any) error {
-	tx, err := p.db.BeginTxx(ctx, nil)
+func execUpdate(ctx context.Context, e sqlx.ExtContext, data any) error {
+	tabname, err := tableOf(data)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
-			logger.Error(fmt.Errorf("psql rollbacck error: %w", err))
-		}
-	}()
-
-	var query string
-	switch data.(type) {
-	case storage.UserData:
-		query = updateQuery["users"]
-	case storage.PasswordData:
-		query = updateQuery["passwords"]
-	case storage.CardData:
-		query = updateQuery["cards"]
-	case storage.NoteData:
-		query = updateQuery["notes"]
-	case storage.BinaryData:
-		query = updateQuery["binaries"]
-	default:
-		return errUnkmownDataType
+	if err = purgeExpiredOf(ctx, e, tabname, data); err != nil {
+		return err
 	}
-
-	if _, err = tx.NamedExecContext(ctx, query, data); err != nil {
-		return errNoContent(errWriteConflict(err))
+	if item, ok := data.(storage.ItemData); ok {
+		return updateItem(ctx, e, item)
 	}
-	return tx.Commit()
-}
 
-func (p *PgxStore) deleteByName(ctx context.Context, tabname, userID, name string) error {
-	tx, err := p.db.BeginTxx(ctx, nil)
+	res, err := sqlx.NamedExecContext(ctx, e, updateQuery[tabname], data)
 	if err != nil {
-		return err
+		return errNoContent(errWriteConflict(err))
 	}
-	defer func() {
-		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
-			logger.Error(fmt.Errorf("psql rollbacck error: %w", err))
-		}
-	}()
+	if n, e := res.RowsAffected(); n == 0 && e == nil {
+		return storage.ErrNoContent
+	}
+	return nil
+}
 
+func execDelete(ctx context.Context, e sqlx.ExecerContext, tabname, userID, name string) error {
 	query := `DELETE FROM ` + tabname +
-		` WHERE user_id=$1 AND name=$2;`
+		` WHERE user_id=$1 AND name=$2 AND ` + notExpired + `;`
 
-	res, err := tx.ExecContext(ctx, query, userID, name)
+	res, err := e.ExecContext(ctx, query, userID, name)
 	if err != nil {
 		return err
 	}
 	if n, e := res.RowsAffected(); n == 0 && e == nil {
 		return storage.ErrNoContent
 	}
-	return tx.Commit()
+	return nil
 }
 
-func (p *PgxStore) namesList(ctx context.Context, tabname, userID string) ([]string, error) {
-	query := `SELECT name FROM ` + tabname +
-		` WHERE user_id = $1`
+// purgeQuery запрос удаления элемента пользователя $1 с наименованием $2,
+// срок хранения которого истёк, вместе с содержимым файла
+func purgeQuery(tabname string) string {
+	query := `DELETE FROM ` + tabname + ` WHERE user_id = $1 AND name = $2 AND expires_at <= now()`
+	if tabname == "binaries" {
+		query = `WITH d AS (` + query + ` RETURNING bin_id) SELECT lo_unlink(bin_id) FROM d`
+	}
+	return query
+}
 
-	res := make([]string, 0)
-	err := p.db.SelectContext(ctx, &res, query, userID)
+// purgeExpired освобождение наименования name, занятого элементом
+// с истёкшим сроком, см. purgeQuery
+func purgeExpired(ctx context.Context, e sqlx.ExecerContext, tabname, userID, name string) error {
+	_, err := e.ExecContext(ctx, purgeQuery(tabname), userID, name)
+	return err
+}
 
-	if err != nil {
-		err = errNoContent(err)
-		return nil, err
+// purgeExpiredOf удаление элемента с истёкшим сроком, занимающего
+// наименование записываемых данных, см. purgeExpired
+func purgeExpiredOf(ctx context.Context, e sqlx.ExecerContext, tabname string, data any) error {
+	switch d := data.(type) {
+	case storage.PasswordData:
+		return purgeExpired(ctx, e, tabname, d.UserID, d.Name)
+	case storage.CardData:
+		return purgeExpired(ctx, e, tabname, d.UserID, d.Name)
+	case storage.NoteData:
+		return purgeExpired(ctx, e, tabname, d.UserID, d.Name)
+	case storage.BinaryData:
+		return purgeExpired(ctx, e, tabname, d.UserID, d.Name)
+	case storage.ItemData:
+		return purgeExpired(ctx, e, tabname, d.UserID, d.Name)
+	case storage.OtpData:
+		return purgeExpired(ctx, e, tabname, d.UserID, d.Name)
+	case storage.SshKeyData:
+		return purgeExpired(ctx, e, tabname, d.UserID, d.Name)
+	}
+	return nil
+}
+
+// tableOf наименование таблицы по типу данных
+func tableOf(data any) (string, error) {
+	switch data.(type) {
+	case storage.UserData:
+		return "users", nil
+	case storage.PasswordData:
+		return "passwords", nil
+	case storage.CardData:
+		return "cards", nil
+	case storage.NoteData:
+		return "notes", nil
+	case storage.BinaryData:
+		return "binaries", nil
+	case storage.ItemData:
+		return "items", nil
+	case storage.OtpData:
+		return "otps", nil
+	case storage.SshKeyData:
+		return "ssh_keys", nil
+	default:
+		return "", errUnkmownDataType
+	}
+}
+
+// entriesList выборка страницы списка с фильтрацией и сортировкой.
+// Пагинация по ключу: (поле сортировки, id) последней записи страницы.
+func (p *PgxStore) entriesList(ctx context.Context, tabname string, q storage.ListQuery) ([]storage.ListEntry, error) {
+	column, ok := sortColumns[q.Sort]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort %q", q.Sort)
+	}
+	column = "t." + column
+
+	size := "0"
+	if tabname == "binaries" {
+		size = "t.size"
+	}
+	tagColumn := tagColumns[tabname]
+
+	query := `SELECT t.id, t.name, ` + size + ` AS size, t.create_at, t.update_at, t.expires_at,
+			COALESCE(f.path, '') AS folder,
+			(SELECT COALESCE(string_agg(tg.name, ',' ORDER BY tg.name), '')
+				FROM item_tags it JOIN tags tg ON tg.id = it.tag_id
+				WHERE it.` + tagColumn + ` = t.id) AS tags
+		FROM ` + tabname + ` t
+			LEFT JOIN folders f ON f.id = t.folder_id
+		WHERE t.user_id = $1 AND (t.expires_at IS NULL OR t.expires_at > now())`
+	args := []any{q.UserID}
+
+	if q.Prefix != "" {
+		args = append(args, likeEscape(q.Prefix)+"%")
+		query += fmt.Sprintf(" AND t.name LIKE $%d", len(args))
+	}
+	if q.Glob != "" {
+		args = append(args, globToLike(q.Glob))
+		query += fmt.Sprintf(" AND t.name LIKE $%d", len(args))
+	}
+	if q.Tag != "" {
+		args = append(args, q.Tag)
+		query += fmt.Sprintf(` AND EXISTS (SELECT 1 FROM item_tags it JOIN tags tg ON tg.id = it.tag_id
+			WHERE it.%s = t.id AND tg.name = $%d)`, tagColumn, len(args))
+	}
+	switch {
+	case q.Folder == "":
+	case q.Folder == "/" && !q.Recursive:
+		query += " AND t.folder_id IS NULL"
+	case q.Folder == "/":
+	case q.Recursive:
+		args = append(args, q.Folder, likeEscape(q.Folder)+"/%")
+		query += fmt.Sprintf(" AND (f.path = $%d OR f.path LIKE $%d)", len(args)-1, len(args))
+	default:
+		args = append(args, q.Folder)
+		query += fmt.Sprintf(" AND f.path = $%d", len(args))
+	}
+
+	order, cmp := "ASC", ">"
+	if q.Desc {
+		order, cmp = "DESC", "<"
+	}
+	if q.After != nil {
+		var key any
+		switch q.Sort {
+		case storage.SortCreated:
+			key = q.After.CtreatAt
+		case storage.SortUpdated:
+			key = q.After.UpdateAt
+		default:
+			key = q.After.Name
+		}
+		args = append(args, key, q.After.ID)
+		query += fmt.Sprintf(" AND (%s, t.id) %s ($%d, $%d)", column, cmp, len(args)-1, len(args))
+	}
+
+	query += fmt.Sprintf(" ORDER BY %s %s, t.id %s", column, order, order)
+	if q.Limit > 0 {
+		args = append(args, q.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	res := make([]storage.ListEntry, 0)
+	if err := p.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, errNoContent(err)
 	}
 	return res, nil
 }
 
+// likeEscape экранирование спецсимволов LIKE
+func likeEscape(s string) string {
+	return likeReplacer.Replace(s)
+}
+
+// globToLike преобразование шаблона с * и ? в шаблон LIKE
+func globToLike(glob string) string {
+	var b strings.Builder
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteByte('%')
+		case '?':
+			b.WriteByte('_')
+		default:
+			b.WriteString(likeEscape(string(r)))
+		}
+	}
+	return b.String()
+}
+
 func (p *PgxStore) readFirstByName(ctx context.Context, res any, userID, name string) error {
 	var tabname string
 
@@ -511,12 +773,18 @@ func (p *PgxStore) readFirstByName(ctx context.Context, res any, userID, name st
 		tabname = "passwords"
 	case *storage.BinaryData:
 		tabname = "binaries"
+	case *storage.ItemData:
+		tabname = "items"
+	case *storage.OtpData:
+		tabname = "otps"
+	case *storage.SshKeyData:
+		tabname = "ssh_keys"
 	default:
 		return errUnkmownDataType
 	}
 
 	query := `SELECT * FROM ` + tabname +
-		` WHERE user_id = $1 AND name = $2 LIMIT 1`
+		` WHERE user_id = $1 AND name = $2 AND ` + notExpired + ` LIMIT 1`
 
 	err := p.db.GetContext(ctx, res, query, userID, name)
 	return errNoContent(err)
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestGlobToLike(t *testing.T) {
+	tests := []struct {
+		glob string
+		want string
+	}{
+		{glob: "mail*", want: "mail%"},
+		{glob: "?ank*card", want: "_ank%card"},
+		{glob: "100%_off", want: `100\%\_off`},
+		{glob: `c:\dir`, want: `c:\\dir`},
+	}
+	for _, tcase := range tests {
+		t.Run(tcase.glob, func(t *testing.T) {
+			assert.Equal(t, tcase.want, globToLike(tcase.glob))
+		})
+	}
+}
+
+func TestExpiredItems(t *testing.T) {
+	p := testStore(t)
+	ctx := context.Background()
+	user := "expiry-test"
+
+	_, err := p.db.ExecContext(ctx, `INSERT INTO users (user_id, passwd_hash)
+		VALUES ($1, '') ON CONFLICT DO NOTHING`, user)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		p.NoteDelete(ctx, user, "code")
+		p.NoteDelete(ctx, user, "later")
+	})
+
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, p.NoteWrite(ctx, storage.NoteData{UserID: user, Name: "code", Notes: []byte("1"), ExpiresAt: &past}))
+	require.NoError(t, p.NoteWrite(ctx, storage.NoteData{UserID: user, Name: "later", Notes: []byte("2"), ExpiresAt: &future}))
+
+	// истёкший элемент не читается и не попадает в список
+	_, err = p.NoteRead(ctx, user, "code")
+	assert.ErrorIs(t, err, storage.ErrNoContent)
+	entries, err := p.NoteList(ctx, storage.ListQuery{UserID: user, Sort: storage.SortName})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "later", entries[0].Name)
+	require.NotNil(t, entries[0].ExpiresAt)
+	assert.WithinDuration(t, future, *entries[0].ExpiresAt, time.Second)
+
+	report, err := p.CollectGarbage(ctx, time.Hour, true)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, report.Expired, 1)
+
+	// наименование истёкшего элемента свободно для записи
+	require.NoError(t, p.NoteWrite(ctx, storage.NoteData{UserID: user, Name: "code", Notes: []byte("3")}))
+	note, err := p.NoteRead(ctx, user, "code")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("3"), note.Notes)
+	assert.Nil(t, note.ExpiresAt)
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// PlainBinaries идентификаторы загруженных бинарников,
+// содержимое которых хранится без шифрования
+func (p *PgxStore) PlainBinaries(ctx context.Context) ([]int64, error) {
+	query := `SELECT bin_id FROM binaries
+		WHERE uploaded AND file_key IS NULL
+		ORDER BY id`
+
+	res := make([]int64, 0)
+	if err := p.db.SelectContext(ctx, &res, query); err != nil {
+		return nil, errNoContent(err)
+	}
+	return res, nil
+}
+
+// BinaryRewrite перенос содержимого бинарника без шифрования в новый
+// большой объект. Исходный объект читается блоками blockSize, каждый
+// блок преобразуется fn и пишется следом за предыдущим. Запись переходит
+// на новый объект вместе с ключом fileKey, старый удаляется, всё в одной
+// транзакции. Возвращает новый идентификатор бинарника.
+func (p *PgxStore) BinaryRewrite(ctx context.Context, binID int64, fileKey []byte, blockSize int,
+	fn func(index int64, block []byte) ([]byte, error)) (int64, error) {
+
+	var newID int64
+	err := p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		// блокируем запись, чтобы её не изменили во время переноса
+		var id int64
+		err := tx.GetContext(ctx, &id, `SELECT id FROM binaries
+			WHERE bin_id = $1 AND file_key IS NULL
+			FOR UPDATE`, binID)
+		if err != nil {
+			return err
+		}
+
+		if err = tx.GetContext(ctx, &newID, `SELECT lo_creat(-1)`); err != nil {
+			return err
+		}
+
+		var read, written int64
+		for index := int64(0); ; index++ {
+			var block []byte
+			err = tx.GetContext(ctx, &block, `SELECT lo_get($1, $2, $3)`,
+				binID, index*int64(blockSize), blockSize)
+			if err != nil {
+				return err
+			}
+			if len(block) == 0 {
+				break
+			}
+
+			out, err := fn(index, block)
+			if err != nil {
+				return err
+			}
+			if _, err = tx.ExecContext(ctx, `SELECT lo_put($1, $2, $3)`, newID, written, out); err != nil {
+				return err
+			}
+			read += int64(len(block))
+			written += int64(len(out))
+
+			if len(block) < blockSize {
+				break
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE binaries SET bin_id = $2, file_key = $3,
+			committed = $4, stored_size = $5
+			WHERE id = $1`, id, newID, fileKey, read, written)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `SELECT lo_unlink($1)`, binID)
+		return err
+	})
+	if err != nil {
+		return 0, errNoContent(err)
+	}
+	return newID, nil
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestBinaryRewrite(t *testing.T) {
+	ctx := context.Background()
+	upper := func(_ int64, block []byte) ([]byte, error) {
+		return append([]byte("<"), block...), nil
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM binaries`).WithArgs(int64(42)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(`SELECT lo_creat\(-1\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"lo_creat"}).AddRow(43))
+		mock.ExpectQuery(`SELECT lo_get`).WithArgs(int64(42), int64(0), 4).
+			WillReturnRows(sqlmock.NewRows([]string{"lo_get"}).AddRow([]byte("abcd")))
+		mock.ExpectExec(`SELECT lo_put`).WithArgs(int64(43), int64(0), []byte("<abcd")).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`SELECT lo_get`).WithArgs(int64(42), int64(4), 4).
+			WillReturnRows(sqlmock.NewRows([]string{"lo_get"}).AddRow([]byte("ef")))
+		mock.ExpectExec(`SELECT lo_put`).WithArgs(int64(43), int64(5), []byte("<ef")).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE binaries SET bin_id`).WithArgs(int64(1), int64(43), []byte("key"), int64(6), int64(8)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`SELECT lo_unlink`).WithArgs(int64(42)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		id, err := p.BinaryRewrite(ctx, 42, []byte("key"), 4, upper)
+		require.NoError(t, err)
+		assert.Equal(t, int64(43), id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already encrypted", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM binaries`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		_, err := p.BinaryRewrite(ctx, 42, []byte("key"), 4, upper)
+		assert.ErrorIs(t, err, storage.ErrNoContent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("transform error", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM binaries`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(`SELECT lo_creat\(-1\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"lo_creat"}).AddRow(43))
+		mock.ExpectQuery(`SELECT lo_get`).
+			WillReturnRows(sqlmock.NewRows([]string{"lo_get"}).AddRow([]byte("abcd")))
+		// новый объект не остаётся, старый не удаляется
+		mock.ExpectRollback()
+
+		_, err := p.BinaryRewrite(ctx, 42, []byte("key"), 4, func(int64, []byte) ([]byte, error) {
+			return nil, errors.New("seal error")
+		})
+		assert.EqualError(t, err, "seal error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// searchKinds порядок видов данных в поиске
+var searchKinds = []string{
+	storage.KindPassword,
+	storage.KindCard,
+	storage.KindNote,
+	storage.KindBinary,
+	storage.KindItem,
+	storage.KindOtp,
+	storage.KindSshKey,
+}
+
+// Search нечёткий поиск по наименованиям, меткам, для паролей ещё
+// по адресу и открытому имени пользователя, для произвольных элементов
+// по типу, для одноразовых паролей по издателю, для ключей SSH по комментарию
+// и отпечатку. Для каждого элемента берётся лучшее совпадение, результат
+// упорядочен по убыванию схожести.
+func (p *PgxStore) Search(ctx context.Context, q storage.SearchQuery) ([]storage.SearchResult, error) {
+	kinds := q.Kinds
+	if len(kinds) == 0 {
+		kinds = searchKinds
+	}
+
+	parts := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		tabname, ok := kindTables[kind]
+		if !ok {
+			return nil, errUnkmownDataType
+		}
+		parts = append(parts, searchPart(kind, tabname))
+	}
+
+	query := strings.Join(parts, "\nUNION ALL\n") + `
+		ORDER BY score DESC, kind, name
+		LIMIT $3`
+
+	res := make([]storage.SearchResult, 0)
+	if err := p.db.SelectContext(ctx, &res, query, q.UserID, q.Text, q.Limit); err != nil {
+		return nil, errNoContent(err)
+	}
+	return res, nil
+}
+
+// searchPart запрос поиска по одной таблице
+func searchPart(kind, tabname string) string {
+	tagColumn := tagColumns[tabname]
+
+	fields := []string{`(word_similarity($2, t.name), 'name')`}
+	where := []string{`$2 <% t.name`}
+	if tabname == "passwords" {
+		fields = append(fields,
+			`(word_similarity($2, t.url), 'url')`,
+			`(word_similarity($2, t.search_username), 'username')`)
+		where = append(where, `$2 <% t.url`, `$2 <% t.search_username`)
+	}
+	if tabname == "items" {
+		fields = append(fields, `(word_similarity($2, t.item_type), 'type')`)
+		where = append(where, `$2 <% t.item_type`)
+	}
+	if tabname == "otps" {
+		fields = append(fields, `(word_similarity($2, t.issuer), 'issuer')`)
+		where = append(where, `$2 <% t.issuer`)
+	}
+	if tabname == "ssh_keys" {
+		fields = append(fields,
+			`(word_similarity($2, t.comment), 'comment')`,
+			`(word_similarity($2, t.fingerprint), 'fingerprint')`)
+		where = append(where, `$2 <% t.comment`, `$2 <% t.fingerprint`)
+	}
+	fields = append(fields, `((SELECT COALESCE(max(word_similarity($2, tg.name)), 0)
+				FROM item_tags it JOIN tags tg ON tg.id = it.tag_id
+				WHERE it.`+tagColumn+` = t.id), 'tag')`)
+	where = append(where, `EXISTS (SELECT 1
+			FROM item_tags it JOIN tags tg ON tg.id = it.tag_id
+			WHERE it.`+tagColumn+` = t.id AND $2 <% tg.name)`)
+
+	return `SELECT '` + kind + `' AS kind, t.name, m.score, m.matched
+		FROM ` + tabname + ` t
+			CROSS JOIN LATERAL (
+				SELECT v.score::float8 AS score, v.matched
+				FROM (VALUES ` + strings.Join(fields, ",\n\t\t\t\t\t") + `) v(score, matched)
+				ORDER BY v.score DESC
+				LIMIT 1) m
+		WHERE t.user_id = $1 AND (t.expires_at IS NULL OR t.expires_at > now())
+			AND (` + strings.Join(where, " OR ") + `)`
+}
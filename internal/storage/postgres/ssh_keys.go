@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+// SshKeyList список наименований ключей SSH
+func (p *PgxStore) SshKeyList(ctx context.Context, q storage.ListQuery) ([]storage.ListEntry, error) {
+	return p.entriesList(ctx, "ssh_keys", q)
+}
+
+// SshKeyWrite запись нового ключа
+func (p *PgxStore) SshKeyWrite(ctx context.Context, data storage.SshKeyData) error {
+	return p.Write(ctx, data)
+}
+
+// SshKeyRead чтение ключа
+func (p *PgxStore) SshKeyRead(ctx context.Context, userID, name string) (res storage.SshKeyData, err error) {
+	err = p.readFirstByName(ctx, &res, userID, name)
+	return
+}
+
+// SshKeyDelete удаление ключа
+func (p *PgxStore) SshKeyDelete(ctx context.Context, userID, name string) error {
+	return p.deleteByName(ctx, "ssh_keys", userID, name)
+}
+
+// SshKeyUpdate обновление ключа
+func (p *PgxStore) SshKeyUpdate(ctx context.Context, data storage.SshKeyData) error {
+	return p.Update(ctx, data)
+}
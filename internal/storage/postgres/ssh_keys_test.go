@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func TestSshKeys(t *testing.T) {
+	p := testStore(t)
+	ctx := context.Background()
+	user := "ssh-keys-test"
+
+	_, err := p.db.ExecContext(ctx, `INSERT INTO users (user_id, passwd_hash)
+		VALUES ($1, '') ON CONFLICT DO NOTHING`, user)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		p.SshKeyDelete(ctx, user, "deploy")
+	})
+
+	key := storage.SshKeyData{UserID: user, Name: "deploy", Type: "ed25519", Bits: 256,
+		PublicKey: "ssh-ed25519 AAAA deploy@ci", PrivateKey: []byte("private"),
+		Comment: "deploy@ci", Fingerprint: "SHA256:abc"}
+	require.NoError(t, p.SshKeyWrite(ctx, key))
+	assert.ErrorIs(t, p.SshKeyWrite(ctx, key), storage.ErrWriteConflict)
+
+	data, err := p.SshKeyRead(ctx, user, "deploy")
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey, data.PublicKey)
+	assert.Equal(t, key.PrivateKey, data.PrivateKey)
+
+	data.Comment = "deploy@prod"
+	require.NoError(t, p.SshKeyUpdate(ctx, data))
+
+	res, err := p.Search(ctx, storage.SearchQuery{UserID: user, Text: "deploy@prod", Limit: 10,
+		Kinds: []string{storage.KindSshKey}})
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+	assert.Equal(t, "deploy", res[0].Name)
+
+	list, err := p.ReadList(ctx, user)
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.SshKeysCount)
+}
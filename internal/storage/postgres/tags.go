@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// TagAdd добавление меток элементу, новые метки создаются
+func (p *PgxStore) TagAdd(ctx context.Context, kind, userID, name string, tags []string) error {
+	tabname, ok := kindTables[kind]
+	if !ok {
+		return errUnkmownDataType
+	}
+
+	return p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		id, err := itemID(ctx, tx, tabname, userID, name)
+		if err != nil {
+			return err
+		}
+
+		for _, tag := range tags {
+			var tagID int64
+			err = tx.GetContext(ctx, &tagID, `INSERT INTO tags (user_id, name)
+				VALUES ($1, $2)
+				ON CONFLICT (user_id, name) DO UPDATE SET name = EXCLUDED.name
+				RETURNING id`, userID, tag)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.ExecContext(ctx, `INSERT INTO item_tags (tag_id, `+tagColumns[tabname]+`)
+				VALUES ($1, $2)
+				ON CONFLICT DO NOTHING`, tagID, id)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// TagRemove снятие меток с элемента, неиспользуемые метки удаляются
+func (p *PgxStore) TagRemove(ctx context.Context, kind, userID, name string, tags []string) error {
+	tabname, ok := kindTables[kind]
+	if !ok {
+		return errUnkmownDataType
+	}
+
+	return p.WithTx(ctx, func(tx *sqlx.Tx) error {
+		id, err := itemID(ctx, tx, tabname, userID, name)
+		if err != nil {
+			return err
+		}
+
+		for _, tag := range tags {
+			_, err = tx.ExecContext(ctx, `DELETE FROM item_tags it
+				USING tags tg
+				WHERE it.tag_id = tg.id AND tg.user_id = $1 AND tg.name = $2
+					AND it.`+tagColumns[tabname]+` = $3`, userID, tag, id)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM tags
+			WHERE user_id = $1
+				AND NOT EXISTS (SELECT 1 FROM item_tags WHERE tag_id = tags.id)`, userID)
+		return err
+	})
+}
+
+// TagList список меток пользователя, назначенных хотя бы одному элементу
+func (p *PgxStore) TagList(ctx context.Context, userID string) ([]string, error) {
+	query := `SELECT name FROM tags
+		WHERE user_id = $1
+			AND EXISTS (SELECT 1 FROM item_tags WHERE tag_id = tags.id)
+		ORDER BY name`
+
+	res := make([]string, 0)
+	if err := p.db.SelectContext(ctx, &res, query, userID); err != nil {
+		return nil, errNoContent(err)
+	}
+	return res, nil
+}
+
+// itemID идентификатор элемента по наименованию, срок хранения которого не истёк
+func itemID(ctx context.Context, q sqlx.QueryerContext, tabname, userID, name string) (int64, error) {
+	var id int64
+	query := `SELECT id FROM ` + tabname + ` WHERE user_id = $1 AND name = $2 AND ` + notExpired
+	err := sqlx.GetContext(ctx, q, &id, query, userID, name)
+	return id, errNoContent(err)
+}
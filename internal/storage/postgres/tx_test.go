@@ -0,0 +1,269 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eugene982/yp-gophkeeper/internal/storage"
+)
+
+func newMockStore(t *testing.T) (*PgxStore, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return &PgxStore{db: sqlx.NewDb(db, "pgx")}, mock
+}
+
+// expectPurge ожидание освобождения наименования от элемента с истёкшим сроком
+func expectPurge(mock sqlmock.Sqlmock, tabname, userID, name string) {
+	mock.ExpectExec(`DELETE FROM `+tabname+` WHERE user_id = \$1 AND name = \$2 AND expires_at <= now\(\)`).
+		WithArgs(userID, name).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func TestWithTx(t *testing.T) {
+	ctx := context.Background()
+	stepErr := errors.New("step error")
+
+	t.Run("commit", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		require.NoError(t, p.WithTx(ctx, func(*sqlx.Tx) error { return nil }))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rollback on error", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		err := p.WithTx(ctx, func(*sqlx.Tx) error { return stepErr })
+		assert.ErrorIs(t, err, stepErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rollback on panic", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		assert.Panics(t, func() {
+			_ = p.WithTx(ctx, func(*sqlx.Tx) error { panic("step panic") })
+		})
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestBinaryDeleteAtomic(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		deleteErr error
+		deleted   int64
+		wantErr   error
+	}{
+		{name: "ok", deleted: 1},
+		{name: "delete error", deleteErr: errors.New("delete error"), wantErr: errors.New("delete error")},
+		{name: "not found", deleted: 0, wantErr: storage.ErrNoContent},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			p, mock := newMockStore(t)
+			mock.ExpectBegin()
+			mock.ExpectExec(`SELECT lo_unlink\(bin_id\)`).
+				WithArgs("user", "file").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			del := mock.ExpectExec(`DELETE FROM binaries`).WithArgs("user", "file")
+			if tcase.deleteErr != nil {
+				del.WillReturnError(tcase.deleteErr)
+			} else {
+				del.WillReturnResult(sqlmock.NewResult(0, tcase.deleted))
+			}
+			// удаление большого объекта откатывается вместе с записью
+			if tcase.wantErr == nil {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			err := p.BinaryDelete(ctx, "user", "file")
+			if tcase.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tcase.wantErr.Error())
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBinaryUpdateAtomic(t *testing.T) {
+	ctx := context.Background()
+	data := storage.BinaryData{ID: 1, UserID: "user", Name: "file", Size: 10, BinID: 42}
+
+	tests := []struct {
+		name      string
+		createErr error
+		updateErr error
+		updated   int64
+		wantErr   error
+	}{
+		{name: "ok", updated: 1},
+		{name: "create error", createErr: errors.New("create error"), wantErr: errors.New("create error")},
+		{name: "update error", updateErr: errors.New("update error"), wantErr: errors.New("update error")},
+		{name: "not found", updated: 0, wantErr: storage.ErrNoContent},
+	}
+
+	for _, tcase := range tests {
+		t.Run(tcase.name, func(t *testing.T) {
+			p, mock := newMockStore(t)
+			mock.ExpectBegin()
+			mock.ExpectExec(`SELECT lo_unlink\(\$1\)`).
+				WithArgs(data.BinID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			create := mock.ExpectExec(`SELECT lo_create\(\$1\)`).WithArgs(data.BinID)
+			if tcase.createErr != nil {
+				create.WillReturnError(tcase.createErr)
+			} else {
+				create.WillReturnResult(sqlmock.NewResult(0, 1))
+				expectPurge(mock, "binaries", data.UserID, data.Name)
+				upd := mock.ExpectExec(`UPDATE binaries`)
+				if tcase.updateErr != nil {
+					upd.WillReturnError(tcase.updateErr)
+				} else {
+					upd.WillReturnResult(sqlmock.NewResult(0, tcase.updated))
+				}
+			}
+			if tcase.wantErr == nil {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			err := p.BinaryUpdate(ctx, data)
+			if tcase.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tcase.wantErr.Error())
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBinaryWriteAtomic(t *testing.T) {
+	ctx := context.Background()
+	data := storage.BinaryData{UserID: "user", Name: "file", Size: 10}
+
+	t.Run("ok", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT lo_creat\(-1\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"lo_creat"}).AddRow(42))
+		expectPurge(mock, "binaries", data.UserID, data.Name)
+		mock.ExpectExec(`INSERT INTO binaries`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		id, err := p.BinaryWrite(ctx, data)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("insert error", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT lo_creat\(-1\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"lo_creat"}).AddRow(42))
+		expectPurge(mock, "binaries", data.UserID, data.Name)
+		mock.ExpectExec(`INSERT INTO binaries`).WillReturnError(errors.New("insert error"))
+		// созданный большой объект не остаётся без записи
+		mock.ExpectRollback()
+
+		_, err := p.BinaryWrite(ctx, data)
+		assert.EqualError(t, err, "insert error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestBatchAtomic(t *testing.T) {
+	ctx := context.Background()
+	ops := []storage.BatchOp{
+		{Action: storage.OpCreate, Kind: storage.KindNote, Name: "a", Data: storage.NoteData{UserID: "user", Name: "a"}},
+		{Action: storage.OpDelete, Kind: storage.KindNote, Name: "b"},
+	}
+
+	p, mock := newMockStore(t)
+	mock.ExpectBegin()
+	expectPurge(mock, "notes", "user", "a")
+	mock.ExpectExec(`INSERT INTO notes`).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`DELETE FROM notes`).WithArgs("user", "b").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	results, err := p.Batch(ctx, "user", ops, false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.ErrorIs(t, results[0], storage.ErrBatchAborted)
+	assert.ErrorIs(t, results[1], storage.ErrNoContent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBinaryUploadStatus(t *testing.T) {
+	ctx := context.Background()
+	statusColumns := []string{"size", "uploaded", "hash_state", "file_key", "compression", "committed", "stored_size"}
+
+	t.Run("ok", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectQuery(`SELECT size, uploaded, hash_state, file_key, compression, committed, stored_size`).
+			WithArgs("user", int64(42)).
+			WillReturnRows(sqlmock.NewRows(statusColumns).
+				AddRow(10, false, []byte("state"), []byte("key"), "zstd", 4, 3))
+
+		st, err := p.BinaryUploadStatus(ctx, "user", 42)
+		require.NoError(t, err)
+		assert.Equal(t, storage.UploadStatus{Committed: 4, StoredSize: 3, Size: 10,
+			HashState: []byte("state"), FileKey: []byte("key"), Compression: "zstd"}, st)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		p, mock := newMockStore(t)
+		mock.ExpectQuery(`SELECT size, uploaded, hash_state, file_key, compression, committed, stored_size`).
+			WillReturnRows(sqlmock.NewRows(statusColumns))
+
+		_, err := p.BinaryUploadStatus(ctx, "user", 42)
+		assert.ErrorIs(t, err, storage.ErrNoContent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestBinaryTransferRequiresPgx(t *testing.T) {
+	ctx := context.Background()
+	p, mock := newMockStore(t)
+
+	called := false
+	err := p.BinaryUpload(ctx, 42, func(storage.BlobWriter) (storage.UploadProgress, error) {
+		called = true
+		return storage.UploadProgress{}, nil
+	})
+	assert.ErrorIs(t, err, errNotPgx)
+
+	err = p.BinaryDownload(ctx, 42, func(io.ReadSeeker) error {
+		called = true
+		return nil
+	})
+	assert.ErrorIs(t, err, errNotPgx)
+	assert.False(t, called)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,6 +4,7 @@ package storage
 import (
 	"context"
 	"errors"
+	"io"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -13,6 +14,7 @@ var (
 	// ошибки возвращаемяе при работе с хранилищем
 	ErrWriteConflict = errors.New("write conflict")
 	ErrNoContent     = errors.New("no content")
+	ErrBatchAborted  = errors.New("batch aborted")
 )
 
 var database Storage
@@ -65,32 +67,86 @@ type Storage interface {
 	ReadList(context.Context, string) (ListData, error)
 
 	// Password
-	PasswordList(ctx context.Context, userID string) ([]string, error)
+	PasswordList(ctx context.Context, q ListQuery) ([]ListEntry, error)
 	PasswordRead(ctx context.Context, userID, name string) (PasswordData, error)
 	PasswordWrite(ctx context.Context, data PasswordData) error
 	PasswordDelete(ctx context.Context, userID, name string) error
 	PasswordUpdate(ctx context.Context, data PasswordData) error
 
 	// Card
-	CardList(ctx context.Context, userID string) ([]string, error)
+	CardList(ctx context.Context, q ListQuery) ([]ListEntry, error)
 	CardRead(ctx context.Context, userID, name string) (CardData, error)
 	CardWrite(ctx context.Context, data CardData) error
 	CardDelete(ctx context.Context, userID, name string) error
 	CardUpdate(ctx context.Context, data CardData) error
 
 	// Notes
-	NoteList(ctx context.Context, userID string) ([]string, error)
+	NoteList(ctx context.Context, q ListQuery) ([]ListEntry, error)
 	NoteRead(ctx context.Context, userID, name string) (NoteData, error)
 	NoteWrite(ctx context.Context, data NoteData) error
 	NoteDelete(ctx context.Context, userID, name string) error
 	NoteUpdate(ctx context.Context, data NoteData) error
 
+	// Items
+	ItemList(ctx context.Context, q ListQuery) ([]ListEntry, error)
+	ItemRead(ctx context.Context, userID, name string) (ItemData, error)
+	ItemWrite(ctx context.Context, data ItemData) error
+	ItemDelete(ctx context.Context, userID, name string) error
+	ItemUpdate(ctx context.Context, data ItemData) error
+
+	// One-time passwords
+	OtpList(ctx context.Context, q ListQuery) ([]ListEntry, error)
+	OtpRead(ctx context.Context, userID, name string) (OtpData, error)
+	OtpWrite(ctx context.Context, data OtpData) error
+	OtpDelete(ctx context.Context, userID, name string) error
+	OtpUpdate(ctx context.Context, data OtpData) error
+	OtpNextCounter(ctx context.Context, userID, name string) (OtpData, error)
+
+	// SSH keys
+	SshKeyList(ctx context.Context, q ListQuery) ([]ListEntry, error)
+	SshKeyRead(ctx context.Context, userID, name string) (SshKeyData, error)
+	SshKeyWrite(ctx context.Context, data SshKeyData) error
+	SshKeyDelete(ctx context.Context, userID, name string) error
+	SshKeyUpdate(ctx context.Context, data SshKeyData) error
+
 	// Binary
-	BinaryList(ctx context.Context, userID string) ([]string, error)
+	BinaryList(ctx context.Context, q ListQuery) ([]ListEntry, error)
 	BinaryRead(ctx context.Context, userID, name string) (BinaryData, error)
 	BinaryWrite(ctx context.Context, data BinaryData) (int64, error)
 	BinaryDelete(ctx context.Context, userID, name string) error
 	BinaryUpdate(ctx context.Context, data BinaryData) error
-	BinaryUpload(ctx context.Context, data BinaryChunk) error
-	BinaryDownload(ctx context.Context, data *BinaryChunk) error
+	BinaryUpload(ctx context.Context, binID int64, fn func(w BlobWriter) (UploadProgress, error)) error
+	BinaryDownload(ctx context.Context, binID int64, fn func(r io.ReadSeeker) error) error
+	BinaryPut(ctx context.Context, data BinaryData, fn func(w BlobWriter) (UploadProgress, error)) (int64, error)
+	BinarySize(ctx context.Context, binID int64) (int64, error)
+	BinaryUploaded(ctx context.Context, binID int64, sha256 []byte) error
+	BinaryUploadStatus(ctx context.Context, userID string, binID int64) (UploadStatus, error)
+	BinaryPartsBegin(ctx context.Context, binID int64, progress UploadProgress) error
+	BinaryUploadPart(ctx context.Context, binID, offset int64, fn func(w BlobWriter) (UploadProgress, error)) error
+	BinaryAssemble(ctx context.Context, binID int64, fn func(w BlobWriter, parts []PartReader) (UploadProgress, error)) error
+	PlainBinaries(ctx context.Context) ([]int64, error)
+	BinaryRewrite(ctx context.Context, binID int64, fileKey []byte, blockSize int,
+		fn func(index int64, block []byte) ([]byte, error)) (int64, error)
+
+	// GC
+	CollectGarbage(ctx context.Context, grace time.Duration, dryRun bool) (GCReport, error)
+
+	// Tags
+	TagAdd(ctx context.Context, kind, userID, name string, tags []string) error
+	TagRemove(ctx context.Context, kind, userID, name string, tags []string) error
+	TagList(ctx context.Context, userID string) ([]string, error)
+
+	// Folders
+	FolderMove(ctx context.Context, kind, userID, name, folder string) error
+	FolderList(ctx context.Context, userID string) ([]string, error)
+	FolderDelete(ctx context.Context, userID, folder string) error
+
+	// Search
+	Search(ctx context.Context, q SearchQuery) ([]SearchResult, error)
+
+	// Rename
+	Rename(ctx context.Context, kind, userID, name, newName string) error
+
+	// Batch
+	Batch(ctx context.Context, userID string, ops []BatchOp, bestEffort bool) ([]error, error)
 }